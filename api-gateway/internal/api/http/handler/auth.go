@@ -9,6 +9,8 @@ import (
 	"github.com/semho/hotel-booking/api-gateway/internal/api/http/response"
 	"github.com/semho/hotel-booking/pkg/logger"
 	pb "github.com/semho/hotel-booking/pkg/proto/auth_v1/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type AuthHandler struct {
@@ -28,6 +30,8 @@ func (h *AuthHandler) RegisterRoutes(r chi.Router) {
 			r.Post("/login", h.Login)
 			r.Post("/refresh", h.Refresh)
 			r.Post("/validate", h.Validate)
+			r.Post("/verify-email", h.VerifyEmail)
+			r.Post("/verify-email/resend", h.ResendEmailVerification)
 		},
 	)
 }
@@ -46,7 +50,7 @@ func (h *AuthHandler) Validate(w http.ResponseWriter, r *http.Request) {
 	)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
-		logger.Log.Error("api-gateway validate with authClient", err)
+		logger.Log.Error("api-gateway validate with authClient", "error", err)
 		return
 	}
 
@@ -65,7 +69,7 @@ func (h *AuthHandler) Validate(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	err = json.NewEncoder(w).Encode(authResponse)
 	if err != nil {
-		logger.Log.Error("api-gateway validate ", err)
+		logger.Log.Error("api-gateway validate ", "error", err)
 		return
 	}
 }
@@ -88,7 +92,7 @@ func (h *AuthHandler) Register(w http.ResponseWriter, r *http.Request) {
 	)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
-		logger.Log.Error("api-gateway register with authClient", err)
+		logger.Log.Error("api-gateway register with authClient", "error", err)
 		return
 	}
 
@@ -149,7 +153,7 @@ func (h *AuthHandler) Register(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	err = json.NewEncoder(w).Encode(authResponse)
 	if err != nil {
-		logger.Log.Error("api-gateway register ", err)
+		logger.Log.Error("api-gateway register ", "error", err)
 		return
 	}
 }
@@ -168,7 +172,7 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		},
 	)
 	if err != nil {
-		logger.Log.Error("api-gateway login with authClient", err)
+		logger.Log.Error("api-gateway login with authClient", "error", err)
 		http.Error(w, err.Error(), http.StatusUnauthorized)
 		return
 	}
@@ -199,7 +203,7 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	err = json.NewEncoder(w).Encode(authResponse)
 	if err != nil {
-		logger.Log.Error("api-gateway login encoding error", err)
+		logger.Log.Error("api-gateway login encoding error", "error", err)
 		return
 	}
 }
@@ -252,7 +256,70 @@ func (h *AuthHandler) Refresh(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	err = json.NewEncoder(w).Encode(authResponse)
 	if err != nil {
-		logger.Log.Error("api-gateway refresh encoding error", err)
+		logger.Log.Error("api-gateway refresh encoding error", "error", err)
 		return
 	}
 }
+
+func (h *AuthHandler) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	var req request.VerifyEmailRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+	if req.Token == "" {
+		http.Error(w, "token is required", http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.authClient.VerifyEmail(r.Context(), &pb.VerifyEmailRequest{Token: req.Token})
+	if err != nil {
+		logger.Log.Error("api-gateway verify email with authClient", "error", err)
+		if status.Code(err) == codes.InvalidArgument {
+			http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, "failed to verify email", http.StatusInternalServerError)
+		return
+	}
+
+	claimed := resp.ClaimedBookingIds
+	if claimed == nil {
+		claimed = []string{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(
+		response.VerifyEmailResponse{
+			User:              response.UserFromProto(resp.User),
+			ClaimedBookingIDs: claimed,
+		},
+	)
+	if err != nil {
+		logger.Log.Error("api-gateway verify email encoding error", "error", err)
+		return
+	}
+}
+
+func (h *AuthHandler) ResendEmailVerification(w http.ResponseWriter, r *http.Request) {
+	var req request.ResendEmailVerificationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+	if req.Email == "" {
+		http.Error(w, "email is required", http.StatusBadRequest)
+		return
+	}
+
+	_, err := h.authClient.ResendEmailVerification(
+		r.Context(), &pb.ResendEmailVerificationRequest{Email: req.Email},
+	)
+	if err != nil {
+		logger.Log.Error("api-gateway resend email verification with authClient", "error", err)
+		http.Error(w, "failed to resend email verification", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
@@ -5,20 +5,23 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/semho/hotel-booking/api-gateway/internal/constants"
+	"io"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/go-chi/chi/v5"
-	"github.com/google/uuid"
 	"github.com/semho/hotel-booking/api-gateway/internal/api/http/mapper"
 	"github.com/semho/hotel-booking/api-gateway/internal/api/http/middleware"
 	"github.com/semho/hotel-booking/api-gateway/internal/api/http/request"
 	"github.com/semho/hotel-booking/api-gateway/internal/api/http/response"
 	"github.com/semho/hotel-booking/pkg/errors"
 	"github.com/semho/hotel-booking/pkg/logger"
+	"github.com/semho/hotel-booking/pkg/money"
 	authpb "github.com/semho/hotel-booking/pkg/proto/auth_v1/auth"
 	bookingpb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
 	roompb "github.com/semho/hotel-booking/pkg/proto/room_v1/room"
+	"google.golang.org/grpc/metadata"
 )
 
 type BookingHandler struct {
@@ -53,8 +56,118 @@ func (h *BookingHandler) RegisterRoutes(r chi.Router) {
 						func(r chi.Router) {
 							r.Use(h.authMiddleware.ValidateToken)
 							r.Post("/", h.CreateBooking)
+							r.Get("/{id}", h.GetBooking)
+							r.Patch("/{id}", h.ModifyBooking)
+							r.Get("/{id}/history", h.GetBookingHistory)
+							r.Get("/{id}/cancellation-quote", h.GetCancellationQuote)
+							r.Post("/{id}/cancel", h.CancelBooking)
 						},
 					)
+					// Маршруты администратора
+					r.Group(
+						func(r chi.Router) {
+							r.Use(h.authMiddleware.ValidateToken)
+							r.Use(h.authMiddleware.RequireAdmin)
+							r.Get("/", h.ListBookings)
+							r.Post("/{id}/check-in", h.CheckIn)
+							r.Post("/{id}/check-out", h.CheckOut)
+							r.Post("/{id}/assign-room", h.AssignRoom)
+						},
+					)
+				},
+			)
+
+			// Групповые брони нескольких комнат
+			r.Route(
+				"/reservations", func(r chi.Router) {
+					r.Use(h.authMiddleware.ValidateToken)
+					r.Post("/", h.CreateReservation)
+					r.Get("/{id}", h.GetReservation)
+				},
+			)
+
+			// Промокоды, управление доступно только администратору
+			r.Route(
+				"/promo-codes", func(r chi.Router) {
+					r.Use(h.authMiddleware.ValidateToken)
+					r.Use(h.authMiddleware.RequireAdmin)
+					r.Post("/", h.CreatePromoCode)
+					r.Get("/", h.ListPromoCodes)
+					r.Get("/{id}", h.GetPromoCode)
+					r.Put("/{id}", h.UpdatePromoCode)
+					r.Delete("/{id}", h.DeletePromoCode)
+				},
+			)
+
+			// Ограничения продаж, управление доступно только администратору
+			r.Route(
+				"/stay-restrictions", func(r chi.Router) {
+					r.Use(h.authMiddleware.ValidateToken)
+					r.Use(h.authMiddleware.RequireAdmin)
+					r.Post("/", h.CreateStayRestriction)
+					r.Get("/", h.ListStayRestrictions)
+					r.Get("/{id}", h.GetStayRestriction)
+					r.Put("/{id}", h.UpdateStayRestriction)
+					r.Delete("/{id}", h.DeleteStayRestriction)
+				},
+			)
+
+			// Профили гостей
+			r.Route(
+				"/guest-profiles", func(r chi.Router) {
+					r.Use(h.authMiddleware.ValidateToken)
+					r.Use(h.authMiddleware.RequireAdmin)
+					r.Get("/", h.SearchGuestProfiles)
+					r.Get("/{id}", h.GetGuestProfile)
+					r.Put("/{id}", h.UpdateGuestProfile)
+					r.Post("/{id}/merge", h.MergeGuestProfiles)
+				},
+			)
+
+			// Лист ожидания на занятые даты
+			r.Route(
+				"/waitlist", func(r chi.Router) {
+					r.Group(
+						func(r chi.Router) {
+							r.Use(h.authMiddleware.ValidateToken)
+							r.Post("/", h.JoinWaitlist)
+							r.Get("/{id}", h.GetWaitlistEntry)
+							r.Delete("/{id}", h.LeaveWaitlist)
+						},
+					)
+					r.Group(
+						func(r chi.Router) {
+							r.Use(h.authMiddleware.ValidateToken)
+							r.Use(h.authMiddleware.RequireAdmin)
+							r.Get("/", h.ListWaitlistEntries)
+						},
+					)
+				},
+			)
+
+			// Календарь доступности комнат по ночам
+			r.Route(
+				"/availability", func(r chi.Router) {
+					r.Use(h.authMiddleware.ValidateToken)
+					r.Get("/calendar", h.GetAvailabilityCalendar)
+				},
+			)
+
+			// Отчеты администратора
+			r.Route(
+				"/reports", func(r chi.Router) {
+					r.Use(h.authMiddleware.ValidateToken)
+					r.Use(h.authMiddleware.RequireAdmin)
+					r.Get("/oversold-nights", h.GetOversoldNights)
+				},
+			)
+
+			// Брони и лист ожидания текущего пользователя
+			r.Route(
+				"/me", func(r chi.Router) {
+					r.Use(h.authMiddleware.ValidateToken)
+					r.Get("/bookings", h.ListMyBookings)
+					r.Get("/waitlist", h.ListMyWaitlistEntries)
 				},
 			)
 		},
@@ -78,6 +191,7 @@ func (h *BookingHandler) RegisterRoutes(r chi.Router) {
 // @Param checkOut query string true "Check-out date (YYYY-MM-DD)"
 // @Param capacity query integer false "Minimum room capacity"
 // @Param type query string false "Room type (STANDARD, DELUXE, SUITE)"
+// @Param currency query string false "Currency to convert room prices to (ISO 4217)"
 // @Success 200 {array} response.AvailableRoom
 // @Failure 400 {object} response.Error
 // @Failure 500 {object} response.Error
@@ -114,6 +228,7 @@ func (h *BookingHandler) GetAvailableRooms(w http.ResponseWriter, r *http.Reques
 		CheckOut: mapper.TimeToProtoTimestamp(params.CheckOut),
 		Capacity: params.Capacity,
 		Type:     params.Type,
+		Currency: params.Currency,
 	}
 
 	// Устанавливаем timeout для запроса
@@ -124,10 +239,8 @@ func (h *BookingHandler) GetAvailableRooms(w http.ResponseWriter, r *http.Reques
 	resp, err := h.bookingClient.GetAvailableRooms(ctx, req)
 	if err != nil {
 		logger.Log.Error("failed to get available rooms", "error", err)
-		h.respondWithError(
-			w, http.StatusInternalServerError,
-			errors.WithMessage(errors.ErrInternal, "failed to get available rooms"),
-		)
+		err = mapper.GRPCToDomainError(err)
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
 		return
 	}
 
@@ -173,6 +286,10 @@ func (h *BookingHandler) parseSearchParams(r *http.Request) (*request.SearchPara
 		}
 	}
 
+	if params.Currency, err = parseCurrency(r); err != nil {
+		return nil, err
+	}
+
 	// Валидируем параметры
 	if err = params.Validate(); err != nil {
 		return nil, err
@@ -198,6 +315,10 @@ func (h *BookingHandler) respondWithError(w http.ResponseWriter, code int, err e
 		errorCode = "CONFLICT"
 	case errors.IsInvalidInput(err):
 		errorCode = "INVALID_INPUT"
+	case errors.IsUnauthorized(err):
+		errorCode = "UNAUTHORIZED"
+	case errors.IsForbidden(err):
+		errorCode = "FORBIDDEN"
 	default:
 		errorCode = "INTERNAL_ERROR"
 	}
@@ -210,48 +331,657 @@ func (h *BookingHandler) respondWithError(w http.ResponseWriter, code int, err e
 	)
 }
 
-// TODO: заглушка до реализации
+// @Summary Create a new booking
+// @Description Books a free room for the authenticated user
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Param Idempotency-Key header string false "Unique key to safely retry the request"
+// @Param request body request.CreateBookingRequest true "Booking data"
+// @Success 201 {object} response.CreateBookingResponse
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Error
+// @Failure 409 {object} response.Error
+// @Failure 500 {object} response.Error
+// @Router /api/v1/bookings [post]
 func (h *BookingHandler) CreateBooking(w http.ResponseWriter, r *http.Request) {
 	// Получаем данные пользователя из контекста (установленные в AuthMiddleware)
 	userInfo, ok := r.Context().Value(constants.USER).(*authpb.UserInfo)
 	if !ok {
-		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		h.respondWithError(w, http.StatusUnauthorized, errors.ErrUnauthorized)
 		return
 	}
 
 	// Читаем тело запроса
 	var req request.CreateBookingRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "invalid request body", http.StatusBadRequest)
+		logger.Log.Error("failed to decode request body", "error", err)
+		h.respondWithError(
+			w, http.StatusBadRequest,
+			errors.WithMessage(errors.ErrInvalidInput, "invalid request body"),
+		)
 		return
 	}
 
-	// Создаем заглушку ответа
-	res := response.CreateBookingResponse{
-		ID:     uuid.New().String(), // Генерируем фейковый ID
-		RoomID: req.RoomID,
-		UserInfo: &response.UserInfo{
-			ID:        userInfo.Id,
-			Email:     userInfo.Email,
-			FirstName: userInfo.FirstName,
-			LastName:  userInfo.LastName,
-			Role:      userInfo.Role.String(),
-		},
-		Status:  "PENDING",
-		Message: "Booking created successfully (stub response)",
+	if err := req.Validate(); err != nil {
+		h.respondWithError(w, http.StatusBadRequest, err)
+		return
 	}
 
-	// Отправляем ответ
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusCreated)
-	if err := json.NewEncoder(w).Encode(res); err != nil {
-		logger.Log.Error("failed to encode response", "error", err)
+	protoReq, err := mapper.CreateBookingRequestToProto(req, userInfo)
+	if err != nil {
+		h.respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Устанавливаем timeout для запроса
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	// Повтор запроса с тем же ключом вернет исходную бронь вместо создания новой
+	if key := r.Header.Get(constants.IdempotencyKeyHeader); key != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, constants.IdempotencyKeyMetadata, key)
+	}
+
+	resp, err := h.bookingClient.CreateBooking(ctx, protoReq)
+	if err != nil {
+		logger.Log.Error("failed to create booking", "error", err, "user_id", userInfo.Id)
+		err = mapper.GRPCToDomainError(err)
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
 	}
 
 	logger.Log.Info(
-		"stub booking created",
+		"booking created",
 		"user_id", userInfo.Id,
-		"room_id", req.RoomID,
-		"booking_id", res.ID,
+		"room_id", resp.Booking.RoomId,
+		"booking_id", resp.Booking.Id,
+	)
+
+	h.respondWithJSON(w, http.StatusCreated, mapper.ProtoToCreateBookingResponse(resp.Booking, userInfo))
+}
+
+// @Summary Get booking by ID
+// @Description Returns booking with its current status. Non-admin users can only see their own bookings
+// @Tags bookings
+// @Produce json
+// @Param id path string true "Booking ID"
+// @Param currency query string false "Currency for convertedTotalPrice (ISO 4217)"
+// @Success 200 {object} response.Booking
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Error
+// @Failure 403 {object} response.Error
+// @Failure 404 {object} response.Error
+// @Failure 500 {object} response.Error
+// @Router /api/v1/bookings/{id} [get]
+func (h *BookingHandler) GetBooking(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := r.Context().Value(constants.USER).(*authpb.UserInfo)
+	if !ok {
+		h.respondWithError(w, http.StatusUnauthorized, errors.ErrUnauthorized)
+		return
+	}
+
+	currency, err := parseCurrency(r)
+	if err != nil {
+		h.respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	booking, err := h.getAccessibleBooking(ctx, chi.URLParam(r, "id"), userInfo, currency)
+	if err != nil {
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	h.respondWithJSON(w, http.StatusOK, mapper.ProtoToBooking(booking))
+}
+
+// @Summary Get booking status history
+// @Description Returns booking status changes, newest first. Non-admin users can only see their own bookings
+// @Tags bookings
+// @Produce json
+// @Param id path string true "Booking ID"
+// @Success 200 {array} response.BookingStatusChange
+// @Failure 401 {object} response.Error
+// @Failure 403 {object} response.Error
+// @Failure 404 {object} response.Error
+// @Failure 500 {object} response.Error
+// @Router /api/v1/bookings/{id}/history [get]
+func (h *BookingHandler) GetBookingHistory(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := r.Context().Value(constants.USER).(*authpb.UserInfo)
+	if !ok {
+		h.respondWithError(w, http.StatusUnauthorized, errors.ErrUnauthorized)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	booking, err := h.getAccessibleBooking(ctx, chi.URLParam(r, "id"), userInfo, nil)
+	if err != nil {
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	resp, err := h.bookingClient.GetBookingHistory(
+		ctx, &bookingpb.GetBookingHistoryRequest{
+			BookingId: booking.Id,
+		},
+	)
+	if err != nil {
+		logger.Log.Error("failed to get booking history", "error", err, "booking_id", booking.Id)
+		err = mapper.GRPCToDomainError(err)
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	h.respondWithJSON(w, http.StatusOK, mapper.ProtoToStatusHistory(resp.History))
+}
+
+// @Summary List bookings
+// @Description Returns bookings page filtered by user, room, status and period. Admin only
+// @Tags bookings
+// @Produce json
+// @Param userId query string false "User ID"
+// @Param roomId query string false "Room ID"
+// @Param guestProfileId query string false "Guest profile ID"
+// @Param status query string false "Booking status (PENDING, CONFIRMED, CANCELLED, COMPLETED, NO_SHOW)"
+// @Param from query string false "Period start (YYYY-MM-DD)"
+// @Param to query string false "Period end (YYYY-MM-DD)"
+// @Param pageSize query integer false "Page size"
+// @Param pageToken query string false "Token of the next page"
+// @Param currency query string false "Currency for convertedTotalPrice (ISO 4217)"
+// @Success 200 {object} response.BookingList
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Error
+// @Failure 403 {object} response.Error
+// @Failure 500 {object} response.Error
+// @Router /api/v1/bookings [get]
+func (h *BookingHandler) ListBookings(w http.ResponseWriter, r *http.Request) {
+	params, err := h.parseListBookingsParams(r)
+	if err != nil {
+		h.respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if userID := r.URL.Query().Get("userId"); userID != "" {
+		params.UserID = &userID
+	}
+	if profileID := r.URL.Query().Get("guestProfileId"); profileID != "" {
+		params.GuestProfileID = &profileID
+	}
+
+	h.listBookings(w, r, params)
+}
+
+// @Summary List my bookings
+// @Description Returns bookings of the authenticated user
+// @Tags bookings
+// @Produce json
+// @Param status query string false "Booking status (PENDING, CONFIRMED, CANCELLED, COMPLETED, NO_SHOW)"
+// @Param from query string false "Period start (YYYY-MM-DD)"
+// @Param to query string false "Period end (YYYY-MM-DD)"
+// @Param pageSize query integer false "Page size"
+// @Param pageToken query string false "Token of the next page"
+// @Param currency query string false "Currency for convertedTotalPrice (ISO 4217)"
+// @Success 200 {object} response.BookingList
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Error
+// @Failure 500 {object} response.Error
+// @Router /api/v1/me/bookings [get]
+func (h *BookingHandler) ListMyBookings(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := r.Context().Value(constants.USER).(*authpb.UserInfo)
+	if !ok {
+		h.respondWithError(w, http.StatusUnauthorized, errors.ErrUnauthorized)
+		return
+	}
+
+	params, err := h.parseListBookingsParams(r)
+	if err != nil {
+		h.respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Пользователь всегда видит только свои брони
+	userID := userInfo.Id
+	params.UserID = &userID
+
+	h.listBookings(w, r, params)
+}
+
+func (h *BookingHandler) listBookings(w http.ResponseWriter, r *http.Request, params *request.ListBookingsParams) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.bookingClient.ListBookings(ctx, mapper.ListBookingsParamsToProto(params))
+	if err != nil {
+		logger.Log.Error("failed to list bookings", "error", err)
+		err = mapper.GRPCToDomainError(err)
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	h.respondWithJSON(w, http.StatusOK, mapper.ProtoToBookingList(resp))
+}
+
+// @Summary Modify booking
+// @Description Changes dates, room type or guest details. Keeps the same room when it is free for the new dates. Non-admin users can only modify their own bookings
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Param id path string true "Booking ID"
+// @Param request body request.ModifyBookingRequest true "Changes"
+// @Success 200 {object} response.Booking
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Error
+// @Failure 403 {object} response.Error
+// @Failure 404 {object} response.Error
+// @Failure 409 {object} response.Error
+// @Failure 500 {object} response.Error
+// @Router /api/v1/bookings/{id} [patch]
+func (h *BookingHandler) ModifyBooking(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := r.Context().Value(constants.USER).(*authpb.UserInfo)
+	if !ok {
+		h.respondWithError(w, http.StatusUnauthorized, errors.ErrUnauthorized)
+		return
+	}
+
+	var req request.ModifyBookingRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Log.Error("failed to decode request body", "error", err)
+		h.respondWithError(
+			w, http.StatusBadRequest,
+			errors.WithMessage(errors.ErrInvalidInput, "invalid request body"),
+		)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	booking, err := h.getAccessibleBooking(ctx, chi.URLParam(r, "id"), userInfo, nil)
+	if err != nil {
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	protoReq, err := mapper.ModifyBookingRequestToProto(req, booking.Id, userInfo)
+	if err != nil {
+		h.respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	resp, err := h.bookingClient.ModifyBooking(ctx, protoReq)
+	if err != nil {
+		logger.Log.Error("failed to modify booking", "error", err, "booking_id", booking.Id)
+		err = mapper.GRPCToDomainError(err)
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	h.respondWithJSON(w, http.StatusOK, mapper.ProtoToBooking(resp.Booking))
+}
+
+// @Summary Get cancellation quote
+// @Description Previews penalty and refund if the booking is cancelled now. Non-admin users can only see their own bookings
+// @Tags bookings
+// @Produce json
+// @Param id path string true "Booking ID"
+// @Success 200 {object} response.CancellationQuote
+// @Failure 401 {object} response.Error
+// @Failure 403 {object} response.Error
+// @Failure 404 {object} response.Error
+// @Failure 409 {object} response.Error
+// @Failure 500 {object} response.Error
+// @Router /api/v1/bookings/{id}/cancellation-quote [get]
+func (h *BookingHandler) GetCancellationQuote(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := r.Context().Value(constants.USER).(*authpb.UserInfo)
+	if !ok {
+		h.respondWithError(w, http.StatusUnauthorized, errors.ErrUnauthorized)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	booking, err := h.getAccessibleBooking(ctx, chi.URLParam(r, "id"), userInfo, nil)
+	if err != nil {
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	resp, err := h.bookingClient.GetCancellationQuote(
+		ctx, &bookingpb.GetCancellationQuoteRequest{
+			BookingId: booking.Id,
+		},
+	)
+	if err != nil {
+		logger.Log.Error("failed to get cancellation quote", "error", err, "booking_id", booking.Id)
+		err = mapper.GRPCToDomainError(err)
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	h.respondWithJSON(w, http.StatusOK, mapper.ProtoToCancellationQuote(resp.Quote))
+}
+
+// @Summary Cancel booking
+// @Description Cancels the booking applying the cancellation policy of the room type. Non-admin users can only cancel their own bookings
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Param id path string true "Booking ID"
+// @Param request body request.CancelBookingRequest false "Cancellation reason"
+// @Success 200 {object} response.CancelBookingResponse
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Error
+// @Failure 403 {object} response.Error
+// @Failure 404 {object} response.Error
+// @Failure 409 {object} response.Error
+// @Failure 500 {object} response.Error
+// @Router /api/v1/bookings/{id}/cancel [post]
+func (h *BookingHandler) CancelBooking(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := r.Context().Value(constants.USER).(*authpb.UserInfo)
+	if !ok {
+		h.respondWithError(w, http.StatusUnauthorized, errors.ErrUnauthorized)
+		return
+	}
+
+	// Тело необязательное
+	var req request.CancelBookingRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && err != io.EOF {
+		logger.Log.Error("failed to decode request body", "error", err)
+		h.respondWithError(
+			w, http.StatusBadRequest,
+			errors.WithMessage(errors.ErrInvalidInput, "invalid request body"),
+		)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	booking, err := h.getAccessibleBooking(ctx, chi.URLParam(r, "id"), userInfo, nil)
+	if err != nil {
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	resp, err := h.bookingClient.CancelBooking(
+		ctx, &bookingpb.CancelBookingRequest{
+			BookingId: booking.Id,
+			Reason:    req.Reason,
+			ChangedBy: userInfo.Id,
+		},
 	)
+	if err != nil {
+		logger.Log.Error("failed to cancel booking", "error", err, "booking_id", booking.Id)
+		err = mapper.GRPCToDomainError(err)
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	h.respondWithJSON(
+		w, http.StatusOK, response.CancelBookingResponse{
+			Booking: mapper.ProtoToBooking(resp.Booking),
+			Quote:   mapper.ProtoToCancellationQuote(resp.Quote),
+		},
+	)
+}
+
+// @Summary Check in guest
+// @Description Records guest arrival. Optionally places the guest in another free room. Admin only
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Param id path string true "Booking ID"
+// @Param request body request.CheckInRequest false "Room to check in to"
+// @Success 200 {object} response.Booking
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Error
+// @Failure 403 {object} response.Error
+// @Failure 404 {object} response.Error
+// @Failure 409 {object} response.Error
+// @Failure 500 {object} response.Error
+// @Router /api/v1/bookings/{id}/check-in [post]
+func (h *BookingHandler) CheckIn(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := r.Context().Value(constants.USER).(*authpb.UserInfo)
+	if !ok {
+		h.respondWithError(w, http.StatusUnauthorized, errors.ErrUnauthorized)
+		return
+	}
+
+	// Тело необязательное: без него гость заселяется в забронированную комнату
+	var req request.CheckInRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && err != io.EOF {
+		logger.Log.Error("failed to decode request body", "error", err)
+		h.respondWithError(
+			w, http.StatusBadRequest,
+			errors.WithMessage(errors.ErrInvalidInput, "invalid request body"),
+		)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	bookingID := chi.URLParam(r, "id")
+	resp, err := h.bookingClient.CheckIn(
+		ctx, &bookingpb.CheckInRequest{
+			BookingId: bookingID,
+			RoomId:    req.RoomID,
+			ChangedBy: userInfo.Id,
+		},
+	)
+	if err != nil {
+		logger.Log.Error("failed to check in", "error", err, "booking_id", bookingID)
+		err = mapper.GRPCToDomainError(err)
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	h.respondWithJSON(w, http.StatusOK, mapper.ProtoToBooking(resp.Booking))
+}
+
+// @Summary Check out guest
+// @Description Records guest departure and completes the booking. Early check-out shortens the stay and recalculates the price. Admin only
+// @Tags bookings
+// @Produce json
+// @Param id path string true "Booking ID"
+// @Success 200 {object} response.Booking
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Error
+// @Failure 403 {object} response.Error
+// @Failure 404 {object} response.Error
+// @Failure 409 {object} response.Error
+// @Failure 500 {object} response.Error
+// @Router /api/v1/bookings/{id}/check-out [post]
+func (h *BookingHandler) CheckOut(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := r.Context().Value(constants.USER).(*authpb.UserInfo)
+	if !ok {
+		h.respondWithError(w, http.StatusUnauthorized, errors.ErrUnauthorized)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	bookingID := chi.URLParam(r, "id")
+	resp, err := h.bookingClient.CheckOut(
+		ctx, &bookingpb.CheckOutRequest{
+			BookingId: bookingID,
+			ChangedBy: userInfo.Id,
+		},
+	)
+	if err != nil {
+		logger.Log.Error("failed to check out", "error", err, "booking_id", bookingID)
+		err = mapper.GRPCToDomainError(err)
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	h.respondWithJSON(w, http.StatusOK, mapper.ProtoToBooking(resp.Booking))
+}
+
+// @Summary Assign room to booking
+// @Description Assigns a concrete room to a booking sold by room type before arrival, overriding the assignment job. Admin only
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Param id path string true "Booking ID"
+// @Param request body request.AssignRoomRequest true "Room to assign"
+// @Success 200 {object} response.Booking
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Error
+// @Failure 403 {object} response.Error
+// @Failure 404 {object} response.Error
+// @Failure 409 {object} response.Error
+// @Failure 500 {object} response.Error
+// @Router /api/v1/bookings/{id}/assign-room [post]
+func (h *BookingHandler) AssignRoom(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := r.Context().Value(constants.USER).(*authpb.UserInfo)
+	if !ok {
+		h.respondWithError(w, http.StatusUnauthorized, errors.ErrUnauthorized)
+		return
+	}
+
+	var req request.AssignRoomRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Log.Error("failed to decode request body", "error", err)
+		h.respondWithError(
+			w, http.StatusBadRequest,
+			errors.WithMessage(errors.ErrInvalidInput, "invalid request body"),
+		)
+		return
+	}
+	if err := req.Validate(); err != nil {
+		h.respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	bookingID := chi.URLParam(r, "id")
+	resp, err := h.bookingClient.AssignRoom(
+		ctx, &bookingpb.AssignRoomRequest{
+			BookingId: bookingID,
+			RoomId:    req.RoomID,
+			ChangedBy: userInfo.Id,
+		},
+	)
+	if err != nil {
+		logger.Log.Error("failed to assign room", "error", err, "booking_id", bookingID)
+		err = mapper.GRPCToDomainError(err)
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	h.respondWithJSON(w, http.StatusOK, mapper.ProtoToBooking(resp.Booking))
+}
+
+// getAccessibleBooking загружает бронь и проверяет, что она принадлежит пользователю (администратор видит все)
+func (h *BookingHandler) getAccessibleBooking(
+	ctx context.Context,
+	bookingID string,
+	userInfo *authpb.UserInfo,
+	currency *string,
+) (*bookingpb.Booking, error) {
+	resp, err := h.bookingClient.GetBooking(
+		ctx, &bookingpb.GetBookingRequest{
+			BookingId: bookingID,
+			Currency:  currency,
+		},
+	)
+	if err != nil {
+		logger.Log.Error("failed to get booking", "error", err, "booking_id", bookingID)
+		return nil, mapper.GRPCToDomainError(err)
+	}
+
+	booking := resp.Booking
+	if userInfo.Role != authpb.UserRole_USER_ROLE_ADMIN &&
+		(booking.UserId == nil || *booking.UserId != userInfo.Id) {
+		logger.Log.Info(
+			"access to foreign booking denied",
+			"user_id", userInfo.Id,
+			"booking_id", bookingID,
+		)
+		return nil, errors.WithMessage(errors.ErrForbidden, "booking belongs to another user")
+	}
+
+	return booking, nil
+}
+
+func (h *BookingHandler) parseListBookingsParams(r *http.Request) (*request.ListBookingsParams, error) {
+	query := r.URL.Query()
+	params := &request.ListBookingsParams{
+		PageToken: query.Get("pageToken"),
+	}
+
+	if roomID := query.Get("roomId"); roomID != "" {
+		params.RoomID = &roomID
+	}
+
+	if status := query.Get("status"); status != "" {
+		val, ok := mapper.StringToBookingStatus(status)
+		if !ok {
+			return nil, errors.WithMessage(errors.ErrInvalidInput, "invalid booking status")
+		}
+		params.Status = &val
+	}
+
+	if from := query.Get("from"); from != "" {
+		t, err := time.Parse("2006-01-02", from)
+		if err != nil {
+			return nil, errors.WithMessage(errors.ErrInvalidInput, "invalid 'from' date format")
+		}
+		params.From = &t
+	}
+
+	if to := query.Get("to"); to != "" {
+		t, err := time.Parse("2006-01-02", to)
+		if err != nil {
+			return nil, errors.WithMessage(errors.ErrInvalidInput, "invalid 'to' date format")
+		}
+		params.To = &t
+	}
+
+	if pageSize := query.Get("pageSize"); pageSize != "" {
+		if _, err := fmt.Sscan(pageSize, &params.PageSize); err != nil {
+			return nil, errors.WithMessage(errors.ErrInvalidInput, "invalid page size value")
+		}
+	}
+
+	currency, err := parseCurrency(r)
+	if err != nil {
+		return nil, err
+	}
+	params.Currency = currency
+
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	return params, nil
+}
+
+// parseCurrency читает необязательный параметр currency — валюту, в которую пересчитываются цены
+func parseCurrency(r *http.Request) (*string, error) {
+	currency := r.URL.Query().Get("currency")
+	if currency == "" {
+		return nil, nil
+	}
+	if err := money.ValidateCurrency(currency); err != nil {
+		return nil, err
+	}
+	currency = strings.ToUpper(currency)
+	return &currency, nil
 }
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/semho/hotel-booking/api-gateway/internal/api/http/mapper"
+	"github.com/semho/hotel-booking/api-gateway/internal/constants"
+	"github.com/semho/hotel-booking/pkg/errors"
+	"github.com/semho/hotel-booking/pkg/logger"
+	authpb "github.com/semho/hotel-booking/pkg/proto/auth_v1/auth"
+	bookingpb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
+	roompb "github.com/semho/hotel-booking/pkg/proto/room_v1/room"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// @Summary Availability calendar
+// @Description Returns per-night state (FREE, BOOKED, BLOCKED, OUT_OF_SERVICE) of each room or room type in a window. Booking IDs are shown to admins only
+// @Tags availability
+// @Produce json
+// @Param from query string false "First night (YYYY-MM-DD), defaults to today"
+// @Param to query string false "Night after the last one (YYYY-MM-DD), defaults to 30 days after from"
+// @Param groupBy query string false "ROOM (default) or ROOM_TYPE"
+// @Param type query string false "Room type filter"
+// @Success 200 {array} response.AvailabilityCalendarRow
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Error
+// @Failure 500 {object} response.Error
+// @Router /api/v1/availability/calendar [get]
+func (h *BookingHandler) GetAvailabilityCalendar(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := r.Context().Value(constants.USER).(*authpb.UserInfo)
+	if !ok {
+		h.respondWithError(w, http.StatusUnauthorized, errors.ErrUnauthorized)
+		return
+	}
+
+	req, err := parseAvailabilityCalendarRequest(r)
+	if err != nil {
+		h.respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.bookingClient.GetAvailabilityCalendar(ctx, req)
+	if err != nil {
+		logger.Log.Error("failed to get availability calendar", "error", err)
+		err = mapper.GRPCToDomainError(err)
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	isAdmin := userInfo.Role == authpb.UserRole_USER_ROLE_ADMIN
+	h.respondWithJSON(w, http.StatusOK, mapper.ProtoToAvailabilityCalendar(resp.Rows, isAdmin))
+}
+
+func parseAvailabilityCalendarRequest(r *http.Request) (*bookingpb.GetAvailabilityCalendarRequest, error) {
+	req := &bookingpb.GetAvailabilityCalendarRequest{}
+	query := r.URL.Query()
+
+	if from := query.Get("from"); from != "" {
+		t, err := time.Parse("2006-01-02", from)
+		if err != nil {
+			return nil, errors.WithMessage(errors.ErrInvalidInput, "invalid 'from' date format")
+		}
+		req.From = timestamppb.New(t)
+	}
+
+	if to := query.Get("to"); to != "" {
+		t, err := time.Parse("2006-01-02", to)
+		if err != nil {
+			return nil, errors.WithMessage(errors.ErrInvalidInput, "invalid 'to' date format")
+		}
+		req.To = timestamppb.New(t)
+	}
+
+	if groupBy := query.Get("groupBy"); groupBy != "" {
+		grouping, ok := mapper.StringToCalendarGrouping(groupBy)
+		if !ok {
+			return nil, errors.WithMessage(errors.ErrInvalidInput, "invalid groupBy value")
+		}
+		req.GroupBy = grouping
+	}
+
+	if roomType := query.Get("type"); roomType != "" {
+		val, ok := roompb.RoomType_value[roomType]
+		if !ok {
+			return nil, errors.WithMessage(errors.ErrInvalidInput, "invalid room type")
+		}
+		t := roompb.RoomType(val)
+		req.RoomType = &t
+	}
+
+	return req, nil
+}
@@ -0,0 +1,179 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/semho/hotel-booking/api-gateway/internal/api/http/mapper"
+	"github.com/semho/hotel-booking/api-gateway/internal/api/http/request"
+	"github.com/semho/hotel-booking/pkg/errors"
+	"github.com/semho/hotel-booking/pkg/logger"
+	bookingpb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
+)
+
+// @Summary Search guest profiles
+// @Description Finds guest profiles by part of the name, email or phone, ordered by name. Admin only
+// @Tags guest-profiles
+// @Produce json
+// @Param query query string false "Part of the guest name, email or phone"
+// @Param limit query integer false "Maximum number of profiles (default 20, at most 100)"
+// @Success 200 {array} response.GuestProfile
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Error
+// @Failure 403 {object} response.Error
+// @Failure 500 {object} response.Error
+// @Router /api/v1/guest-profiles [get]
+func (h *BookingHandler) SearchGuestProfiles(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := &bookingpb.SearchGuestProfilesRequest{Query: query.Get("query")}
+	if limit := query.Get("limit"); limit != "" {
+		if _, err := fmt.Sscan(limit, &req.Limit); err != nil || req.Limit < 0 {
+			h.respondWithError(
+				w, http.StatusBadRequest,
+				errors.WithMessage(errors.ErrInvalidInput, "invalid limit value"),
+			)
+			return
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.bookingClient.SearchGuestProfiles(ctx, req)
+	if err != nil {
+		logger.Log.Error("failed to search guest profiles", "error", err)
+		err = mapper.GRPCToDomainError(err)
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	h.respondWithJSON(w, http.StatusOK, mapper.ProtoToGuestProfiles(resp.Profiles))
+}
+
+// @Summary Get guest profile
+// @Description Returns a guest profile with contacts, preferences, stay count and lifetime value. Admin only
+// @Tags guest-profiles
+// @Produce json
+// @Param id path string true "Guest profile ID"
+// @Success 200 {object} response.GuestProfile
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Error
+// @Failure 403 {object} response.Error
+// @Failure 404 {object} response.Error
+// @Failure 500 {object} response.Error
+// @Router /api/v1/guest-profiles/{id} [get]
+func (h *BookingHandler) GetGuestProfile(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	id := chi.URLParam(r, "id")
+	resp, err := h.bookingClient.GetGuestProfile(ctx, &bookingpb.GetGuestProfileRequest{Id: id})
+	if err != nil {
+		logger.Log.Error("failed to get guest profile", "error", err, "profile_id", id)
+		err = mapper.GRPCToDomainError(err)
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	h.respondWithJSON(w, http.StatusOK, mapper.ProtoToGuestProfile(resp.Profile))
+}
+
+// @Summary Update guest profile
+// @Description Replaces the guest name and preferences. Admin only
+// @Tags guest-profiles
+// @Accept json
+// @Produce json
+// @Param id path string true "Guest profile ID"
+// @Param request body request.GuestProfileRequest true "Guest profile"
+// @Success 200 {object} response.GuestProfile
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Error
+// @Failure 403 {object} response.Error
+// @Failure 404 {object} response.Error
+// @Failure 500 {object} response.Error
+// @Router /api/v1/guest-profiles/{id} [put]
+func (h *BookingHandler) UpdateGuestProfile(w http.ResponseWriter, r *http.Request) {
+	var req request.GuestProfileRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Log.Error("failed to decode request body", "error", err)
+		h.respondWithError(
+			w, http.StatusBadRequest,
+			errors.WithMessage(errors.ErrInvalidInput, "invalid request body"),
+		)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	id := chi.URLParam(r, "id")
+	resp, err := h.bookingClient.UpdateGuestProfile(ctx, mapper.UpdateGuestProfileRequestToProto(id, req))
+	if err != nil {
+		logger.Log.Error("failed to update guest profile", "error", err, "profile_id", id)
+		err = mapper.GRPCToDomainError(err)
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	h.respondWithJSON(w, http.StatusOK, mapper.ProtoToGuestProfile(resp.Profile))
+}
+
+// @Summary Merge guest profiles
+// @Description Moves contacts and bookings of duplicate profiles into the profile and deletes the duplicates. Empty preferences are filled from the duplicates. Admin only
+// @Tags guest-profiles
+// @Accept json
+// @Produce json
+// @Param id path string true "Guest profile ID to keep"
+// @Param request body request.MergeGuestProfilesRequest true "Duplicate profiles"
+// @Success 200 {object} response.GuestProfile
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Error
+// @Failure 403 {object} response.Error
+// @Failure 404 {object} response.Error
+// @Failure 500 {object} response.Error
+// @Router /api/v1/guest-profiles/{id}/merge [post]
+func (h *BookingHandler) MergeGuestProfiles(w http.ResponseWriter, r *http.Request) {
+	var req request.MergeGuestProfilesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Log.Error("failed to decode request body", "error", err)
+		h.respondWithError(
+			w, http.StatusBadRequest,
+			errors.WithMessage(errors.ErrInvalidInput, "invalid request body"),
+		)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	id := chi.URLParam(r, "id")
+	resp, err := h.bookingClient.MergeGuestProfiles(
+		ctx, &bookingpb.MergeGuestProfilesRequest{
+			Id:        id,
+			SourceIds: req.SourceIDs,
+		},
+	)
+	if err != nil {
+		logger.Log.Error("failed to merge guest profiles", "error", err, "profile_id", id)
+		err = mapper.GRPCToDomainError(err)
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	logger.Log.Info("guest profiles merged", "profile_id", id, "sources", req.SourceIDs)
+	h.respondWithJSON(w, http.StatusOK, mapper.ProtoToGuestProfile(resp.Profile))
+}
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/semho/hotel-booking/api-gateway/internal/api/http/mapper"
+	"github.com/semho/hotel-booking/pkg/errors"
+	"github.com/semho/hotel-booking/pkg/logger"
+	bookingpb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// @Summary Oversold nights report
+// @Description Returns nights on which a room type is sold beyond its room count thanks to the overbooking allowance, with the number of bookings still waiting for a room. Admin only
+// @Tags reports
+// @Produce json
+// @Param from query string false "First night (YYYY-MM-DD), defaults to today"
+// @Param to query string false "Night after the last one (YYYY-MM-DD), defaults to 90 days after from"
+// @Success 200 {array} response.OversoldNight
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Error
+// @Failure 403 {object} response.Error
+// @Failure 500 {object} response.Error
+// @Router /api/v1/reports/oversold-nights [get]
+func (h *BookingHandler) GetOversoldNights(w http.ResponseWriter, r *http.Request) {
+	req, err := parseOversoldNightsRequest(r)
+	if err != nil {
+		h.respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.bookingClient.GetOversoldNights(ctx, req)
+	if err != nil {
+		logger.Log.Error("failed to get oversold nights", "error", err)
+		err = mapper.GRPCToDomainError(err)
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	h.respondWithJSON(w, http.StatusOK, mapper.ProtoToOversoldNights(resp.Nights))
+}
+
+func parseOversoldNightsRequest(r *http.Request) (*bookingpb.GetOversoldNightsRequest, error) {
+	req := &bookingpb.GetOversoldNightsRequest{}
+	query := r.URL.Query()
+
+	if from := query.Get("from"); from != "" {
+		t, err := time.Parse("2006-01-02", from)
+		if err != nil {
+			return nil, errors.WithMessage(errors.ErrInvalidInput, "invalid 'from' date format")
+		}
+		req.From = timestamppb.New(t)
+	}
+
+	if to := query.Get("to"); to != "" {
+		t, err := time.Parse("2006-01-02", to)
+		if err != nil {
+			return nil, errors.WithMessage(errors.ErrInvalidInput, "invalid 'to' date format")
+		}
+		req.To = timestamppb.New(t)
+	}
+
+	return req, nil
+}
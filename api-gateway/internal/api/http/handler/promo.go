@@ -0,0 +1,199 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/semho/hotel-booking/api-gateway/internal/api/http/mapper"
+	"github.com/semho/hotel-booking/api-gateway/internal/api/http/request"
+	"github.com/semho/hotel-booking/pkg/errors"
+	"github.com/semho/hotel-booking/pkg/logger"
+	bookingpb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
+)
+
+// @Summary Create promo code
+// @Description Creates an active promo code with a percentage or fixed discount. Admin only
+// @Tags promo-codes
+// @Accept json
+// @Produce json
+// @Param request body request.CreatePromoCodeRequest true "Promo code"
+// @Success 201 {object} response.PromoCode
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Error
+// @Failure 403 {object} response.Error
+// @Failure 409 {object} response.Error
+// @Failure 500 {object} response.Error
+// @Router /api/v1/promo-codes [post]
+func (h *BookingHandler) CreatePromoCode(w http.ResponseWriter, r *http.Request) {
+	var req request.CreatePromoCodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Log.Error("failed to decode request body", "error", err)
+		h.respondWithError(
+			w, http.StatusBadRequest,
+			errors.WithMessage(errors.ErrInvalidInput, "invalid request body"),
+		)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	protoReq, err := mapper.CreatePromoCodeRequestToProto(req)
+	if err != nil {
+		h.respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.bookingClient.CreatePromoCode(ctx, protoReq)
+	if err != nil {
+		logger.Log.Error("failed to create promo code", "error", err, "code", req.Code)
+		err = mapper.GRPCToDomainError(err)
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	h.respondWithJSON(w, http.StatusCreated, mapper.ProtoToPromoCode(resp.PromoCode))
+}
+
+// @Summary List promo codes
+// @Description Returns promo codes, newest first. Admin only
+// @Tags promo-codes
+// @Produce json
+// @Param activeOnly query boolean false "Only active promo codes"
+// @Success 200 {array} response.PromoCode
+// @Failure 401 {object} response.Error
+// @Failure 403 {object} response.Error
+// @Failure 500 {object} response.Error
+// @Router /api/v1/promo-codes [get]
+func (h *BookingHandler) ListPromoCodes(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.bookingClient.ListPromoCodes(
+		ctx, &bookingpb.ListPromoCodesRequest{
+			ActiveOnly: r.URL.Query().Get("activeOnly") == "true",
+		},
+	)
+	if err != nil {
+		logger.Log.Error("failed to list promo codes", "error", err)
+		err = mapper.GRPCToDomainError(err)
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	h.respondWithJSON(w, http.StatusOK, mapper.ProtoToPromoCodes(resp.PromoCodes))
+}
+
+// @Summary Get promo code
+// @Description Returns promo code with its redemption count. Admin only
+// @Tags promo-codes
+// @Produce json
+// @Param id path string true "Promo code ID"
+// @Success 200 {object} response.PromoCode
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Error
+// @Failure 403 {object} response.Error
+// @Failure 404 {object} response.Error
+// @Failure 500 {object} response.Error
+// @Router /api/v1/promo-codes/{id} [get]
+func (h *BookingHandler) GetPromoCode(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	id := chi.URLParam(r, "id")
+	resp, err := h.bookingClient.GetPromoCode(ctx, &bookingpb.GetPromoCodeRequest{Id: id})
+	if err != nil {
+		logger.Log.Error("failed to get promo code", "error", err, "promo_code_id", id)
+		err = mapper.GRPCToDomainError(err)
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	h.respondWithJSON(w, http.StatusOK, mapper.ProtoToPromoCode(resp.PromoCode))
+}
+
+// @Summary Update promo code
+// @Description Replaces discount, validity window, restrictions and limits of the promo code; the code itself cannot be changed. Admin only
+// @Tags promo-codes
+// @Accept json
+// @Produce json
+// @Param id path string true "Promo code ID"
+// @Param request body request.UpdatePromoCodeRequest true "Promo code terms"
+// @Success 200 {object} response.PromoCode
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Error
+// @Failure 403 {object} response.Error
+// @Failure 404 {object} response.Error
+// @Failure 500 {object} response.Error
+// @Router /api/v1/promo-codes/{id} [put]
+func (h *BookingHandler) UpdatePromoCode(w http.ResponseWriter, r *http.Request) {
+	var req request.UpdatePromoCodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Log.Error("failed to decode request body", "error", err)
+		h.respondWithError(
+			w, http.StatusBadRequest,
+			errors.WithMessage(errors.ErrInvalidInput, "invalid request body"),
+		)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	protoReq, err := mapper.UpdatePromoCodeRequestToProto(id, req)
+	if err != nil {
+		h.respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.bookingClient.UpdatePromoCode(ctx, protoReq)
+	if err != nil {
+		logger.Log.Error("failed to update promo code", "error", err, "promo_code_id", id)
+		err = mapper.GRPCToDomainError(err)
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	h.respondWithJSON(w, http.StatusOK, mapper.ProtoToPromoCode(resp.PromoCode))
+}
+
+// @Summary Delete promo code
+// @Description Deletes a promo code that has no redemptions; redeemed codes can only be deactivated. Admin only
+// @Tags promo-codes
+// @Param id path string true "Promo code ID"
+// @Success 204
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Error
+// @Failure 403 {object} response.Error
+// @Failure 404 {object} response.Error
+// @Failure 409 {object} response.Error
+// @Failure 500 {object} response.Error
+// @Router /api/v1/promo-codes/{id} [delete]
+func (h *BookingHandler) DeletePromoCode(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	id := chi.URLParam(r, "id")
+	if _, err := h.bookingClient.DeletePromoCode(ctx, &bookingpb.DeletePromoCodeRequest{Id: id}); err != nil {
+		logger.Log.Error("failed to delete promo code", "error", err, "promo_code_id", id)
+		err = mapper.GRPCToDomainError(err)
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,131 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/semho/hotel-booking/api-gateway/internal/api/http/mapper"
+	"github.com/semho/hotel-booking/api-gateway/internal/api/http/request"
+	"github.com/semho/hotel-booking/api-gateway/internal/constants"
+	"github.com/semho/hotel-booking/pkg/errors"
+	"github.com/semho/hotel-booking/pkg/logger"
+	authpb "github.com/semho/hotel-booking/pkg/proto/auth_v1/auth"
+	bookingpb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
+)
+
+// @Summary Create a group reservation
+// @Description Books several rooms for the same dates atomically: either all rooms are booked or none
+// @Tags reservations
+// @Accept json
+// @Produce json
+// @Param request body request.CreateReservationRequest true "Reservation data"
+// @Success 201 {object} response.Reservation
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Error
+// @Failure 404 {object} response.Error
+// @Failure 409 {object} response.Error
+// @Failure 500 {object} response.Error
+// @Router /api/v1/reservations [post]
+func (h *BookingHandler) CreateReservation(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := r.Context().Value(constants.USER).(*authpb.UserInfo)
+	if !ok {
+		h.respondWithError(w, http.StatusUnauthorized, errors.ErrUnauthorized)
+		return
+	}
+
+	var req request.CreateReservationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Log.Error("failed to decode request body", "error", err)
+		h.respondWithError(
+			w, http.StatusBadRequest,
+			errors.WithMessage(errors.ErrInvalidInput, "invalid request body"),
+		)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	protoReq, err := mapper.CreateReservationRequestToProto(req, userInfo)
+	if err != nil {
+		h.respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.bookingClient.CreateReservation(ctx, protoReq)
+	if err != nil {
+		logger.Log.Error("failed to create reservation", "error", err, "user_id", userInfo.Id)
+		err = mapper.GRPCToDomainError(err)
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	logger.Log.Info(
+		"reservation created",
+		"user_id", userInfo.Id,
+		"reservation_id", resp.Reservation.Id,
+		"rooms", len(resp.Reservation.Bookings),
+	)
+
+	h.respondWithJSON(w, http.StatusCreated, mapper.ProtoToReservation(resp.Reservation))
+}
+
+// @Summary Get group reservation by ID
+// @Description Returns reservation with all its room bookings. Non-admin users can only see their own reservations
+// @Tags reservations
+// @Produce json
+// @Param id path string true "Reservation ID"
+// @Success 200 {object} response.Reservation
+// @Failure 401 {object} response.Error
+// @Failure 403 {object} response.Error
+// @Failure 404 {object} response.Error
+// @Failure 500 {object} response.Error
+// @Router /api/v1/reservations/{id} [get]
+func (h *BookingHandler) GetReservation(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := r.Context().Value(constants.USER).(*authpb.UserInfo)
+	if !ok {
+		h.respondWithError(w, http.StatusUnauthorized, errors.ErrUnauthorized)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	reservationID := chi.URLParam(r, "id")
+	resp, err := h.bookingClient.GetReservation(
+		ctx, &bookingpb.GetReservationRequest{
+			ReservationId: reservationID,
+		},
+	)
+	if err != nil {
+		logger.Log.Error("failed to get reservation", "error", err, "reservation_id", reservationID)
+		err = mapper.GRPCToDomainError(err)
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	reservation := resp.Reservation
+	if userInfo.Role != authpb.UserRole_USER_ROLE_ADMIN &&
+		(reservation.UserId == nil || *reservation.UserId != userInfo.Id) {
+		logger.Log.Info(
+			"access to foreign reservation denied",
+			"user_id", userInfo.Id,
+			"reservation_id", reservationID,
+		)
+		h.respondWithError(
+			w, http.StatusForbidden,
+			errors.WithMessage(errors.ErrForbidden, "reservation belongs to another user"),
+		)
+		return
+	}
+
+	h.respondWithJSON(w, http.StatusOK, mapper.ProtoToReservation(reservation))
+}
@@ -0,0 +1,237 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/semho/hotel-booking/api-gateway/internal/api/http/mapper"
+	"github.com/semho/hotel-booking/api-gateway/internal/api/http/request"
+	"github.com/semho/hotel-booking/pkg/errors"
+	"github.com/semho/hotel-booking/pkg/logger"
+	bookingpb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
+	roompb "github.com/semho/hotel-booking/pkg/proto/room_v1/room"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// @Summary Create stay restriction
+// @Description Creates a restriction for a date range and room type: minimum/maximum stay, closed to arrival/departure, advance booking window. Admin only
+// @Tags stay-restrictions
+// @Accept json
+// @Produce json
+// @Param request body request.StayRestrictionRequest true "Stay restriction"
+// @Success 201 {object} response.StayRestriction
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Error
+// @Failure 403 {object} response.Error
+// @Failure 500 {object} response.Error
+// @Router /api/v1/stay-restrictions [post]
+func (h *BookingHandler) CreateStayRestriction(w http.ResponseWriter, r *http.Request) {
+	var req request.StayRestrictionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Log.Error("failed to decode request body", "error", err)
+		h.respondWithError(
+			w, http.StatusBadRequest,
+			errors.WithMessage(errors.ErrInvalidInput, "invalid request body"),
+		)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	protoReq, err := mapper.CreateStayRestrictionRequestToProto(req)
+	if err != nil {
+		h.respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.bookingClient.CreateStayRestriction(ctx, protoReq)
+	if err != nil {
+		logger.Log.Error("failed to create stay restriction", "error", err, "name", req.Name)
+		err = mapper.GRPCToDomainError(err)
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	h.respondWithJSON(w, http.StatusCreated, mapper.ProtoToStayRestriction(resp.Restriction))
+}
+
+// @Summary List stay restrictions
+// @Description Returns restrictions overlapping the date range, ordered by start date. Admin only
+// @Tags stay-restrictions
+// @Produce json
+// @Param from query string false "First date (YYYY-MM-DD)"
+// @Param to query string false "Last date (YYYY-MM-DD)"
+// @Param type query string false "Room type: restrictions of the type and of all types"
+// @Success 200 {array} response.StayRestriction
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Error
+// @Failure 403 {object} response.Error
+// @Failure 500 {object} response.Error
+// @Router /api/v1/stay-restrictions [get]
+func (h *BookingHandler) ListStayRestrictions(w http.ResponseWriter, r *http.Request) {
+	req, err := parseListStayRestrictionsRequest(r)
+	if err != nil {
+		h.respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.bookingClient.ListStayRestrictions(ctx, req)
+	if err != nil {
+		logger.Log.Error("failed to list stay restrictions", "error", err)
+		err = mapper.GRPCToDomainError(err)
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	h.respondWithJSON(w, http.StatusOK, mapper.ProtoToStayRestrictions(resp.Restrictions))
+}
+
+// @Summary Get stay restriction
+// @Description Returns a stay restriction. Admin only
+// @Tags stay-restrictions
+// @Produce json
+// @Param id path string true "Stay restriction ID"
+// @Success 200 {object} response.StayRestriction
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Error
+// @Failure 403 {object} response.Error
+// @Failure 404 {object} response.Error
+// @Failure 500 {object} response.Error
+// @Router /api/v1/stay-restrictions/{id} [get]
+func (h *BookingHandler) GetStayRestriction(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	id := chi.URLParam(r, "id")
+	resp, err := h.bookingClient.GetStayRestriction(ctx, &bookingpb.GetStayRestrictionRequest{Id: id})
+	if err != nil {
+		logger.Log.Error("failed to get stay restriction", "error", err, "restriction_id", id)
+		err = mapper.GRPCToDomainError(err)
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	h.respondWithJSON(w, http.StatusOK, mapper.ProtoToStayRestriction(resp.Restriction))
+}
+
+// @Summary Update stay restriction
+// @Description Replaces all terms of the stay restriction. Admin only
+// @Tags stay-restrictions
+// @Accept json
+// @Produce json
+// @Param id path string true "Stay restriction ID"
+// @Param request body request.StayRestrictionRequest true "Stay restriction"
+// @Success 200 {object} response.StayRestriction
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Error
+// @Failure 403 {object} response.Error
+// @Failure 404 {object} response.Error
+// @Failure 500 {object} response.Error
+// @Router /api/v1/stay-restrictions/{id} [put]
+func (h *BookingHandler) UpdateStayRestriction(w http.ResponseWriter, r *http.Request) {
+	var req request.StayRestrictionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Log.Error("failed to decode request body", "error", err)
+		h.respondWithError(
+			w, http.StatusBadRequest,
+			errors.WithMessage(errors.ErrInvalidInput, "invalid request body"),
+		)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	protoReq, err := mapper.UpdateStayRestrictionRequestToProto(id, req)
+	if err != nil {
+		h.respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.bookingClient.UpdateStayRestriction(ctx, protoReq)
+	if err != nil {
+		logger.Log.Error("failed to update stay restriction", "error", err, "restriction_id", id)
+		err = mapper.GRPCToDomainError(err)
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	h.respondWithJSON(w, http.StatusOK, mapper.ProtoToStayRestriction(resp.Restriction))
+}
+
+// @Summary Delete stay restriction
+// @Description Deletes a stay restriction. Admin only
+// @Tags stay-restrictions
+// @Param id path string true "Stay restriction ID"
+// @Success 204
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Error
+// @Failure 403 {object} response.Error
+// @Failure 404 {object} response.Error
+// @Failure 500 {object} response.Error
+// @Router /api/v1/stay-restrictions/{id} [delete]
+func (h *BookingHandler) DeleteStayRestriction(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	id := chi.URLParam(r, "id")
+	_, err := h.bookingClient.DeleteStayRestriction(ctx, &bookingpb.DeleteStayRestrictionRequest{Id: id})
+	if err != nil {
+		logger.Log.Error("failed to delete stay restriction", "error", err, "restriction_id", id)
+		err = mapper.GRPCToDomainError(err)
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func parseListStayRestrictionsRequest(r *http.Request) (*bookingpb.ListStayRestrictionsRequest, error) {
+	req := &bookingpb.ListStayRestrictionsRequest{}
+	query := r.URL.Query()
+
+	if from := query.Get("from"); from != "" {
+		t, err := time.Parse("2006-01-02", from)
+		if err != nil {
+			return nil, errors.WithMessage(errors.ErrInvalidInput, "invalid 'from' date format")
+		}
+		req.From = timestamppb.New(t)
+	}
+
+	if to := query.Get("to"); to != "" {
+		t, err := time.Parse("2006-01-02", to)
+		if err != nil {
+			return nil, errors.WithMessage(errors.ErrInvalidInput, "invalid 'to' date format")
+		}
+		req.To = timestamppb.New(t)
+	}
+
+	if roomType := query.Get("type"); roomType != "" {
+		val, ok := roompb.RoomType_value[roomType]
+		if !ok {
+			return nil, errors.WithMessage(errors.ErrInvalidInput, "invalid room type")
+		}
+		t := roompb.RoomType(val)
+		req.RoomType = &t
+	}
+
+	return req, nil
+}
@@ -0,0 +1,254 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/semho/hotel-booking/api-gateway/internal/api/http/mapper"
+	"github.com/semho/hotel-booking/api-gateway/internal/api/http/request"
+	"github.com/semho/hotel-booking/api-gateway/internal/constants"
+	"github.com/semho/hotel-booking/pkg/errors"
+	"github.com/semho/hotel-booking/pkg/logger"
+	authpb "github.com/semho/hotel-booking/pkg/proto/auth_v1/auth"
+	bookingpb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
+)
+
+// @Summary Join waitlist
+// @Description Registers interest in sold-out dates. When a matching room is freed by a cancellation or an expired hold, the first guest in the queue gets a PENDING booking to confirm before offerExpiresAt. If a room is already free, the booking is offered right away
+// @Tags waitlist
+// @Accept json
+// @Produce json
+// @Param request body request.JoinWaitlistRequest true "Dates and room criteria"
+// @Success 201 {object} response.WaitlistEntry
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Error
+// @Failure 500 {object} response.Error
+// @Router /api/v1/waitlist [post]
+func (h *BookingHandler) JoinWaitlist(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := r.Context().Value(constants.USER).(*authpb.UserInfo)
+	if !ok {
+		h.respondWithError(w, http.StatusUnauthorized, errors.ErrUnauthorized)
+		return
+	}
+
+	var req request.JoinWaitlistRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Log.Error("failed to decode request body", "error", err)
+		h.respondWithError(
+			w, http.StatusBadRequest,
+			errors.WithMessage(errors.ErrInvalidInput, "invalid request body"),
+		)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	protoReq, err := mapper.JoinWaitlistRequestToProto(req, userInfo)
+	if err != nil {
+		h.respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.bookingClient.JoinWaitlist(ctx, protoReq)
+	if err != nil {
+		logger.Log.Error("failed to join waitlist", "error", err, "user_id", userInfo.Id)
+		err = mapper.GRPCToDomainError(err)
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	logger.Log.Info("waitlist joined", "user_id", userInfo.Id, "entry_id", resp.Entry.Id)
+
+	h.respondWithJSON(w, http.StatusCreated, mapper.ProtoToWaitlistEntry(resp.Entry))
+}
+
+// @Summary Get waitlist entry
+// @Description Returns waitlist entry with the offered booking, if any. Non-admin users can only see their own entries
+// @Tags waitlist
+// @Produce json
+// @Param id path string true "Waitlist entry ID"
+// @Success 200 {object} response.WaitlistEntry
+// @Failure 401 {object} response.Error
+// @Failure 403 {object} response.Error
+// @Failure 404 {object} response.Error
+// @Failure 500 {object} response.Error
+// @Router /api/v1/waitlist/{id} [get]
+func (h *BookingHandler) GetWaitlistEntry(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := r.Context().Value(constants.USER).(*authpb.UserInfo)
+	if !ok {
+		h.respondWithError(w, http.StatusUnauthorized, errors.ErrUnauthorized)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	entry, err := h.getAccessibleWaitlistEntry(ctx, chi.URLParam(r, "id"), userInfo)
+	if err != nil {
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	h.respondWithJSON(w, http.StatusOK, mapper.ProtoToWaitlistEntry(entry))
+}
+
+// @Summary Leave waitlist
+// @Description Removes a waiting entry from the queue. An entry that has already got an offer cannot leave: cancel the offered booking instead. Non-admin users can only remove their own entries
+// @Tags waitlist
+// @Produce json
+// @Param id path string true "Waitlist entry ID"
+// @Success 200 {object} response.WaitlistEntry
+// @Failure 401 {object} response.Error
+// @Failure 403 {object} response.Error
+// @Failure 404 {object} response.Error
+// @Failure 409 {object} response.Error
+// @Failure 500 {object} response.Error
+// @Router /api/v1/waitlist/{id} [delete]
+func (h *BookingHandler) LeaveWaitlist(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := r.Context().Value(constants.USER).(*authpb.UserInfo)
+	if !ok {
+		h.respondWithError(w, http.StatusUnauthorized, errors.ErrUnauthorized)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	entryID := chi.URLParam(r, "id")
+	if _, err := h.getAccessibleWaitlistEntry(ctx, entryID, userInfo); err != nil {
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	resp, err := h.bookingClient.LeaveWaitlist(ctx, &bookingpb.LeaveWaitlistRequest{Id: entryID})
+	if err != nil {
+		logger.Log.Error("failed to leave waitlist", "error", err, "entry_id", entryID)
+		err = mapper.GRPCToDomainError(err)
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	h.respondWithJSON(w, http.StatusOK, mapper.ProtoToWaitlistEntry(resp.Entry))
+}
+
+// @Summary List waitlist entries
+// @Description Returns waitlist entries in queue order. Admin only
+// @Tags waitlist
+// @Produce json
+// @Param userId query string false "User ID"
+// @Param status query string false "Entry status (WAITING, OFFERED, EXPIRED, CANCELLED)"
+// @Success 200 {array} response.WaitlistEntry
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Error
+// @Failure 403 {object} response.Error
+// @Failure 500 {object} response.Error
+// @Router /api/v1/waitlist [get]
+func (h *BookingHandler) ListWaitlistEntries(w http.ResponseWriter, r *http.Request) {
+	req, err := parseListWaitlistRequest(r)
+	if err != nil {
+		h.respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if userID := r.URL.Query().Get("userId"); userID != "" {
+		req.UserId = &userID
+	}
+
+	h.listWaitlistEntries(w, r, req)
+}
+
+// @Summary List my waitlist entries
+// @Description Returns waitlist entries of the authenticated user in queue order
+// @Tags waitlist
+// @Produce json
+// @Param status query string false "Entry status (WAITING, OFFERED, EXPIRED, CANCELLED)"
+// @Success 200 {array} response.WaitlistEntry
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Error
+// @Failure 500 {object} response.Error
+// @Router /api/v1/me/waitlist [get]
+func (h *BookingHandler) ListMyWaitlistEntries(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := r.Context().Value(constants.USER).(*authpb.UserInfo)
+	if !ok {
+		h.respondWithError(w, http.StatusUnauthorized, errors.ErrUnauthorized)
+		return
+	}
+
+	req, err := parseListWaitlistRequest(r)
+	if err != nil {
+		h.respondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Пользователь всегда видит только свои записи
+	userID := userInfo.Id
+	req.UserId = &userID
+
+	h.listWaitlistEntries(w, r, req)
+}
+
+func (h *BookingHandler) listWaitlistEntries(
+	w http.ResponseWriter,
+	r *http.Request,
+	req *bookingpb.ListWaitlistEntriesRequest,
+) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.bookingClient.ListWaitlistEntries(ctx, req)
+	if err != nil {
+		logger.Log.Error("failed to list waitlist entries", "error", err)
+		err = mapper.GRPCToDomainError(err)
+		h.respondWithError(w, mapper.DomainErrorToHTTPStatus(err), err)
+		return
+	}
+
+	h.respondWithJSON(w, http.StatusOK, mapper.ProtoToWaitlistEntries(resp.Entries))
+}
+
+// getAccessibleWaitlistEntry загружает запись листа ожидания и проверяет, что она принадлежит пользователю
+// (администратор видит все)
+func (h *BookingHandler) getAccessibleWaitlistEntry(
+	ctx context.Context,
+	entryID string,
+	userInfo *authpb.UserInfo,
+) (*bookingpb.WaitlistEntry, error) {
+	resp, err := h.bookingClient.GetWaitlistEntry(ctx, &bookingpb.GetWaitlistEntryRequest{Id: entryID})
+	if err != nil {
+		logger.Log.Error("failed to get waitlist entry", "error", err, "entry_id", entryID)
+		return nil, mapper.GRPCToDomainError(err)
+	}
+
+	entry := resp.Entry
+	if userInfo.Role != authpb.UserRole_USER_ROLE_ADMIN && entry.UserId != userInfo.Id {
+		logger.Log.Info(
+			"access to foreign waitlist entry denied",
+			"user_id", userInfo.Id,
+			"entry_id", entryID,
+		)
+		return nil, errors.WithMessage(errors.ErrForbidden, "waitlist entry belongs to another user")
+	}
+
+	return entry, nil
+}
+
+func parseListWaitlistRequest(r *http.Request) (*bookingpb.ListWaitlistEntriesRequest, error) {
+	req := &bookingpb.ListWaitlistEntriesRequest{}
+	if value := r.URL.Query().Get("status"); value != "" {
+		status, ok := mapper.StringToWaitlistStatus(value)
+		if !ok {
+			return nil, errors.WithMessage(errors.ErrInvalidInput, "invalid waitlist status")
+		}
+		req.Status = &status
+	}
+	return req, nil
+}
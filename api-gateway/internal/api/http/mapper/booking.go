@@ -1,12 +1,20 @@
 package mapper
 
 import (
+	"strings"
+	"time"
+
+	"github.com/semho/hotel-booking/api-gateway/internal/api/http/request"
 	"github.com/semho/hotel-booking/api-gateway/internal/api/http/response"
+	"github.com/semho/hotel-booking/pkg/errors"
+	authpb "github.com/semho/hotel-booking/pkg/proto/auth_v1/auth"
+	bookingpb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
 	roompb "github.com/semho/hotel-booking/pkg/proto/room_v1/room"
 	"google.golang.org/protobuf/types/known/timestamppb"
-	"time"
 )
 
+const dateLayout = "2006-01-02"
+
 func TimeToProtoTimestamp(t time.Time) *timestamppb.Timestamp {
 	return timestamppb.New(t)
 }
@@ -19,9 +27,278 @@ func ProtoToAvailableRooms(protoRooms []*roompb.Room) []response.AvailableRoom {
 			RoomNumber: pr.RoomNumber,
 			Type:       pr.Type,
 			Price:      pr.Price,
+			Currency:   pr.Currency,
 			Capacity:   int(pr.Capacity),
 			Amenities:  pr.Amenities,
 		}
 	}
 	return rooms
 }
+
+// CreateBookingRequestToProto собирает gRPC запрос на бронирование, user_id берется из провалидированного токена
+func CreateBookingRequestToProto(
+	req request.CreateBookingRequest,
+	userInfo *authpb.UserInfo,
+) (*bookingpb.CreateBookingRequest, error) {
+	checkIn, err := time.Parse(dateLayout, req.CheckIn)
+	if err != nil {
+		return nil, errors.WithMessage(errors.ErrInvalidInput, "invalid check-in date format")
+	}
+
+	checkOut, err := time.Parse(dateLayout, req.CheckOut)
+	if err != nil {
+		return nil, errors.WithMessage(errors.ErrInvalidInput, "invalid check-out date format")
+	}
+
+	if !checkOut.After(checkIn) {
+		return nil, errors.WithMessage(errors.ErrInvalidInput, "check-out date must be after check-in date")
+	}
+
+	var roomType *roompb.RoomType
+	if req.Type != nil {
+		val, ok := roompb.RoomType_value[*req.Type]
+		if !ok {
+			return nil, errors.WithMessage(errors.ErrInvalidInput, "invalid room type")
+		}
+		t := roompb.RoomType(val)
+		roomType = &t
+	}
+
+	// Комната, выбранная гостем из списка доступных
+	var roomID *string
+	if req.RoomID != "" {
+		roomID = &req.RoomID
+	}
+
+	userID := userInfo.Id
+
+	return &bookingpb.CreateBookingRequest{
+		RoomId:     roomID,
+		CheckIn:    TimeToProtoTimestamp(checkIn),
+		CheckOut:   TimeToProtoTimestamp(checkOut),
+		Capacity:   req.Capacity,
+		Type:       roomType,
+		UserId:     &userID,
+		GuestName:  req.GuestName,
+		GuestEmail: req.GuestEmail,
+		GuestPhone: req.GuestPhone,
+		PromoCode:  req.PromoCode,
+	}, nil
+}
+
+func ProtoToCreateBookingResponse(
+	booking *bookingpb.Booking,
+	userInfo *authpb.UserInfo,
+) response.CreateBookingResponse {
+	return response.CreateBookingResponse{
+		ID:       booking.Id,
+		RoomID:   booking.RoomId,
+		RoomType: optionalRoomType(booking.RoomType),
+		UserInfo: &response.UserInfo{
+			ID:        userInfo.Id,
+			Email:     userInfo.Email,
+			FirstName: userInfo.FirstName,
+			LastName:  userInfo.LastName,
+			Role:      userInfo.Role.String(),
+		},
+		CheckIn:    booking.CheckIn.AsTime(),
+		CheckOut:   booking.CheckOut.AsTime(),
+		TotalPrice: ProtoToMoney(booking.TotalPrice),
+		Status:     BookingStatusToString(booking.CurrentStatus),
+		Message:    "Booking created successfully",
+
+		HoldExpiresAt:  optionalTime(booking.HoldExpiresAt),
+		PriceBreakdown: ProtoToNightPrices(booking.PriceBreakdown),
+		PromoCode:      booking.PromoCode,
+	}
+}
+
+func optionalRoomType(roomType roompb.RoomType) *string {
+	if roomType == roompb.RoomType_ROOM_TYPE_UNSPECIFIED {
+		return nil
+	}
+	name := roomType.String()
+	return &name
+}
+
+func optionalTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+// ModifyBookingRequestToProto собирает gRPC запрос на изменение брони, changed_by берется из токена
+func ModifyBookingRequestToProto(
+	req request.ModifyBookingRequest,
+	bookingID string,
+	userInfo *authpb.UserInfo,
+) (*bookingpb.ModifyBookingRequest, error) {
+	protoReq := &bookingpb.ModifyBookingRequest{
+		BookingId:  bookingID,
+		GuestName:  req.GuestName,
+		GuestEmail: req.GuestEmail,
+		GuestPhone: req.GuestPhone,
+		ChangedBy:  userInfo.Id,
+	}
+
+	if req.CheckIn != nil {
+		checkIn, err := time.Parse(dateLayout, *req.CheckIn)
+		if err != nil {
+			return nil, errors.WithMessage(errors.ErrInvalidInput, "invalid check-in date format")
+		}
+		protoReq.CheckIn = TimeToProtoTimestamp(checkIn)
+	}
+
+	if req.CheckOut != nil {
+		checkOut, err := time.Parse(dateLayout, *req.CheckOut)
+		if err != nil {
+			return nil, errors.WithMessage(errors.ErrInvalidInput, "invalid check-out date format")
+		}
+		protoReq.CheckOut = TimeToProtoTimestamp(checkOut)
+	}
+
+	if req.Type != nil {
+		val, ok := roompb.RoomType_value[*req.Type]
+		if !ok {
+			return nil, errors.WithMessage(errors.ErrInvalidInput, "invalid room type")
+		}
+		t := roompb.RoomType(val)
+		protoReq.Type = &t
+	}
+
+	return protoReq, nil
+}
+
+// BookingStatusToString отдает статус без префикса enum: BOOKING_STATUS_PENDING -> PENDING
+func BookingStatusToString(status bookingpb.BookingStatus) string {
+	return strings.TrimPrefix(status.String(), "BOOKING_STATUS_")
+}
+
+func ProtoToBooking(booking *bookingpb.Booking) response.Booking {
+	allowedTransitions := make([]string, len(booking.AllowedTransitions))
+	for i, status := range booking.AllowedTransitions {
+		allowedTransitions[i] = BookingStatusToString(status)
+	}
+
+	return response.Booking{
+		ID:         booking.Id,
+		RoomID:     booking.RoomId,
+		RoomType:   optionalRoomType(booking.RoomType),
+		UserID:     booking.UserId,
+		GuestName:  booking.GuestName,
+		GuestEmail: booking.GuestEmail,
+		GuestPhone: booking.GuestPhone,
+		CheckIn:    booking.CheckIn.AsTime(),
+		CheckOut:   booking.CheckOut.AsTime(),
+		TotalPrice: ProtoToMoney(booking.TotalPrice),
+		Status:     BookingStatusToString(booking.CurrentStatus),
+		CreatedAt:  booking.CreatedAt.AsTime(),
+
+		AllowedTransitions:  allowedTransitions,
+		HoldExpiresAt:       optionalTime(booking.HoldExpiresAt),
+		RoomNumber:          booking.RoomNumber,
+		CheckedInAt:         optionalTime(booking.CheckedInAt),
+		CheckedOutAt:        optionalTime(booking.CheckedOutAt),
+		CancellationPenalty: ProtoToOptionalMoney(booking.CancellationPenalty),
+		RefundAmount:        ProtoToOptionalMoney(booking.RefundAmount),
+		CancelledAt:         optionalTime(booking.CancelledAt),
+		ReservationID:       booking.ReservationId,
+		PriceBreakdown:      ProtoToNightPrices(booking.PriceBreakdown),
+		ConvertedTotalPrice: ProtoToOptionalMoney(booking.ConvertedTotalPrice),
+		PromoCode:           booking.PromoCode,
+		GuestProfileID:      booking.GuestProfileId,
+	}
+}
+
+func ProtoToNightPrices(nights []*bookingpb.NightPrice) []response.NightPrice {
+	result := make([]response.NightPrice, len(nights))
+	for i, night := range nights {
+		adjustments := make([]response.PriceAdjustment, len(night.Adjustments))
+		for j, adjustment := range night.Adjustments {
+			adjustments[j] = response.PriceAdjustment{
+				Rule:    adjustment.Rule,
+				Percent: adjustment.Percent,
+				Amount:  ProtoToOptionalMoney(adjustment.Amount),
+			}
+		}
+
+		result[i] = response.NightPrice{
+			Date:        night.Date.AsTime(),
+			BasePrice:   ProtoToMoney(night.BasePrice),
+			Price:       ProtoToMoney(night.Price),
+			Adjustments: adjustments,
+		}
+	}
+	return result
+}
+
+func ProtoToBookingList(resp *bookingpb.ListBookingsResponse) response.BookingList {
+	bookings := make([]response.Booking, len(resp.Bookings))
+	for i, b := range resp.Bookings {
+		bookings[i] = ProtoToBooking(b)
+	}
+	return response.BookingList{
+		Bookings:      bookings,
+		NextPageToken: resp.NextPageToken,
+	}
+}
+
+func ProtoToStatusHistory(history []*bookingpb.BookingStatusChange) []response.BookingStatusChange {
+	result := make([]response.BookingStatusChange, len(history))
+	for i, h := range history {
+		result[i] = response.BookingStatusChange{
+			ID:        h.Id,
+			Status:    BookingStatusToString(h.Status),
+			Reason:    h.Reason,
+			ChangedBy: h.ChangedBy,
+			ChangedAt: h.ChangedAt.AsTime(),
+		}
+	}
+	return result
+}
+
+func ListBookingsParamsToProto(params *request.ListBookingsParams) *bookingpb.ListBookingsRequest {
+	req := &bookingpb.ListBookingsRequest{
+		UserId:         params.UserID,
+		RoomId:         params.RoomID,
+		Status:         params.Status,
+		GuestProfileId: params.GuestProfileID,
+		PageSize:       params.PageSize,
+		PageToken:      params.PageToken,
+		Currency:       params.Currency,
+	}
+	if params.From != nil {
+		req.From = TimeToProtoTimestamp(*params.From)
+	}
+	if params.To != nil {
+		req.To = TimeToProtoTimestamp(*params.To)
+	}
+	return req
+}
+
+// StringToBookingStatus принимает статус как с префиксом enum, так и без: PENDING или BOOKING_STATUS_PENDING
+func StringToBookingStatus(value string) (bookingpb.BookingStatus, bool) {
+	name := strings.ToUpper(value)
+	if !strings.HasPrefix(name, "BOOKING_STATUS_") {
+		name = "BOOKING_STATUS_" + name
+	}
+	status, ok := bookingpb.BookingStatus_value[name]
+	if !ok || status == int32(bookingpb.BookingStatus_BOOKING_STATUS_UNSPECIFIED) {
+		return bookingpb.BookingStatus_BOOKING_STATUS_UNSPECIFIED, false
+	}
+	return bookingpb.BookingStatus(status), true
+}
+
+func ProtoToCancellationQuote(quote *bookingpb.CancellationQuote) response.CancellationQuote {
+	return response.CancellationQuote{
+		TotalPrice:            ProtoToMoney(quote.TotalPrice),
+		PenaltyPercent:        quote.PenaltyPercent,
+		Penalty:               ProtoToMoney(quote.Penalty),
+		Refund:                ProtoToMoney(quote.Refund),
+		DaysBeforeCheckIn:     quote.DaysBeforeCheckIn,
+		FreeCancellationUntil: optionalTime(quote.FreeCancellationUntil),
+		NonRefundable:         quote.NonRefundable,
+	}
+}
@@ -0,0 +1,54 @@
+package mapper
+
+import (
+	"strings"
+
+	"github.com/semho/hotel-booking/api-gateway/internal/api/http/response"
+	bookingpb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
+)
+
+// ProtoToAvailabilityCalendar переводит календарь в ответ; withBookings — показывать ли брони, занимающие комнаты
+func ProtoToAvailabilityCalendar(
+	rows []*bookingpb.AvailabilityCalendarRow,
+	withBookings bool,
+) []response.AvailabilityCalendarRow {
+	result := make([]response.AvailabilityCalendarRow, len(rows))
+	for i, row := range rows {
+		nights := make([]response.AvailabilityCalendarNight, len(row.Nights))
+		for n, night := range row.Nights {
+			nights[n] = response.AvailabilityCalendarNight{
+				Night:        night.Night.AsTime(),
+				Status:       strings.TrimPrefix(night.Status.String(), "AVAILABILITY_STATUS_"),
+				Free:         night.Free,
+				Booked:       night.Booked,
+				Blocked:      night.Blocked,
+				OutOfService: night.OutOfService,
+			}
+			if withBookings {
+				nights[n].BookingID = night.BookingId
+			}
+		}
+
+		result[i] = response.AvailabilityCalendarRow{
+			RoomID:     row.RoomId,
+			RoomNumber: row.RoomNumber,
+			RoomType:   row.RoomType.String(),
+			Nights:     nights,
+		}
+	}
+	return result
+}
+
+// StringToCalendarGrouping принимает группировку как с префиксом enum, так и без: ROOM_TYPE или
+// CALENDAR_GROUPING_ROOM_TYPE
+func StringToCalendarGrouping(value string) (bookingpb.CalendarGrouping, bool) {
+	name := strings.ToUpper(value)
+	if !strings.HasPrefix(name, "CALENDAR_GROUPING_") {
+		name = "CALENDAR_GROUPING_" + name
+	}
+	grouping, ok := bookingpb.CalendarGrouping_value[name]
+	if !ok || grouping == int32(bookingpb.CalendarGrouping_CALENDAR_GROUPING_UNSPECIFIED) {
+		return bookingpb.CalendarGrouping_CALENDAR_GROUPING_UNSPECIFIED, false
+	}
+	return bookingpb.CalendarGrouping(grouping), true
+}
@@ -0,0 +1,54 @@
+package mapper
+
+import (
+	"net/http"
+
+	"github.com/semho/hotel-booking/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GRPCToDomainError переводит gRPC статус от сервисов в доменную ошибку
+func GRPCToDomainError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return errors.WithMessage(errors.ErrInternal, err.Error())
+	}
+
+	switch st.Code() {
+	case codes.NotFound:
+		return errors.WithMessage(errors.ErrNotFound, st.Message())
+	case codes.InvalidArgument:
+		return errors.WithMessage(errors.ErrInvalidInput, st.Message())
+	case codes.AlreadyExists:
+		return errors.WithMessage(errors.ErrConflict, st.Message())
+	case codes.Unauthenticated:
+		return errors.WithMessage(errors.ErrUnauthorized, st.Message())
+	case codes.PermissionDenied:
+		return errors.WithMessage(errors.ErrForbidden, st.Message())
+	default:
+		return errors.WithMessage(errors.ErrInternal, "internal server error")
+	}
+}
+
+// DomainErrorToHTTPStatus подбирает HTTP код для доменной ошибки
+func DomainErrorToHTTPStatus(err error) int {
+	switch {
+	case errors.IsNotFound(err):
+		return http.StatusNotFound
+	case errors.IsInvalidInput(err):
+		return http.StatusBadRequest
+	case errors.IsConflict(err):
+		return http.StatusConflict
+	case errors.IsUnauthorized(err):
+		return http.StatusUnauthorized
+	case errors.IsForbidden(err):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
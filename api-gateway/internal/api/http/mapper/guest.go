@@ -0,0 +1,54 @@
+package mapper
+
+import (
+	"github.com/semho/hotel-booking/api-gateway/internal/api/http/request"
+	"github.com/semho/hotel-booking/api-gateway/internal/api/http/response"
+	bookingpb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
+)
+
+func UpdateGuestProfileRequestToProto(
+	id string,
+	req request.GuestProfileRequest,
+) *bookingpb.UpdateGuestProfileRequest {
+	return &bookingpb.UpdateGuestProfileRequest{
+		Id:             id,
+		Name:           req.Name,
+		BedType:        req.BedType,
+		PreferredFloor: req.PreferredFloor,
+		Allergies:      req.Allergies,
+	}
+}
+
+func ProtoToGuestProfile(profile *bookingpb.GuestProfile) response.GuestProfile {
+	emails := profile.Emails
+	if emails == nil {
+		emails = []string{}
+	}
+	phones := profile.Phones
+	if phones == nil {
+		phones = []string{}
+	}
+
+	return response.GuestProfile{
+		ID:             profile.Id,
+		Name:           profile.Name,
+		Emails:         emails,
+		Phones:         phones,
+		BedType:        profile.BedType,
+		PreferredFloor: profile.PreferredFloor,
+		Allergies:      profile.Allergies,
+		StayCount:      profile.StayCount,
+		LifetimeValue:  ProtoToMoney(profile.LifetimeValue),
+		LastStayAt:     optionalTime(profile.LastStayAt),
+		CreatedAt:      profile.CreatedAt.AsTime(),
+		UpdatedAt:      profile.UpdatedAt.AsTime(),
+	}
+}
+
+func ProtoToGuestProfiles(profiles []*bookingpb.GuestProfile) []response.GuestProfile {
+	result := make([]response.GuestProfile, len(profiles))
+	for i, profile := range profiles {
+		result[i] = ProtoToGuestProfile(profile)
+	}
+	return result
+}
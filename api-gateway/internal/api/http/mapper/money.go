@@ -0,0 +1,23 @@
+package mapper
+
+import (
+	"github.com/semho/hotel-booking/api-gateway/internal/api/http/response"
+	"github.com/semho/hotel-booking/pkg/money"
+	moneypb "github.com/semho/hotel-booking/pkg/proto/money_v1/money"
+)
+
+func ProtoToMoney(amount *moneypb.Money) response.Money {
+	m := money.FromProto(amount)
+	return response.Money{
+		Amount:   m.String(),
+		Currency: m.Currency,
+	}
+}
+
+func ProtoToOptionalMoney(amount *moneypb.Money) *response.Money {
+	if amount == nil {
+		return nil
+	}
+	m := ProtoToMoney(amount)
+	return &m
+}
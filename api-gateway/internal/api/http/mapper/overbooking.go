@@ -0,0 +1,22 @@
+package mapper
+
+import (
+	"github.com/semho/hotel-booking/api-gateway/internal/api/http/response"
+	bookingpb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
+)
+
+func ProtoToOversoldNights(nights []*bookingpb.OversoldNight) []response.OversoldNight {
+	result := make([]response.OversoldNight, len(nights))
+	for i, night := range nights {
+		result[i] = response.OversoldNight{
+			Night:      night.Night.AsTime(),
+			RoomType:   night.RoomType.String(),
+			Inventory:  night.Inventory,
+			Ceiling:    night.Ceiling,
+			Booked:     night.Booked,
+			Unassigned: night.Unassigned,
+			Oversold:   night.Oversold,
+		}
+	}
+	return result
+}
@@ -0,0 +1,140 @@
+package mapper
+
+import (
+	"strings"
+
+	"github.com/semho/hotel-booking/api-gateway/internal/api/http/request"
+	"github.com/semho/hotel-booking/api-gateway/internal/api/http/response"
+	"github.com/semho/hotel-booking/pkg/errors"
+	"github.com/semho/hotel-booking/pkg/money"
+	bookingpb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
+	moneypb "github.com/semho/hotel-booking/pkg/proto/money_v1/money"
+	roompb "github.com/semho/hotel-booking/pkg/proto/room_v1/room"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Разобранные условия промокода в proto-типах
+type promoTerms struct {
+	discountType   bookingpb.PromoDiscountType
+	discountAmount *moneypb.Money
+	validFrom      *timestamppb.Timestamp
+	validTo        *timestamppb.Timestamp
+	roomTypes      []roompb.RoomType
+}
+
+func parsePromoTerms(terms request.PromoCodeTerms) (*promoTerms, error) {
+	discountType, ok := StringToPromoDiscountType(terms.DiscountType)
+	if !ok {
+		return nil, errors.WithMessage(errors.ErrInvalidInput, "invalid discount type")
+	}
+
+	parsed := &promoTerms{discountType: discountType}
+	if terms.DiscountAmount != nil {
+		amount, err := money.Parse(terms.DiscountAmount.Amount, terms.DiscountAmount.Currency)
+		if err != nil {
+			return nil, err
+		}
+		parsed.discountAmount = amount.ToProto()
+	}
+	if terms.ValidFrom != nil {
+		parsed.validFrom = timestamppb.New(*terms.ValidFrom)
+	}
+	if terms.ValidTo != nil {
+		parsed.validTo = timestamppb.New(*terms.ValidTo)
+	}
+	for _, roomType := range terms.RoomTypes {
+		val, ok := roompb.RoomType_value[roomType]
+		if !ok || val == int32(roompb.RoomType_ROOM_TYPE_UNSPECIFIED) {
+			return nil, errors.WithMessage(errors.ErrInvalidInput, "invalid room type "+roomType)
+		}
+		parsed.roomTypes = append(parsed.roomTypes, roompb.RoomType(val))
+	}
+	return parsed, nil
+}
+
+func CreatePromoCodeRequestToProto(req request.CreatePromoCodeRequest) (*bookingpb.CreatePromoCodeRequest, error) {
+	terms, err := parsePromoTerms(req.PromoCodeTerms)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bookingpb.CreatePromoCodeRequest{
+		Code:                  req.Code,
+		DiscountType:          terms.discountType,
+		DiscountPercent:       req.DiscountPercent,
+		DiscountAmount:        terms.discountAmount,
+		ValidFrom:             terms.validFrom,
+		ValidTo:               terms.validTo,
+		RoomTypes:             terms.roomTypes,
+		MaxRedemptions:        req.MaxRedemptions,
+		MaxRedemptionsPerUser: req.MaxRedemptionsPerUser,
+	}, nil
+}
+
+func UpdatePromoCodeRequestToProto(
+	id string,
+	req request.UpdatePromoCodeRequest,
+) (*bookingpb.UpdatePromoCodeRequest, error) {
+	terms, err := parsePromoTerms(req.PromoCodeTerms)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bookingpb.UpdatePromoCodeRequest{
+		Id:                    id,
+		DiscountType:          terms.discountType,
+		DiscountPercent:       req.DiscountPercent,
+		DiscountAmount:        terms.discountAmount,
+		ValidFrom:             terms.validFrom,
+		ValidTo:               terms.validTo,
+		RoomTypes:             terms.roomTypes,
+		MaxRedemptions:        req.MaxRedemptions,
+		MaxRedemptionsPerUser: req.MaxRedemptionsPerUser,
+		Active:                req.Active,
+	}, nil
+}
+
+func ProtoToPromoCode(promo *bookingpb.PromoCode) response.PromoCode {
+	roomTypes := make([]string, len(promo.RoomTypes))
+	for i, roomType := range promo.RoomTypes {
+		roomTypes[i] = roomType.String()
+	}
+
+	return response.PromoCode{
+		ID:                    promo.Id,
+		Code:                  promo.Code,
+		DiscountType:          strings.TrimPrefix(promo.DiscountType.String(), "PROMO_DISCOUNT_TYPE_"),
+		DiscountPercent:       promo.DiscountPercent,
+		DiscountAmount:        ProtoToOptionalMoney(promo.DiscountAmount),
+		ValidFrom:             optionalTime(promo.ValidFrom),
+		ValidTo:               optionalTime(promo.ValidTo),
+		RoomTypes:             roomTypes,
+		MaxRedemptions:        promo.MaxRedemptions,
+		MaxRedemptionsPerUser: promo.MaxRedemptionsPerUser,
+		Redemptions:           promo.Redemptions,
+		Active:                promo.Active,
+		CreatedAt:             promo.CreatedAt.AsTime(),
+		UpdatedAt:             promo.UpdatedAt.AsTime(),
+	}
+}
+
+func ProtoToPromoCodes(promos []*bookingpb.PromoCode) []response.PromoCode {
+	result := make([]response.PromoCode, len(promos))
+	for i, promo := range promos {
+		result[i] = ProtoToPromoCode(promo)
+	}
+	return result
+}
+
+// StringToPromoDiscountType принимает тип как с префиксом enum, так и без: PERCENT или PROMO_DISCOUNT_TYPE_PERCENT
+func StringToPromoDiscountType(value string) (bookingpb.PromoDiscountType, bool) {
+	name := strings.ToUpper(value)
+	if !strings.HasPrefix(name, "PROMO_DISCOUNT_TYPE_") {
+		name = "PROMO_DISCOUNT_TYPE_" + name
+	}
+	discountType, ok := bookingpb.PromoDiscountType_value[name]
+	if !ok || discountType == int32(bookingpb.PromoDiscountType_PROMO_DISCOUNT_TYPE_UNSPECIFIED) {
+		return bookingpb.PromoDiscountType_PROMO_DISCOUNT_TYPE_UNSPECIFIED, false
+	}
+	return bookingpb.PromoDiscountType(discountType), true
+}
@@ -0,0 +1,83 @@
+package mapper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/semho/hotel-booking/api-gateway/internal/api/http/request"
+	"github.com/semho/hotel-booking/api-gateway/internal/api/http/response"
+	"github.com/semho/hotel-booking/pkg/errors"
+	authpb "github.com/semho/hotel-booking/pkg/proto/auth_v1/auth"
+	bookingpb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
+	roompb "github.com/semho/hotel-booking/pkg/proto/room_v1/room"
+)
+
+func CreateReservationRequestToProto(
+	req request.CreateReservationRequest,
+	userInfo *authpb.UserInfo,
+) (*bookingpb.CreateReservationRequest, error) {
+	checkIn, err := time.Parse(dateLayout, req.CheckIn)
+	if err != nil {
+		return nil, errors.WithMessage(errors.ErrInvalidInput, "invalid check-in date format")
+	}
+
+	checkOut, err := time.Parse(dateLayout, req.CheckOut)
+	if err != nil {
+		return nil, errors.WithMessage(errors.ErrInvalidInput, "invalid check-out date format")
+	}
+
+	if !checkOut.After(checkIn) {
+		return nil, errors.WithMessage(errors.ErrInvalidInput, "check-out date must be after check-in date")
+	}
+
+	rooms := make([]*bookingpb.ReservationRoomRequest, len(req.Rooms))
+	for i, room := range req.Rooms {
+		var roomType *roompb.RoomType
+		if room.Type != nil {
+			val, ok := roompb.RoomType_value[*room.Type]
+			if !ok {
+				return nil, errors.WithMessage(errors.ErrInvalidInput, fmt.Sprintf("room %d: invalid room type", i+1))
+			}
+			t := roompb.RoomType(val)
+			roomType = &t
+		}
+
+		rooms[i] = &bookingpb.ReservationRoomRequest{
+			RoomId:    room.RoomID,
+			Type:      roomType,
+			Capacity:  room.Capacity,
+			GuestName: room.GuestName,
+		}
+	}
+
+	userID := userInfo.Id
+
+	return &bookingpb.CreateReservationRequest{
+		CheckIn:      TimeToProtoTimestamp(checkIn),
+		CheckOut:     TimeToProtoTimestamp(checkOut),
+		UserId:       &userID,
+		ContactName:  req.ContactName,
+		ContactEmail: req.ContactEmail,
+		ContactPhone: req.ContactPhone,
+		Rooms:        rooms,
+	}, nil
+}
+
+func ProtoToReservation(reservation *bookingpb.Reservation) response.Reservation {
+	bookings := make([]response.Booking, len(reservation.Bookings))
+	for i, b := range reservation.Bookings {
+		bookings[i] = ProtoToBooking(b)
+	}
+
+	return response.Reservation{
+		ID:               reservation.Id,
+		ConfirmationCode: reservation.ConfirmationCode,
+		UserID:           reservation.UserId,
+		ContactName:      reservation.ContactName,
+		ContactEmail:     reservation.ContactEmail,
+		ContactPhone:     reservation.ContactPhone,
+		CreatedAt:        reservation.CreatedAt.AsTime(),
+		Bookings:         bookings,
+		TotalPrice:       ProtoToOptionalMoney(reservation.TotalPrice),
+	}
+}
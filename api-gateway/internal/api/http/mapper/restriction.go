@@ -0,0 +1,132 @@
+package mapper
+
+import (
+	"time"
+
+	"github.com/semho/hotel-booking/api-gateway/internal/api/http/request"
+	"github.com/semho/hotel-booking/api-gateway/internal/api/http/response"
+	"github.com/semho/hotel-booking/pkg/errors"
+	bookingpb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
+	roompb "github.com/semho/hotel-booking/pkg/proto/room_v1/room"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Разобранные даты и тип комнаты ограничения в proto-типах
+type restrictionTerms struct {
+	dateFrom *timestamppb.Timestamp
+	dateTo   *timestamppb.Timestamp
+	roomType *roompb.RoomType
+}
+
+func parseRestrictionTerms(req request.StayRestrictionRequest) (*restrictionTerms, error) {
+	dateFrom, err := time.Parse(dateLayout, req.DateFrom)
+	if err != nil {
+		return nil, errors.WithMessage(errors.ErrInvalidInput, "invalid dateFrom format")
+	}
+	dateTo, err := time.Parse(dateLayout, req.DateTo)
+	if err != nil {
+		return nil, errors.WithMessage(errors.ErrInvalidInput, "invalid dateTo format")
+	}
+	if dateTo.Before(dateFrom) {
+		return nil, errors.WithMessage(errors.ErrInvalidInput, "dateTo must not be before dateFrom")
+	}
+
+	terms := &restrictionTerms{
+		dateFrom: TimeToProtoTimestamp(dateFrom),
+		dateTo:   TimeToProtoTimestamp(dateTo),
+	}
+	if req.RoomType != nil {
+		val, ok := roompb.RoomType_value[*req.RoomType]
+		if !ok || val == int32(roompb.RoomType_ROOM_TYPE_UNSPECIFIED) {
+			return nil, errors.WithMessage(errors.ErrInvalidInput, "invalid room type")
+		}
+		roomType := roompb.RoomType(val)
+		terms.roomType = &roomType
+	}
+	return terms, nil
+}
+
+func CreateStayRestrictionRequestToProto(
+	req request.StayRestrictionRequest,
+) (*bookingpb.CreateStayRestrictionRequest, error) {
+	terms, err := parseRestrictionTerms(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bookingpb.CreateStayRestrictionRequest{
+		Name:              req.Name,
+		DateFrom:          terms.dateFrom,
+		DateTo:            terms.dateTo,
+		RoomType:          terms.roomType,
+		Weekdays:          req.Weekdays,
+		MinStay:           req.MinStay,
+		MaxStay:           req.MaxStay,
+		ClosedToArrival:   req.ClosedToArrival,
+		ClosedToDeparture: req.ClosedToDeparture,
+		MinAdvanceDays:    req.MinAdvanceDays,
+		MaxAdvanceDays:    req.MaxAdvanceDays,
+	}, nil
+}
+
+func UpdateStayRestrictionRequestToProto(
+	id string,
+	req request.StayRestrictionRequest,
+) (*bookingpb.UpdateStayRestrictionRequest, error) {
+	terms, err := parseRestrictionTerms(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bookingpb.UpdateStayRestrictionRequest{
+		Id:                id,
+		Name:              req.Name,
+		DateFrom:          terms.dateFrom,
+		DateTo:            terms.dateTo,
+		RoomType:          terms.roomType,
+		Weekdays:          req.Weekdays,
+		MinStay:           req.MinStay,
+		MaxStay:           req.MaxStay,
+		ClosedToArrival:   req.ClosedToArrival,
+		ClosedToDeparture: req.ClosedToDeparture,
+		MinAdvanceDays:    req.MinAdvanceDays,
+		MaxAdvanceDays:    req.MaxAdvanceDays,
+	}, nil
+}
+
+func ProtoToStayRestriction(restriction *bookingpb.StayRestriction) response.StayRestriction {
+	var roomType *string
+	if restriction.RoomType != nil {
+		t := restriction.RoomType.String()
+		roomType = &t
+	}
+	weekdays := restriction.Weekdays
+	if weekdays == nil {
+		weekdays = []int32{}
+	}
+
+	return response.StayRestriction{
+		ID:                restriction.Id,
+		Name:              restriction.Name,
+		DateFrom:          restriction.DateFrom.AsTime().Format(dateLayout),
+		DateTo:            restriction.DateTo.AsTime().Format(dateLayout),
+		RoomType:          roomType,
+		Weekdays:          weekdays,
+		MinStay:           restriction.MinStay,
+		MaxStay:           restriction.MaxStay,
+		ClosedToArrival:   restriction.ClosedToArrival,
+		ClosedToDeparture: restriction.ClosedToDeparture,
+		MinAdvanceDays:    restriction.MinAdvanceDays,
+		MaxAdvanceDays:    restriction.MaxAdvanceDays,
+		CreatedAt:         restriction.CreatedAt.AsTime(),
+		UpdatedAt:         restriction.UpdatedAt.AsTime(),
+	}
+}
+
+func ProtoToStayRestrictions(restrictions []*bookingpb.StayRestriction) []response.StayRestriction {
+	result := make([]response.StayRestriction, len(restrictions))
+	for i, restriction := range restrictions {
+		result[i] = ProtoToStayRestriction(restriction)
+	}
+	return result
+}
@@ -12,6 +12,7 @@ func ToHTTPRoom(protoRoom *roompb.Room) response.CreateRoomResponse {
 		Number:    protoRoom.RoomNumber,
 		Type:      protoRoom.Type,
 		Price:     protoRoom.Price,
+		Currency:  protoRoom.Currency,
 		Capacity:  int(protoRoom.Capacity),
 		Status:    protoRoom.Status,
 		Amenities: protoRoom.Amenities,
@@ -23,6 +24,7 @@ func HttpToProto(req request.CreateRoomRequest) *roompb.CreateRoomRequest {
 		RoomNumber: req.RoomNumber,
 		Type:       req.Type,
 		Price:      req.Price,
+		Currency:   req.Currency,
 		Capacity:   int32(req.Capacity),
 		Status:     req.Status,
 		Amenities:  req.Amenities,
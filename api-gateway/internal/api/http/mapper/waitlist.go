@@ -0,0 +1,99 @@
+package mapper
+
+import (
+	"strings"
+	"time"
+
+	"github.com/semho/hotel-booking/api-gateway/internal/api/http/request"
+	"github.com/semho/hotel-booking/api-gateway/internal/api/http/response"
+	"github.com/semho/hotel-booking/pkg/errors"
+	authpb "github.com/semho/hotel-booking/pkg/proto/auth_v1/auth"
+	bookingpb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
+	roompb "github.com/semho/hotel-booking/pkg/proto/room_v1/room"
+)
+
+func JoinWaitlistRequestToProto(
+	req request.JoinWaitlistRequest,
+	userInfo *authpb.UserInfo,
+) (*bookingpb.JoinWaitlistRequest, error) {
+	checkIn, err := time.Parse(dateLayout, req.CheckIn)
+	if err != nil {
+		return nil, errors.WithMessage(errors.ErrInvalidInput, "invalid check-in date format")
+	}
+
+	checkOut, err := time.Parse(dateLayout, req.CheckOut)
+	if err != nil {
+		return nil, errors.WithMessage(errors.ErrInvalidInput, "invalid check-out date format")
+	}
+
+	if !checkOut.After(checkIn) {
+		return nil, errors.WithMessage(errors.ErrInvalidInput, "check-out date must be after check-in date")
+	}
+
+	var roomType *roompb.RoomType
+	if req.Type != nil {
+		val, ok := roompb.RoomType_value[*req.Type]
+		if !ok {
+			return nil, errors.WithMessage(errors.ErrInvalidInput, "invalid room type")
+		}
+		t := roompb.RoomType(val)
+		roomType = &t
+	}
+
+	return &bookingpb.JoinWaitlistRequest{
+		UserId:     userInfo.Id,
+		CheckIn:    TimeToProtoTimestamp(checkIn),
+		CheckOut:   TimeToProtoTimestamp(checkOut),
+		Capacity:   req.Capacity,
+		Type:       roomType,
+		GuestName:  req.GuestName,
+		GuestEmail: req.GuestEmail,
+		GuestPhone: req.GuestPhone,
+	}, nil
+}
+
+func ProtoToWaitlistEntry(entry *bookingpb.WaitlistEntry) response.WaitlistEntry {
+	var roomType *string
+	if entry.Type != nil {
+		t := entry.Type.String()
+		roomType = &t
+	}
+
+	return response.WaitlistEntry{
+		ID:             entry.Id,
+		UserID:         entry.UserId,
+		GuestName:      entry.GuestName,
+		GuestEmail:     entry.GuestEmail,
+		GuestPhone:     entry.GuestPhone,
+		CheckIn:        entry.CheckIn.AsTime(),
+		CheckOut:       entry.CheckOut.AsTime(),
+		Capacity:       entry.Capacity,
+		Type:           roomType,
+		Status:         strings.TrimPrefix(entry.Status.String(), "WAITLIST_STATUS_"),
+		BookingID:      entry.BookingId,
+		OfferExpiresAt: optionalTime(entry.OfferExpiresAt),
+		CreatedAt:      entry.CreatedAt.AsTime(),
+		UpdatedAt:      entry.UpdatedAt.AsTime(),
+	}
+}
+
+func ProtoToWaitlistEntries(entries []*bookingpb.WaitlistEntry) []response.WaitlistEntry {
+	result := make([]response.WaitlistEntry, len(entries))
+	for i, entry := range entries {
+		result[i] = ProtoToWaitlistEntry(entry)
+	}
+	return result
+}
+
+// StringToWaitlistStatus принимает статус как с префиксом enum, так и без: WAITING или WAITLIST_STATUS_WAITING
+func StringToWaitlistStatus(value string) (bookingpb.WaitlistStatus, bool) {
+	name := strings.ToUpper(value)
+	if !strings.HasPrefix(name, "WAITLIST_STATUS_") {
+		name = "WAITLIST_STATUS_" + name
+	}
+	status, ok := bookingpb.WaitlistStatus_value[name]
+	if !ok || status == int32(bookingpb.WaitlistStatus_WAITLIST_STATUS_UNSPECIFIED) {
+		return bookingpb.WaitlistStatus_WAITLIST_STATUS_UNSPECIFIED, false
+	}
+	return bookingpb.WaitlistStatus(status), true
+}
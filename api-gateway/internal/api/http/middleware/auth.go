@@ -73,3 +73,28 @@ func (m *AuthMiddleware) ValidateToken(next http.Handler) http.Handler {
 		},
 	)
 }
+
+// RequireAdmin пропускает только администраторов, должен идти после ValidateToken
+func (m *AuthMiddleware) RequireAdmin(next http.Handler) http.Handler {
+	return http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			userInfo, ok := r.Context().Value(constants.USER).(*pb.UserInfo)
+			if !ok {
+				http.Error(w, "unauthorized", http.StatusUnauthorized)
+				return
+			}
+
+			if userInfo.Role != pb.UserRole_USER_ROLE_ADMIN {
+				logger.Log.Info(
+					"access denied for non-admin user",
+					"user_id", userInfo.Id,
+					"path", r.URL.Path,
+				)
+				http.Error(w, "forbidden", http.StatusForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		},
+	)
+}
@@ -20,3 +20,11 @@ type RefreshRequest struct {
 type ValidateRequest struct {
 	AccessToken string `json:"accessToken"`
 }
+
+type VerifyEmailRequest struct {
+	Token string `json:"token"`
+}
+
+type ResendEmailVerificationRequest struct {
+	Email string `json:"email"`
+}
@@ -2,6 +2,7 @@ package request
 
 import (
 	"github.com/semho/hotel-booking/pkg/errors"
+	bookingpb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
 	roompb "github.com/semho/hotel-booking/pkg/proto/room_v1/room"
 	"time"
 )
@@ -11,6 +12,8 @@ type SearchParams struct {
 	CheckOut time.Time
 	Capacity *int32
 	Type     *roompb.RoomType
+	// Валюта, в которую пересчитываются цены комнат
+	Currency *string
 }
 
 func (p *SearchParams) Validate() error {
@@ -20,7 +23,7 @@ func (p *SearchParams) Validate() error {
 	if p.CheckOut.IsZero() {
 		return errors.WithMessage(errors.ErrInvalidInput, "check-out date is required")
 	}
-	if p.CheckOut.Before(p.CheckIn) {
+	if !p.CheckOut.After(p.CheckIn) {
 		return errors.WithMessage(errors.ErrInvalidInput, "check-out date must be after check-in date")
 	}
 	if p.Capacity != nil && *p.Capacity <= 0 {
@@ -52,17 +55,145 @@ func (req *CreateBooking) Validate() error {
 	if req.CheckIn.IsZero() || req.CheckOut.IsZero() {
 		return errors.WithMessage(errors.ErrInvalidInput, "check-in and check-out dates are required")
 	}
-	if req.CheckOut.Before(req.CheckIn) {
+	if !req.CheckOut.After(req.CheckIn) {
 		return errors.WithMessage(errors.ErrInvalidInput, "check-out date must be after check-in date")
 	}
 	return nil
 }
 
 type CreateBookingRequest struct {
-	RoomID     string `json:"roomId"`
-	CheckIn    string `json:"checkIn"`
-	CheckOut   string `json:"checkOut"`
-	GuestName  string `json:"guestName"`
-	GuestEmail string `json:"guestEmail"`
-	GuestPhone string `json:"guestPhone"`
+	RoomID     string  `json:"roomId"`
+	CheckIn    string  `json:"checkIn"`
+	CheckOut   string  `json:"checkOut"`
+	GuestName  string  `json:"guestName"`
+	GuestEmail string  `json:"guestEmail"`
+	GuestPhone string  `json:"guestPhone"`
+	Capacity   *int32  `json:"capacity,omitempty"`
+	Type       *string `json:"type,omitempty"`
+	PromoCode  *string `json:"promoCode,omitempty"`
+}
+
+func (req *CreateBookingRequest) Validate() error {
+	if req.GuestName == "" {
+		return errors.WithMessage(errors.ErrInvalidInput, "guest name is required")
+	}
+	if req.GuestEmail == "" {
+		return errors.WithMessage(errors.ErrInvalidInput, "guest email is required")
+	}
+	if req.CheckIn == "" || req.CheckOut == "" {
+		return errors.WithMessage(errors.ErrInvalidInput, "check-in and check-out dates are required")
+	}
+	if req.Capacity != nil && *req.Capacity <= 0 {
+		return errors.WithMessage(errors.ErrInvalidInput, "capacity must be positive")
+	}
+	return nil
+}
+
+type ListBookingsParams struct {
+	UserID    *string
+	RoomID    *string
+	Status    *bookingpb.BookingStatus
+	From      *time.Time
+	To        *time.Time
+	PageSize  int32
+	PageToken string
+	// Валюта для convertedTotalPrice
+	Currency *string
+	// Профиль гостя, фильтр доступен только администратору
+	GuestProfileID *string
+}
+
+func (p *ListBookingsParams) Validate() error {
+	if p.From != nil && p.To != nil && !p.To.After(*p.From) {
+		return errors.WithMessage(errors.ErrInvalidInput, "'to' date must be after 'from' date")
+	}
+	if p.PageSize < 0 {
+		return errors.WithMessage(errors.ErrInvalidInput, "page size must be positive")
+	}
+	return nil
+}
+
+// Тело запроса заселения, может быть пустым
+type CheckInRequest struct {
+	// Комната для заселения, если отличается от забронированной
+	RoomID *string `json:"roomId,omitempty"`
+}
+
+// Ручное назначение комнаты брони до заезда
+type AssignRoomRequest struct {
+	RoomID string `json:"roomId"`
+}
+
+func (req *AssignRoomRequest) Validate() error {
+	if req.RoomID == "" {
+		return errors.WithMessage(errors.ErrInvalidInput, "roomId is required")
+	}
+	return nil
+}
+
+// Изменение брони, незаданные поля остаются без изменений
+type ModifyBookingRequest struct {
+	CheckIn    *string `json:"checkIn,omitempty"`
+	CheckOut   *string `json:"checkOut,omitempty"`
+	Type       *string `json:"type,omitempty"`
+	GuestName  *string `json:"guestName,omitempty"`
+	GuestEmail *string `json:"guestEmail,omitempty"`
+	GuestPhone *string `json:"guestPhone,omitempty"`
+}
+
+func (req *ModifyBookingRequest) Validate() error {
+	if req.CheckIn == nil && req.CheckOut == nil && req.Type == nil &&
+		req.GuestName == nil && req.GuestEmail == nil && req.GuestPhone == nil {
+		return errors.WithMessage(errors.ErrInvalidInput, "no changes requested")
+	}
+	if req.GuestName != nil && *req.GuestName == "" {
+		return errors.WithMessage(errors.ErrInvalidInput, "guest name cannot be empty")
+	}
+	if req.GuestEmail != nil && *req.GuestEmail == "" {
+		return errors.WithMessage(errors.ErrInvalidInput, "guest email cannot be empty")
+	}
+	return nil
+}
+
+// Тело запроса отмены брони, может быть пустым
+type CancelBookingRequest struct {
+	Reason string `json:"reason,omitempty"`
+}
+
+// Комната в составе групповой брони: конкретная комната или подбор по типу и вместимости
+type ReservationRoomRequest struct {
+	RoomID    *string `json:"roomId,omitempty"`
+	Type      *string `json:"type,omitempty"`
+	Capacity  *int32  `json:"capacity,omitempty"`
+	GuestName string  `json:"guestName,omitempty"`
+}
+
+type CreateReservationRequest struct {
+	CheckIn      string                   `json:"checkIn"`
+	CheckOut     string                   `json:"checkOut"`
+	ContactName  string                   `json:"contactName"`
+	ContactEmail string                   `json:"contactEmail"`
+	ContactPhone string                   `json:"contactPhone"`
+	Rooms        []ReservationRoomRequest `json:"rooms"`
+}
+
+func (req *CreateReservationRequest) Validate() error {
+	if req.ContactName == "" {
+		return errors.WithMessage(errors.ErrInvalidInput, "contact name is required")
+	}
+	if req.ContactEmail == "" {
+		return errors.WithMessage(errors.ErrInvalidInput, "contact email is required")
+	}
+	if req.CheckIn == "" || req.CheckOut == "" {
+		return errors.WithMessage(errors.ErrInvalidInput, "check-in and check-out dates are required")
+	}
+	if len(req.Rooms) == 0 {
+		return errors.WithMessage(errors.ErrInvalidInput, "at least one room is required")
+	}
+	for _, room := range req.Rooms {
+		if room.Capacity != nil && *room.Capacity <= 0 {
+			return errors.WithMessage(errors.ErrInvalidInput, "capacity must be positive")
+		}
+	}
+	return nil
 }
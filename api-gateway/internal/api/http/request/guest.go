@@ -0,0 +1,34 @@
+package request
+
+import (
+	"strings"
+
+	"github.com/semho/hotel-booking/pkg/errors"
+)
+
+// Полная замена имени и предпочтений гостя
+type GuestProfileRequest struct {
+	Name           string `json:"name"`
+	BedType        string `json:"bedType"`
+	PreferredFloor string `json:"preferredFloor"`
+	Allergies      string `json:"allergies"`
+}
+
+func (req *GuestProfileRequest) Validate() error {
+	if strings.TrimSpace(req.Name) == "" {
+		return errors.WithMessage(errors.ErrInvalidInput, "name is required")
+	}
+	return nil
+}
+
+// Слияние дублей в профиль из пути запроса
+type MergeGuestProfilesRequest struct {
+	SourceIDs []string `json:"sourceIds"`
+}
+
+func (req *MergeGuestProfilesRequest) Validate() error {
+	if len(req.SourceIDs) == 0 {
+		return errors.WithMessage(errors.ErrInvalidInput, "sourceIds are required")
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package request
+
+import (
+	"time"
+
+	"github.com/semho/hotel-booking/pkg/errors"
+)
+
+// Сумма в запросе: десятичная строка и код валюты
+type MoneyAmount struct {
+	Amount   string `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// Условия промокода, общие для создания и изменения
+type PromoCodeTerms struct {
+	// PERCENT или FIXED
+	DiscountType    string       `json:"discountType"`
+	DiscountPercent int32        `json:"discountPercent,omitempty"`
+	DiscountAmount  *MoneyAmount `json:"discountAmount,omitempty"`
+	ValidFrom       *time.Time   `json:"validFrom,omitempty"`
+	ValidTo         *time.Time   `json:"validTo,omitempty"`
+	// Типы комнат (ROOM_TYPE_STANDARD, ...), пустой список — все типы
+	RoomTypes             []string `json:"roomTypes,omitempty"`
+	MaxRedemptions        *int32   `json:"maxRedemptions,omitempty"`
+	MaxRedemptionsPerUser *int32   `json:"maxRedemptionsPerUser,omitempty"`
+}
+
+func (t *PromoCodeTerms) Validate() error {
+	if t.DiscountType == "" {
+		return errors.WithMessage(errors.ErrInvalidInput, "discount type is required")
+	}
+	if t.ValidFrom != nil && t.ValidTo != nil && !t.ValidTo.After(*t.ValidFrom) {
+		return errors.WithMessage(errors.ErrInvalidInput, "validTo must be after validFrom")
+	}
+	return nil
+}
+
+type CreatePromoCodeRequest struct {
+	Code string `json:"code"`
+	PromoCodeTerms
+}
+
+func (req *CreatePromoCodeRequest) Validate() error {
+	if req.Code == "" {
+		return errors.WithMessage(errors.ErrInvalidInput, "code is required")
+	}
+	return req.PromoCodeTerms.Validate()
+}
+
+type UpdatePromoCodeRequest struct {
+	PromoCodeTerms
+	Active bool `json:"active"`
+}
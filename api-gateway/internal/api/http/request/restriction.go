@@ -0,0 +1,30 @@
+package request
+
+import (
+	"github.com/semho/hotel-booking/pkg/errors"
+)
+
+// Ограничение продаж: используется и при создании, и при полной замене условий
+type StayRestrictionRequest struct {
+	Name string `json:"name"`
+	// Даты периода включительно (YYYY-MM-DD)
+	DateFrom string `json:"dateFrom"`
+	DateTo   string `json:"dateTo"`
+	// Тип комнаты (ROOM_TYPE_STANDARD, ...), не задан — все типы
+	RoomType *string `json:"roomType,omitempty"`
+	// Дни недели (0 — воскресенье, 6 — суббота), пустой список — все дни
+	Weekdays          []int32 `json:"weekdays,omitempty"`
+	MinStay           *int32  `json:"minStay,omitempty"`
+	MaxStay           *int32  `json:"maxStay,omitempty"`
+	ClosedToArrival   bool    `json:"closedToArrival"`
+	ClosedToDeparture bool    `json:"closedToDeparture"`
+	MinAdvanceDays    *int32  `json:"minAdvanceDays,omitempty"`
+	MaxAdvanceDays    *int32  `json:"maxAdvanceDays,omitempty"`
+}
+
+func (req *StayRestrictionRequest) Validate() error {
+	if req.DateFrom == "" || req.DateTo == "" {
+		return errors.WithMessage(errors.ErrInvalidInput, "dateFrom and dateTo are required")
+	}
+	return nil
+}
@@ -15,6 +15,7 @@ type CreateRoomRequest struct {
 	Capacity   int               `json:"capacity"`
 	Status     roompb.RoomStatus `json:"status"`
 	Amenities  pq.StringArray    `json:"amenities"`
+	Currency   string            `json:"currency"`
 }
 
 // UnmarshalJSON implements custom JSON unmarshaling for RoomType
@@ -27,6 +28,7 @@ func (r *CreateRoomRequest) UnmarshalJSON(data []byte) error {
 		Capacity   int            `json:"capacity"`
 		Status     interface{}    `json:"status"`
 		Amenities  pq.StringArray `json:"amenities"`
+		Currency   string         `json:"currency"`
 	}
 
 	var alias Alias
@@ -39,6 +41,7 @@ func (r *CreateRoomRequest) UnmarshalJSON(data []byte) error {
 	r.Price = alias.Price
 	r.Capacity = alias.Capacity
 	r.Amenities = alias.Amenities
+	r.Currency = alias.Currency
 
 	// Обрабатываем Type
 	switch v := alias.Type.(type) {
@@ -0,0 +1,31 @@
+package request
+
+import (
+	"github.com/semho/hotel-booking/pkg/errors"
+)
+
+type JoinWaitlistRequest struct {
+	CheckIn    string  `json:"checkIn"`
+	CheckOut   string  `json:"checkOut"`
+	GuestName  string  `json:"guestName"`
+	GuestEmail string  `json:"guestEmail"`
+	GuestPhone string  `json:"guestPhone"`
+	Capacity   *int32  `json:"capacity,omitempty"`
+	Type       *string `json:"type,omitempty"`
+}
+
+func (req *JoinWaitlistRequest) Validate() error {
+	if req.GuestName == "" {
+		return errors.WithMessage(errors.ErrInvalidInput, "guest name is required")
+	}
+	if req.GuestEmail == "" {
+		return errors.WithMessage(errors.ErrInvalidInput, "guest email is required")
+	}
+	if req.CheckIn == "" || req.CheckOut == "" {
+		return errors.WithMessage(errors.ErrInvalidInput, "check-in and check-out dates are required")
+	}
+	if req.Capacity != nil && *req.Capacity <= 0 {
+		return errors.WithMessage(errors.ErrInvalidInput, "capacity must be positive")
+	}
+	return nil
+}
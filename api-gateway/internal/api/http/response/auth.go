@@ -21,18 +21,27 @@ type UserInfo struct {
 	Role      string    `json:"role"`
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt"`
+	// Пустой, пока пользователь не подтвердил email
+	EmailVerifiedAt *time.Time `json:"emailVerifiedAt,omitempty"`
 }
 
 func UserFromProto(user *pb.UserInfo) UserInfo {
+	var emailVerifiedAt *time.Time
+	if user.EmailVerifiedAt != nil {
+		verifiedAt := user.EmailVerifiedAt.AsTime()
+		emailVerifiedAt = &verifiedAt
+	}
+
 	return UserInfo{
-		ID:        user.Id,
-		Email:     user.Email,
-		FirstName: user.FirstName,
-		LastName:  user.LastName,
-		Phone:     user.Phone,
-		Role:      user.Role.String(),
-		CreatedAt: user.CreatedAt.AsTime(),
-		UpdatedAt: user.UpdatedAt.AsTime(),
+		ID:              user.Id,
+		Email:           user.Email,
+		FirstName:       user.FirstName,
+		LastName:        user.LastName,
+		Phone:           user.Phone,
+		Role:            user.Role.String(),
+		CreatedAt:       user.CreatedAt.AsTime(),
+		UpdatedAt:       user.UpdatedAt.AsTime(),
+		EmailVerifiedAt: emailVerifiedAt,
 	}
 }
 
@@ -40,3 +49,9 @@ type ValidateResponse struct {
 	Valid    bool     `json:"valid"`
 	UserInfo UserInfo `json:"user"`
 }
+
+type VerifyEmailResponse struct {
+	User UserInfo `json:"user"`
+	// Анонимные брони с этим email, привязанные к пользователю при подтверждении
+	ClaimedBookingIDs []string `json:"claimedBookingIds"`
+}
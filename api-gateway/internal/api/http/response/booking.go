@@ -1,12 +1,17 @@
 package response
 
-import roompb "github.com/semho/hotel-booking/pkg/proto/room_v1/room"
+import (
+	"time"
+
+	roompb "github.com/semho/hotel-booking/pkg/proto/room_v1/room"
+)
 
 type AvailableRoom struct {
 	ID         string          `json:"id"`
 	RoomNumber string          `json:"roomNumber"`
 	Type       roompb.RoomType `json:"type"`
 	Price      string          `json:"price"`
+	Currency   string          `json:"currency"`
 	Capacity   int             `json:"capacity"`
 	Amenities  []string        `json:"amenities"`
 }
@@ -17,9 +22,118 @@ type Error struct {
 }
 
 type CreateBookingResponse struct {
-	ID       string    `json:"id"`
-	RoomID   string    `json:"roomId"`
-	UserInfo *UserInfo `json:"userInfo,omitempty"`
-	Status   string    `json:"status"`
-	Message  string    `json:"message"`
+	ID string `json:"id"`
+	// Пустой у брони на тип комнаты: комната назначается перед заездом или при заселении
+	RoomID     string    `json:"roomId"`
+	RoomType   *string   `json:"roomType,omitempty"`
+	UserInfo   *UserInfo `json:"userInfo,omitempty"`
+	CheckIn    time.Time `json:"checkIn"`
+	CheckOut   time.Time `json:"checkOut"`
+	TotalPrice Money     `json:"totalPrice"`
+	Status     string    `json:"status"`
+	// До этого момента бронь нужно подтвердить, иначе она будет отменена
+	HoldExpiresAt  *time.Time   `json:"holdExpiresAt,omitempty"`
+	PriceBreakdown []NightPrice `json:"priceBreakdown"`
+	PromoCode      *string      `json:"promoCode,omitempty"`
+	Message        string       `json:"message"`
+}
+
+type Booking struct {
+	ID string `json:"id"`
+	// Пустой у брони на тип комнаты: комната назначается перед заездом или при заселении
+	RoomID     string    `json:"roomId"`
+	RoomType   *string   `json:"roomType,omitempty"`
+	UserID     *string   `json:"userId,omitempty"`
+	GuestName  string    `json:"guestName"`
+	GuestEmail string    `json:"guestEmail"`
+	GuestPhone string    `json:"guestPhone"`
+	CheckIn    time.Time `json:"checkIn"`
+	CheckOut   time.Time `json:"checkOut"`
+	TotalPrice Money     `json:"totalPrice"`
+	Status     string    `json:"status"`
+	CreatedAt  time.Time `json:"createdAt"`
+	// Статусы, в которые бронь может перейти из текущего
+	AllowedTransitions []string `json:"allowedTransitions"`
+	// Заполняется только для PENDING броней
+	HoldExpiresAt *time.Time `json:"holdExpiresAt,omitempty"`
+	// Заполняются стойкой регистрации при заселении и выезде
+	RoomNumber   string     `json:"roomNumber,omitempty"`
+	CheckedInAt  *time.Time `json:"checkedInAt,omitempty"`
+	CheckedOutAt *time.Time `json:"checkedOutAt,omitempty"`
+	// Заполняются при отмене брони
+	CancellationPenalty *Money     `json:"cancellationPenalty,omitempty"`
+	RefundAmount        *Money     `json:"refundAmount,omitempty"`
+	CancelledAt         *time.Time `json:"cancelledAt,omitempty"`
+	// Групповая бронь, в которую входит бронь комнаты
+	ReservationID *string `json:"reservationId,omitempty"`
+	// Цена каждой ночи по тарифному плану
+	PriceBreakdown []NightPrice `json:"priceBreakdown"`
+	// Стоимость в валюте из параметра currency по текущему курсу, totalPrice остается в валюте расчетов
+	ConvertedTotalPrice *Money `json:"convertedTotalPrice,omitempty"`
+	// Примененный промокод, скидка видна в priceBreakdown
+	PromoCode *string `json:"promoCode,omitempty"`
+	// Профиль гостя, найденный по email или телефону брони
+	GuestProfileID *string `json:"guestProfileId,omitempty"`
+}
+
+type PriceAdjustment struct {
+	Rule    string `json:"rule"`
+	Percent int32  `json:"percent,omitempty"`
+	// Изменение цены суммой, для фиксированной скидки промокода
+	Amount *Money `json:"amount,omitempty"`
+}
+
+type NightPrice struct {
+	Date        time.Time         `json:"date"`
+	BasePrice   Money             `json:"basePrice"`
+	Price       Money             `json:"price"`
+	Adjustments []PriceAdjustment `json:"adjustments,omitempty"`
+}
+
+type BookingList struct {
+	Bookings      []Booking `json:"bookings"`
+	NextPageToken string    `json:"nextPageToken,omitempty"`
+}
+
+type BookingStatusChange struct {
+	ID        string    `json:"id"`
+	Status    string    `json:"status"`
+	Reason    string    `json:"reason"`
+	ChangedBy string    `json:"changedBy"`
+	ChangedAt time.Time `json:"changedAt"`
+}
+
+type CancellationQuote struct {
+	TotalPrice        Money `json:"totalPrice"`
+	PenaltyPercent    int32 `json:"penaltyPercent"`
+	Penalty           Money `json:"penalty"`
+	Refund            Money `json:"refund"`
+	DaysBeforeCheckIn int32 `json:"daysBeforeCheckIn"`
+	// Не заполняется для невозвратного тарифа
+	FreeCancellationUntil *time.Time `json:"freeCancellationUntil,omitempty"`
+	NonRefundable         bool       `json:"nonRefundable"`
+}
+
+type CancelBookingResponse struct {
+	Booking Booking           `json:"booking"`
+	Quote   CancellationQuote `json:"quote"`
+}
+
+type Reservation struct {
+	ID               string    `json:"id"`
+	ConfirmationCode string    `json:"confirmationCode"`
+	UserID           *string   `json:"userId,omitempty"`
+	ContactName      string    `json:"contactName"`
+	ContactEmail     string    `json:"contactEmail"`
+	ContactPhone     string    `json:"contactPhone"`
+	CreatedAt        time.Time `json:"createdAt"`
+	Bookings         []Booking `json:"bookings"`
+	// Суммарная стоимость всех комнат
+	TotalPrice *Money `json:"totalPrice,omitempty"`
+}
+
+// Денежная сумма: десятичная строка с точностью валюты, чтобы клиенты не теряли копейки на float
+type Money struct {
+	Amount   string `json:"amount"`
+	Currency string `json:"currency"`
 }
@@ -0,0 +1,26 @@
+package response
+
+import "time"
+
+// Строка календаря доступности: комната или тип комнат
+type AvailabilityCalendarRow struct {
+	// Заданы только в строках комнат
+	RoomID     *string                     `json:"roomId,omitempty"`
+	RoomNumber *string                     `json:"roomNumber,omitempty"`
+	RoomType   string                      `json:"roomType"`
+	Nights     []AvailabilityCalendarNight `json:"nights"`
+}
+
+// Состояние строки календаря на одну ночь
+type AvailabilityCalendarNight struct {
+	Night time.Time `json:"night"`
+	// FREE, BOOKED, BLOCKED или OUT_OF_SERVICE
+	Status string `json:"status"`
+	// Бронь, занимающая комнату; видна только администратору
+	BookingID *string `json:"bookingId,omitempty"`
+	// Число комнат в каждом состоянии, брони типа без назначенной комнаты считаются занятыми
+	Free         int32 `json:"free"`
+	Booked       int32 `json:"booked"`
+	Blocked      int32 `json:"blocked"`
+	OutOfService int32 `json:"outOfService"`
+}
@@ -0,0 +1,20 @@
+package response
+
+import "time"
+
+type GuestProfile struct {
+	ID     string   `json:"id"`
+	Name   string   `json:"name"`
+	Emails []string `json:"emails"`
+	Phones []string `json:"phones"`
+	// Предпочтения гостя
+	BedType        string `json:"bedType"`
+	PreferredFloor string `json:"preferredFloor"`
+	Allergies      string `json:"allergies"`
+	// Завершенные проживания и их суммарная стоимость в валюте расчетов отеля
+	StayCount     int32      `json:"stayCount"`
+	LifetimeValue Money      `json:"lifetimeValue"`
+	LastStayAt    *time.Time `json:"lastStayAt,omitempty"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	UpdatedAt     time.Time  `json:"updatedAt"`
+}
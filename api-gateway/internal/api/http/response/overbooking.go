@@ -0,0 +1,17 @@
+package response
+
+import "time"
+
+// Ночь, на которую броней типа продано больше, чем есть комнат
+type OversoldNight struct {
+	Night    time.Time `json:"night"`
+	RoomType string    `json:"roomType"`
+	// Комнаты типа, доступные для продажи, и потолок продаж с учетом овербукинга
+	Inventory int32 `json:"inventory"`
+	Ceiling   int32 `json:"ceiling"`
+	// Активные брони типа, из них без закрепленной комнаты
+	Booked     int32 `json:"booked"`
+	Unassigned int32 `json:"unassigned"`
+	// На сколько броней продажи превышают число комнат
+	Oversold int32 `json:"oversold"`
+}
@@ -0,0 +1,20 @@
+package response
+
+import "time"
+
+type PromoCode struct {
+	ID                    string     `json:"id"`
+	Code                  string     `json:"code"`
+	DiscountType          string     `json:"discountType"`
+	DiscountPercent       int32      `json:"discountPercent,omitempty"`
+	DiscountAmount        *Money     `json:"discountAmount,omitempty"`
+	ValidFrom             *time.Time `json:"validFrom,omitempty"`
+	ValidTo               *time.Time `json:"validTo,omitempty"`
+	RoomTypes             []string   `json:"roomTypes"`
+	MaxRedemptions        *int32     `json:"maxRedemptions,omitempty"`
+	MaxRedemptionsPerUser *int32     `json:"maxRedemptionsPerUser,omitempty"`
+	Redemptions           int32      `json:"redemptions"`
+	Active                bool       `json:"active"`
+	CreatedAt             time.Time  `json:"createdAt"`
+	UpdatedAt             time.Time  `json:"updatedAt"`
+}
@@ -0,0 +1,21 @@
+package response
+
+import "time"
+
+type StayRestriction struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Даты периода включительно (YYYY-MM-DD)
+	DateFrom          string    `json:"dateFrom"`
+	DateTo            string    `json:"dateTo"`
+	RoomType          *string   `json:"roomType,omitempty"`
+	Weekdays          []int32   `json:"weekdays"`
+	MinStay           *int32    `json:"minStay,omitempty"`
+	MaxStay           *int32    `json:"maxStay,omitempty"`
+	ClosedToArrival   bool      `json:"closedToArrival"`
+	ClosedToDeparture bool      `json:"closedToDeparture"`
+	MinAdvanceDays    *int32    `json:"minAdvanceDays,omitempty"`
+	MaxAdvanceDays    *int32    `json:"maxAdvanceDays,omitempty"`
+	CreatedAt         time.Time `json:"createdAt"`
+	UpdatedAt         time.Time `json:"updatedAt"`
+}
@@ -10,6 +10,7 @@ type CreateRoomResponse struct {
 	Number    string            `json:"number"`
 	Type      roompb.RoomType   `json:"type"`
 	Price     string            `json:"price"`
+	Currency  string            `json:"currency"`
 	Capacity  int               `json:"capacity"`
 	Status    roompb.RoomStatus `json:"status"`
 	Amenities pq.StringArray    `json:"amenities"`
@@ -0,0 +1,21 @@
+package response
+
+import "time"
+
+type WaitlistEntry struct {
+	ID         string    `json:"id"`
+	UserID     string    `json:"userId"`
+	GuestName  string    `json:"guestName"`
+	GuestEmail string    `json:"guestEmail"`
+	GuestPhone string    `json:"guestPhone"`
+	CheckIn    time.Time `json:"checkIn"`
+	CheckOut   time.Time `json:"checkOut"`
+	Capacity   *int32    `json:"capacity,omitempty"`
+	Type       *string   `json:"type,omitempty"`
+	Status     string    `json:"status"`
+	// PENDING бронь, предложенная при освобождении комнаты; ее нужно подтвердить до offerExpiresAt
+	BookingID      *string    `json:"bookingId,omitempty"`
+	OfferExpiresAt *time.Time `json:"offerExpiresAt,omitempty"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	UpdatedAt      time.Time  `json:"updatedAt"`
+}
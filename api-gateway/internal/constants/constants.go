@@ -1,3 +1,10 @@
 package constants
 
 const USER = "user"
+
+const (
+	// Заголовок клиента для безопасного повтора запроса создания брони
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// Ключ метаданных gRPC, в котором заголовок передается в booking service
+	IdempotencyKeyMetadata = "idempotency-key"
+)
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Подтверждение email: по ссылке с токеном из письма. Хранится только хеш токена
+ALTER TABLE users
+    ADD COLUMN email_verified_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN email_verification_token_hash VARCHAR(64),
+    ADD COLUMN email_verification_expires_at TIMESTAMP WITH TIME ZONE;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email_verification_token
+    ON users(email_verification_token_hash)
+    WHERE email_verification_token_hash IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_users_email_verification_token;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS email_verification_expires_at,
+    DROP COLUMN IF EXISTS email_verification_token_hash,
+    DROP COLUMN IF EXISTS email_verified_at;
+-- +goose StatementEnd
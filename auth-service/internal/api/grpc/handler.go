@@ -6,6 +6,7 @@ import (
 	"github.com/semho/hotel-booking/auth-service/internal/api/grpc/mapper"
 	"github.com/semho/hotel-booking/auth-service/internal/domain/model"
 	"github.com/semho/hotel-booking/auth-service/internal/domain/port"
+	"github.com/semho/hotel-booking/pkg/errors"
 	"github.com/semho/hotel-booking/pkg/logger"
 	pb "github.com/semho/hotel-booking/pkg/proto/auth_v1/auth"
 	"google.golang.org/grpc/codes"
@@ -124,3 +125,39 @@ func (h *AuthHandler) Refresh(ctx context.Context, req *pb.RefreshRequest) (*pb.
 
 	return mapper.ToProtoAuthResponse(response), nil
 }
+
+func (h *AuthHandler) VerifyEmail(ctx context.Context, req *pb.VerifyEmailRequest) (*pb.VerifyEmailResponse, error) {
+	logger.Log.Info("auth VerifyEmail")
+
+	user, claimed, err := h.authService.VerifyEmail(ctx, req.Token)
+	if err != nil {
+		if errors.IsInvalidInput(err) {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to verify email: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to verify email: %v", err)
+	}
+
+	return &pb.VerifyEmailResponse{
+		User:              mapper.ToProtoUserInfo(user),
+		ClaimedBookingIds: claimed,
+	}, nil
+}
+
+func (h *AuthHandler) ResendEmailVerification(
+	ctx context.Context,
+	req *pb.ResendEmailVerificationRequest,
+) (*pb.ResendEmailVerificationResponse, error) {
+	logger.Log.Info(
+		"auth ResendEmailVerification",
+		"user_email", req.Email,
+	)
+
+	if err := h.authService.ResendEmailVerification(ctx, req.Email); err != nil {
+		if errors.IsInvalidInput(err) {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to resend email verification: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to resend email verification: %v", err)
+	}
+
+	return &pb.ResendEmailVerificationResponse{}, nil
+}
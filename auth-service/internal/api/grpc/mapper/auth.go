@@ -4,6 +4,7 @@ import (
 	"github.com/semho/hotel-booking/auth-service/internal/domain/model"
 	pb "github.com/semho/hotel-booking/pkg/proto/auth_v1/auth"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
 )
 
 func ToProtoAuthResponse(resp *model.AuthResponse) *pb.AuthResponse {
@@ -18,26 +19,35 @@ func ToProtoAuthResponse(resp *model.AuthResponse) *pb.AuthResponse {
 
 func ToProtoUser(user *model.User) *pb.UserInfo {
 	return &pb.UserInfo{
-		Id:        user.ID.String(),
-		Email:     user.Email,
-		FirstName: user.FirstName,
-		LastName:  user.LastName,
-		Phone:     user.Phone,
-		Role:      user.Role,
-		CreatedAt: timestamppb.New(user.CreatedAt),
-		UpdatedAt: timestamppb.New(user.UpdatedAt),
+		Id:              user.ID.String(),
+		Email:           user.Email,
+		FirstName:       user.FirstName,
+		LastName:        user.LastName,
+		Phone:           user.Phone,
+		Role:            user.Role,
+		CreatedAt:       timestamppb.New(user.CreatedAt),
+		UpdatedAt:       timestamppb.New(user.UpdatedAt),
+		EmailVerifiedAt: toProtoTimestamp(user.EmailVerifiedAt),
 	}
 }
 
 func ToProtoUserInfo(user *model.User) *pb.UserInfo {
 	return &pb.UserInfo{
-		Id:        user.ID.String(),
-		Email:     user.Email,
-		FirstName: user.FirstName,
-		LastName:  user.LastName,
-		Phone:     user.Phone,
-		Role:      user.Role,
-		CreatedAt: timestamppb.New(user.CreatedAt),
-		UpdatedAt: timestamppb.New(user.UpdatedAt),
+		Id:              user.ID.String(),
+		Email:           user.Email,
+		FirstName:       user.FirstName,
+		LastName:        user.LastName,
+		Phone:           user.Phone,
+		Role:            user.Role,
+		CreatedAt:       timestamppb.New(user.CreatedAt),
+		UpdatedAt:       timestamppb.New(user.UpdatedAt),
+		EmailVerifiedAt: toProtoTimestamp(user.EmailVerifiedAt),
 	}
 }
+
+func toProtoTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
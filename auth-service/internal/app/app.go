@@ -63,6 +63,10 @@ func (a *App) Stop(ctx context.Context) error {
 	// Останавливаем gRPC сервер
 	a.grpcServer.GracefulStop()
 
+	if err := a.deps.bookingConn.Close(); err != nil {
+		logger.Log.Error("failed to close booking service connection", "error", err)
+	}
+
 	// Закрываем соединение с БД
 	if err := a.deps.db.Close(); err != nil {
 		return fmt.Errorf("failed to close db connection: %w", err)
@@ -7,13 +7,19 @@ import (
 	grpcHandler "github.com/semho/hotel-booking/auth-service/internal/api/grpc"
 	"github.com/semho/hotel-booking/auth-service/internal/config"
 	"github.com/semho/hotel-booking/auth-service/internal/domain/service"
+	"github.com/semho/hotel-booking/auth-service/internal/infrastructure/client/booking"
+	"github.com/semho/hotel-booking/auth-service/internal/infrastructure/email"
 	"github.com/semho/hotel-booking/auth-service/internal/infrastructure/repository/postgres"
 	"github.com/semho/hotel-booking/pkg/auth/jwt"
+	bookingpb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"time"
 )
 
 type Deps struct {
 	db          *sqlx.DB
+	bookingConn *grpc.ClientConn
 	AuthHandler *grpcHandler.AuthHandler
 }
 
@@ -32,13 +38,33 @@ func initDeps(cfg *config.Config) (*Deps, error) {
 		time.Duration(cfg.JWT.RefreshTokenTTL)*time.Hour*24,
 	)
 
+	// Устанавливаем соединение с booking service: после подтверждения email к пользователю привязываются его брони
+	bookingConn, err := grpc.NewClient(
+		cfg.BookingService.Address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to booking service: %w", err)
+	}
+	bookingClient := booking.NewBookingClient(
+		bookingpb.NewBookingServiceClient(bookingConn),
+		cfg.BookingService.ServiceToken,
+	)
+
 	// Инициализируем слои
 	userRepo := postgres.NewUserRepository(db)
-	authService := service.NewAuthService(userRepo, tokenManager)
+	authService := service.NewAuthService(
+		userRepo,
+		tokenManager,
+		email.NewEmailSender(cfg.Email),
+		bookingClient,
+		cfg.Email.VerificationTTL,
+	)
 	authHandler := grpcHandler.NewAuthHandler(authService)
 
 	return &Deps{
 		db:          db,
+		bookingConn: bookingConn,
 		AuthHandler: authHandler,
 	}, nil
 }
@@ -4,13 +4,16 @@ import (
 	"fmt"
 	"github.com/spf13/viper"
 	"os"
+	"time"
 )
 
 type Config struct {
-	Environment string     `mapstructure:"environment"`
-	DB          DBConfig   `mapstructure:"db"`
-	GRPC        GRPCConfig `mapstructure:"grpc"`
-	JWT         JWTConfig  `mapstructure:"jwt"`
+	Environment    string               `mapstructure:"environment"`
+	DB             DBConfig             `mapstructure:"db"`
+	GRPC           GRPCConfig           `mapstructure:"grpc"`
+	JWT            JWTConfig            `mapstructure:"jwt"`
+	Email          EmailConfig          `mapstructure:"email"`
+	BookingService BookingServiceConfig `mapstructure:"booking_service"`
 }
 
 type DBConfig struct {
@@ -32,6 +35,24 @@ type JWTConfig struct {
 	RefreshTokenTTL    int    `mapstructure:"refresh_token_ttl"` // в днях
 }
 
+type EmailConfig struct {
+	// SMTP сервер для писем; если host не задан, письма только пишутся в лог (для разработки)
+	SMTPHost     string `mapstructure:"smtp_host"`
+	SMTPPort     int    `mapstructure:"smtp_port"`
+	SMTPUser     string `mapstructure:"smtp_user"`
+	SMTPPassword string `mapstructure:"smtp_password"`
+	From         string `mapstructure:"from"`
+	// Страница подтверждения email, токен добавляется параметром token
+	VerificationURL string        `mapstructure:"verification_url"`
+	VerificationTTL time.Duration `mapstructure:"verification_ttl"`
+}
+
+type BookingServiceConfig struct {
+	Address string `mapstructure:"address"`
+	// Общий секрет для внутренних методов booking service
+	ServiceToken string `mapstructure:"service_token"`
+}
+
 func Load() (*Config, error) {
 	v := viper.New()
 
@@ -63,6 +84,15 @@ func Load() (*Config, error) {
 		v.BindEnv("jwt.refresh_token_secret", "JWT_REFRESH_SECRET")
 		v.BindEnv("jwt.access_token_ttl", "JWT_ACCESS_TTL")
 		v.BindEnv("jwt.refresh_token_ttl", "JWT_REFRESH_TTL")
+		v.BindEnv("email.smtp_host", "SMTP_HOST")
+		v.BindEnv("email.smtp_port", "SMTP_PORT")
+		v.BindEnv("email.smtp_user", "SMTP_USER")
+		v.BindEnv("email.smtp_password", "SMTP_PASSWORD")
+		v.BindEnv("email.from", "EMAIL_FROM")
+		v.BindEnv("email.verification_url", "EMAIL_VERIFICATION_URL")
+		v.BindEnv("email.verification_ttl", "EMAIL_VERIFICATION_TTL")
+		v.BindEnv("booking_service.address", "BOOKING_SERVICE_ADDR")
+		v.BindEnv("booking_service.service_token", "INTERNAL_SERVICE_TOKEN")
 	}
 
 	// Загрузка конфигурации
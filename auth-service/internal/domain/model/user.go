@@ -19,4 +19,6 @@ type User struct {
 	Role      UserRole  `db:"role"`
 	CreatedAt time.Time `db:"created_at"`
 	UpdatedAt time.Time `db:"updated_at"`
+	// nil, пока пользователь не подтвердил email
+	EmailVerifiedAt *time.Time `db:"email_verified_at"`
 }
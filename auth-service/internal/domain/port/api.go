@@ -2,6 +2,7 @@ package port
 
 import (
 	"context"
+	"github.com/google/uuid"
 	pb "github.com/semho/hotel-booking/pkg/proto/auth_v1/auth"
 )
 
@@ -10,4 +11,19 @@ type AuthAPI interface {
 	Login(ctx context.Context, req *pb.LoginRequest) (*pb.AuthResponse, error)
 	Validate(ctx context.Context, req *pb.ValidateRequest) (*pb.ValidateResponse, error)
 	Refresh(ctx context.Context, req *pb.RefreshRequest) (*pb.AuthResponse, error)
+	VerifyEmail(ctx context.Context, req *pb.VerifyEmailRequest) (*pb.VerifyEmailResponse, error)
+	ResendEmailVerification(
+		ctx context.Context,
+		req *pb.ResendEmailVerificationRequest,
+	) (*pb.ResendEmailVerificationResponse, error)
+}
+
+// EmailSender отправляет пользователю письмо со ссылкой подтверждения email
+type EmailSender interface {
+	SendEmailVerification(ctx context.Context, email, token string) error
+}
+
+// BookingClient привязывает к пользователю анонимные брони, оформленные на подтвержденный email
+type BookingClient interface {
+	ClaimGuestBookings(ctx context.Context, userID uuid.UUID, email string) ([]string, error)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/google/uuid"
 	"github.com/semho/hotel-booking/auth-service/internal/domain/model"
+	"time"
 )
 
 type UserRepository interface {
@@ -12,4 +13,10 @@ type UserRepository interface {
 	GetByID(ctx context.Context, id uuid.UUID) (*model.User, error)
 	GetByEmail(ctx context.Context, email string) (*model.User, error)
 	Delete(ctx context.Context, id uuid.UUID) error
+	// Токен подтверждения email хранится хешем; у подтвержденного email токен не задается
+	SetEmailVerificationToken(ctx context.Context, userID uuid.UUID, tokenHash string, expiresAt time.Time) error
+	// Пользователь с неподтвержденным email и действующим на момент now токеном
+	GetByEmailVerificationToken(ctx context.Context, tokenHash string, now time.Time) (*model.User, error)
+	// Отмечает email подтвержденным и сбрасывает токен
+	MarkEmailVerified(ctx context.Context, userID uuid.UUID, verifiedAt time.Time) error
 }
@@ -10,4 +10,8 @@ type AuthService interface {
 	Login(ctx context.Context, email, password string) (*model.AuthResponse, error)
 	ValidateAccessToken(ctx context.Context, token string) (*model.User, error)
 	RefreshTokens(ctx context.Context, refreshToken string) (*model.AuthResponse, error)
+	// Подтверждает email по токену из письма и привязывает к пользователю брони, оформленные на этот email
+	VerifyEmail(ctx context.Context, token string) (*model.User, []string, error)
+	// Отправляет новый токен, если email зарегистрирован и еще не подтвержден
+	ResendEmailVerification(ctx context.Context, email string) error
 }
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/semho/hotel-booking/auth-service/internal/domain/model"
 	"github.com/semho/hotel-booking/auth-service/internal/domain/port"
@@ -13,14 +14,30 @@ import (
 )
 
 type authService struct {
-	userRepo     port.UserRepository
-	tokenManager *jwt.TokenManager
+	userRepo        port.UserRepository
+	tokenManager    *jwt.TokenManager
+	emailSender     port.EmailSender
+	bookingClient   port.BookingClient
+	verificationTTL time.Duration
 }
 
-func NewAuthService(userRepo port.UserRepository, tokenManager *jwt.TokenManager) port.AuthService {
+func NewAuthService(
+	userRepo port.UserRepository,
+	tokenManager *jwt.TokenManager,
+	emailSender port.EmailSender,
+	bookingClient port.BookingClient,
+	verificationTTL time.Duration,
+) port.AuthService {
+	if verificationTTL <= 0 {
+		verificationTTL = defaultVerificationTTL
+	}
+
 	return &authService{
-		userRepo:     userRepo,
-		tokenManager: tokenManager,
+		userRepo:        userRepo,
+		tokenManager:    tokenManager,
+		emailSender:     emailSender,
+		bookingClient:   bookingClient,
+		verificationTTL: verificationTTL,
 	}
 }
 
@@ -50,6 +67,11 @@ func (s *authService) Register(ctx context.Context, req *model.RegisterRequest)
 		return nil, err
 	}
 
+	// Без письма регистрация не отменяется: токен можно запросить повторно
+	if err = s.sendEmailVerification(ctx, user); err != nil {
+		logger.Log.Error("failed to send email verification", "user_id", user.ID, "error", err)
+	}
+
 	// Создаем токены
 	accessToken, accessExp, err := s.tokenManager.CreateAccessToken(
 		user.ID,
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/semho/hotel-booking/auth-service/internal/domain/model"
+	"github.com/semho/hotel-booking/pkg/errors"
+	"github.com/semho/hotel-booking/pkg/logger"
+)
+
+const (
+	// Сколько действует ссылка подтверждения email по умолчанию
+	defaultVerificationTTL = 24 * time.Hour
+
+	verificationTokenBytes = 32
+)
+
+// VerifyEmail подтверждает email по токену из письма. Брони привязываются до отметки о подтверждении:
+// если booking service недоступен, токен остается действующим и переход по ссылке можно повторить
+func (s *authService) VerifyEmail(ctx context.Context, token string) (*model.User, []string, error) {
+	if token == "" {
+		return nil, nil, errors.WithMessage(errors.ErrInvalidInput, "verification token is required")
+	}
+
+	now := time.Now()
+	user, err := s.userRepo.GetByEmailVerificationToken(ctx, hashVerificationToken(token), now)
+	if err != nil {
+		return nil, nil, errors.WithMessage(errors.ErrInvalidInput, "invalid or expired verification token")
+	}
+
+	// Повторная привязка ничего не меняет, поэтому ее можно выполнить еще раз после сбоя
+	claimed, err := s.bookingClient.ClaimGuestBookings(ctx, user.ID, user.Email)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to claim guest bookings: %w", err)
+	}
+
+	if err = s.userRepo.MarkEmailVerified(ctx, user.ID, now); err != nil {
+		return nil, nil, err
+	}
+	user.EmailVerifiedAt = &now
+
+	logger.Log.Info("email verified", "user_id", user.ID, "claimed_bookings", len(claimed))
+	return user, claimed, nil
+}
+
+// ResendEmailVerification не сообщает, зарегистрирован ли email: ответ одинаков для любого адреса
+func (s *authService) ResendEmailVerification(ctx context.Context, email string) error {
+	if email == "" {
+		return errors.WithMessage(errors.ErrInvalidInput, "email is required")
+	}
+
+	user, err := s.userRepo.GetByEmail(ctx, email)
+	if err != nil || user.EmailVerifiedAt != nil {
+		return nil
+	}
+	return s.sendEmailVerification(ctx, user)
+}
+
+// sendEmailVerification выпускает новый токен подтверждения, предыдущий перестает действовать
+func (s *authService) sendEmailVerification(ctx context.Context, user *model.User) error {
+	buf := make([]byte, verificationTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return fmt.Errorf("failed to generate verification token: %w", err)
+	}
+	token := hex.EncodeToString(buf)
+
+	err := s.userRepo.SetEmailVerificationToken(
+		ctx,
+		user.ID,
+		hashVerificationToken(token),
+		time.Now().Add(s.verificationTTL),
+	)
+	if err != nil {
+		return err
+	}
+	return s.emailSender.SendEmailVerification(ctx, user.Email, token)
+}
+
+// В базе хранится только хеш токена, чтобы утечка базы не позволяла подтвердить чужой email
+func hashVerificationToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,261 @@
+package service
+
+import (
+	"context"
+	stdErrors "errors"
+	"io"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/semho/hotel-booking/auth-service/internal/domain/model"
+	"github.com/semho/hotel-booking/pkg/auth/jwt"
+	"github.com/semho/hotel-booking/pkg/errors"
+	"github.com/semho/hotel-booking/pkg/logger"
+)
+
+func TestMain(m *testing.M) {
+	logger.Log = slog.New(slog.NewTextHandler(io.Discard, nil))
+	os.Exit(m.Run())
+}
+
+// После регистрации брони привязываются только по токену из письма и только к владельцу email
+func TestVerifyEmailClaimsBookingsOfVerifiedUser(t *testing.T) {
+	repo := newUserRepoStub()
+	sender := &emailSenderStub{}
+	bookings := &bookingClientStub{claimed: []string{"booking-1"}}
+	service := newTestAuthService(repo, sender, bookings)
+
+	resp, err := service.Register(
+		context.Background(), &model.RegisterRequest{
+			Email:     "guest@example.com",
+			Password:  "secret",
+			FirstName: "Guest",
+			LastName:  "User",
+		},
+	)
+	if err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	if sender.token == "" {
+		t.Fatal("verification email was not sent")
+	}
+	if len(bookings.calls) != 0 {
+		t.Fatal("bookings claimed before the email was verified")
+	}
+
+	if _, _, err = service.VerifyEmail(context.Background(), "wrong-token"); !errors.IsInvalidInput(err) {
+		t.Fatalf("expected invalid input for unknown token, got %v", err)
+	}
+
+	user, claimed, err := service.VerifyEmail(context.Background(), sender.token)
+	if err != nil {
+		t.Fatalf("verify email: %v", err)
+	}
+	if user.EmailVerifiedAt == nil {
+		t.Fatal("email is not marked verified")
+	}
+	if len(claimed) != 1 || claimed[0] != "booking-1" {
+		t.Fatalf("unexpected claimed bookings %v", claimed)
+	}
+	expected := claimCall{userID: resp.User.ID, email: "guest@example.com"}
+	if len(bookings.calls) != 1 || bookings.calls[0] != expected {
+		t.Fatalf("unexpected claim calls %+v", bookings.calls)
+	}
+
+	// Токен одноразовый
+	if _, _, err = service.VerifyEmail(context.Background(), sender.token); !errors.IsInvalidInput(err) {
+		t.Fatalf("expected invalid input for used token, got %v", err)
+	}
+}
+
+// Если booking service недоступен, email не подтверждается и ссылку можно открыть повторно
+func TestVerifyEmailKeepsTokenWhenClaimFails(t *testing.T) {
+	repo := newUserRepoStub()
+	sender := &emailSenderStub{}
+	bookings := &bookingClientStub{err: stdErrors.New("booking service unavailable")}
+	service := newTestAuthService(repo, sender, bookings)
+
+	if _, err := service.Register(
+		context.Background(), &model.RegisterRequest{Email: "guest@example.com", Password: "secret"},
+	); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+
+	if _, _, err := service.VerifyEmail(context.Background(), sender.token); err == nil {
+		t.Fatal("expected claim error")
+	}
+	user, _ := repo.GetByEmail(context.Background(), "guest@example.com")
+	if user.EmailVerifiedAt != nil {
+		t.Fatal("email marked verified although bookings were not claimed")
+	}
+
+	bookings.err = nil
+	if _, _, err := service.VerifyEmail(context.Background(), sender.token); err != nil {
+		t.Fatalf("retry verify email: %v", err)
+	}
+}
+
+// Повторная отправка выпускает новый токен, старый перестает действовать; незнакомый email не раскрывается
+func TestResendEmailVerification(t *testing.T) {
+	repo := newUserRepoStub()
+	sender := &emailSenderStub{}
+	service := newTestAuthService(repo, sender, &bookingClientStub{})
+
+	if _, err := service.Register(
+		context.Background(), &model.RegisterRequest{Email: "guest@example.com", Password: "secret"},
+	); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	first := sender.token
+
+	if err := service.ResendEmailVerification(context.Background(), "guest@example.com"); err != nil {
+		t.Fatalf("resend: %v", err)
+	}
+	if sender.token == first {
+		t.Fatal("resend did not issue a new token")
+	}
+	if _, _, err := service.VerifyEmail(context.Background(), first); !errors.IsInvalidInput(err) {
+		t.Fatalf("expected previous token to be invalid, got %v", err)
+	}
+
+	sent := sender.sent
+	if err := service.ResendEmailVerification(context.Background(), "unknown@example.com"); err != nil {
+		t.Fatalf("resend to unknown email: %v", err)
+	}
+	if sender.sent != sent {
+		t.Fatal("email sent to unregistered address")
+	}
+}
+
+func newTestAuthService(
+	repo *userRepoStub,
+	sender *emailSenderStub,
+	bookings *bookingClientStub,
+) *authService {
+	tokenManager := jwt.NewTokenManager("access", "refresh", time.Minute, time.Hour)
+	return NewAuthService(repo, tokenManager, sender, bookings, time.Hour).(*authService)
+}
+
+type userRepoStub struct {
+	users map[uuid.UUID]*storedUser
+}
+
+type storedUser struct {
+	user      model.User
+	tokenHash string
+	expiresAt time.Time
+}
+
+func newUserRepoStub() *userRepoStub {
+	return &userRepoStub{users: make(map[uuid.UUID]*storedUser)}
+}
+
+func (r *userRepoStub) Create(_ context.Context, user *model.User) error {
+	user.ID = uuid.New()
+	r.users[user.ID] = &storedUser{user: *user}
+	return nil
+}
+
+func (r *userRepoStub) Update(_ context.Context, user *model.User) error {
+	stored, ok := r.users[user.ID]
+	if !ok {
+		return errors.ErrNotFound
+	}
+	stored.user = *user
+	return nil
+}
+
+func (r *userRepoStub) GetByID(_ context.Context, id uuid.UUID) (*model.User, error) {
+	stored, ok := r.users[id]
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+	user := stored.user
+	return &user, nil
+}
+
+func (r *userRepoStub) GetByEmail(_ context.Context, email string) (*model.User, error) {
+	for _, stored := range r.users {
+		if stored.user.Email == email {
+			user := stored.user
+			return &user, nil
+		}
+	}
+	return nil, errors.ErrNotFound
+}
+
+func (r *userRepoStub) Delete(_ context.Context, id uuid.UUID) error {
+	delete(r.users, id)
+	return nil
+}
+
+func (r *userRepoStub) SetEmailVerificationToken(
+	_ context.Context,
+	userID uuid.UUID,
+	tokenHash string,
+	expiresAt time.Time,
+) error {
+	stored, ok := r.users[userID]
+	if !ok || stored.user.EmailVerifiedAt != nil {
+		return errors.ErrNotFound
+	}
+	stored.tokenHash, stored.expiresAt = tokenHash, expiresAt
+	return nil
+}
+
+func (r *userRepoStub) GetByEmailVerificationToken(
+	_ context.Context,
+	tokenHash string,
+	now time.Time,
+) (*model.User, error) {
+	for _, stored := range r.users {
+		if stored.tokenHash == tokenHash && stored.expiresAt.After(now) && stored.user.EmailVerifiedAt == nil {
+			user := stored.user
+			return &user, nil
+		}
+	}
+	return nil, errors.ErrNotFound
+}
+
+func (r *userRepoStub) MarkEmailVerified(_ context.Context, userID uuid.UUID, verifiedAt time.Time) error {
+	stored, ok := r.users[userID]
+	if !ok {
+		return errors.ErrNotFound
+	}
+	stored.user.EmailVerifiedAt = &verifiedAt
+	stored.tokenHash = ""
+	return nil
+}
+
+type emailSenderStub struct {
+	token string
+	sent  int
+}
+
+func (s *emailSenderStub) SendEmailVerification(_ context.Context, _, token string) error {
+	s.token = token
+	s.sent++
+	return nil
+}
+
+type claimCall struct {
+	userID uuid.UUID
+	email  string
+}
+
+type bookingClientStub struct {
+	claimed []string
+	err     error
+	calls   []claimCall
+}
+
+func (c *bookingClientStub) ClaimGuestBookings(_ context.Context, userID uuid.UUID, email string) ([]string, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	c.calls = append(c.calls, claimCall{userID: userID, email: email})
+	return c.claimed, nil
+}
@@ -0,0 +1,37 @@
+package booking
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/semho/hotel-booking/auth-service/internal/domain/port"
+	"github.com/semho/hotel-booking/pkg/auth/servicetoken"
+	pb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
+)
+
+type bookingClient struct {
+	client       pb.BookingServiceClient
+	serviceToken string
+}
+
+// NewBookingClient вызывает внутренние методы booking service с общим секретом serviceToken
+func NewBookingClient(client pb.BookingServiceClient, serviceToken string) port.BookingClient {
+	return &bookingClient{
+		client:       client,
+		serviceToken: serviceToken,
+	}
+}
+
+func (c *bookingClient) ClaimGuestBookings(ctx context.Context, userID uuid.UUID, email string) ([]string, error) {
+	resp, err := c.client.ClaimGuestBookings(
+		servicetoken.WithToken(ctx, c.serviceToken),
+		&pb.ClaimGuestBookingsRequest{
+			UserId: userID.String(),
+			Email:  email,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetBookingIds(), nil
+}
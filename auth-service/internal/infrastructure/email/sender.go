@@ -0,0 +1,82 @@
+package email
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/semho/hotel-booking/auth-service/internal/config"
+	"github.com/semho/hotel-booking/auth-service/internal/domain/port"
+	"github.com/semho/hotel-booking/pkg/logger"
+)
+
+// NewEmailSender отправляет письма через SMTP. Без SMTP сервера ссылка подтверждения только пишется в лог:
+// так можно подтвердить email при локальной разработке
+func NewEmailSender(cfg config.EmailConfig) port.EmailSender {
+	if cfg.SMTPHost == "" {
+		return &logSender{verificationURL: cfg.VerificationURL}
+	}
+	return &smtpSender{cfg: cfg}
+}
+
+type smtpSender struct {
+	cfg config.EmailConfig
+}
+
+func (s *smtpSender) SendEmailVerification(_ context.Context, email, token string) error {
+	link, err := verificationLink(s.cfg.VerificationURL, token)
+	if err != nil {
+		return err
+	}
+
+	message := strings.Join(
+		[]string{
+			"From: " + s.cfg.From,
+			"To: " + email,
+			"Subject: Confirm your email",
+			"Content-Type: text/plain; charset=UTF-8",
+			"",
+			"Confirm your email to see your bookings in your account:",
+			link,
+			"",
+		}, "\r\n",
+	)
+
+	var auth smtp.Auth
+	if s.cfg.SMTPUser != "" {
+		auth = smtp.PlainAuth("", s.cfg.SMTPUser, s.cfg.SMTPPassword, s.cfg.SMTPHost)
+	}
+	addr := net.JoinHostPort(s.cfg.SMTPHost, strconv.Itoa(s.cfg.SMTPPort))
+	if err = smtp.SendMail(addr, auth, s.cfg.From, []string{email}, []byte(message)); err != nil {
+		return fmt.Errorf("failed to send verification email: %w", err)
+	}
+	return nil
+}
+
+type logSender struct {
+	verificationURL string
+}
+
+func (s *logSender) SendEmailVerification(_ context.Context, email, token string) error {
+	link, err := verificationLink(s.verificationURL, token)
+	if err != nil {
+		return err
+	}
+	logger.Log.Info("email verification link (smtp is not configured)", "email", email, "link", link)
+	return nil
+}
+
+func verificationLink(baseURL, token string) (string, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid verification url: %w", err)
+	}
+	query := u.Query()
+	query.Set("token", token)
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
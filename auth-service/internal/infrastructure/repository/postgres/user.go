@@ -24,6 +24,10 @@ const (
 	roleColumn      = "role"
 	createdAtColumn = "created_at"
 	updatedAtColumn = "updated_at"
+
+	emailVerifiedAtColumn       = "email_verified_at"
+	verificationTokenHashColumn = "email_verification_token_hash"
+	verificationExpiresAtColumn = "email_verification_expires_at"
 )
 
 type userRepository struct {
@@ -92,6 +96,7 @@ func (r *userRepository) GetByEmail(ctx context.Context, email string) (*model.U
 			roleColumn,
 			createdAtColumn,
 			updatedAtColumn,
+			emailVerifiedAtColumn,
 		).
 		From(tableUsers).
 		Where(squirrel.Eq{emailColumn: email}).
@@ -121,6 +126,7 @@ func (r *userRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.User
 			roleColumn,
 			createdAtColumn,
 			updatedAtColumn,
+			emailVerifiedAtColumn,
 		).
 		From(tableUsers).
 		Where(squirrel.Eq{idColumn: id}).
@@ -196,3 +202,102 @@ func (r *userRepository) Delete(ctx context.Context, id uuid.UUID) error {
 
 	return nil
 }
+
+func (r *userRepository) SetEmailVerificationToken(
+	ctx context.Context,
+	userID uuid.UUID,
+	tokenHash string,
+	expiresAt time.Time,
+) error {
+	sql, args, err := r.builder.
+		Update(tableUsers).
+		Set(verificationTokenHashColumn, tokenHash).
+		Set(verificationExpiresAtColumn, expiresAt).
+		Set(updatedAtColumn, time.Now()).
+		Where(squirrel.Eq{idColumn: userID}).
+		Where(squirrel.Eq{emailVerifiedAtColumn: nil}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	result, err := r.db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("failed to set email verification token: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if rowsAffected == 0 {
+		return errors.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *userRepository) GetByEmailVerificationToken(
+	ctx context.Context,
+	tokenHash string,
+	now time.Time,
+) (*model.User, error) {
+	sql, args, err := r.builder.
+		Select(
+			idColumn,
+			emailColumn,
+			passwordColumn,
+			firstNameColumn,
+			lastNameColumn,
+			phoneColumn,
+			roleColumn,
+			createdAtColumn,
+			updatedAtColumn,
+			emailVerifiedAtColumn,
+		).
+		From(tableUsers).
+		Where(squirrel.Eq{verificationTokenHashColumn: tokenHash}).
+		Where(squirrel.Gt{verificationExpiresAtColumn: now}).
+		Where(squirrel.Eq{emailVerifiedAtColumn: nil}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var user model.User
+	err = r.db.GetContext(ctx, &user, sql, args...)
+	if err != nil {
+		return nil, errors.ErrNotFound
+	}
+
+	return &user, nil
+}
+
+func (r *userRepository) MarkEmailVerified(ctx context.Context, userID uuid.UUID, verifiedAt time.Time) error {
+	sql, args, err := r.builder.
+		Update(tableUsers).
+		Set(emailVerifiedAtColumn, verifiedAt).
+		Set(verificationTokenHashColumn, nil).
+		Set(verificationExpiresAtColumn, nil).
+		Set(updatedAtColumn, verifiedAt).
+		Where(squirrel.Eq{idColumn: userID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	result, err := r.db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("failed to mark email verified: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if rowsAffected == 0 {
+		return errors.ErrNotFound
+	}
+
+	return nil
+}
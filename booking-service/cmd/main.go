@@ -10,6 +10,8 @@ import (
 	"os/signal"
 	"syscall"
 	"time"
+	// База часовых поясов встроена в бинарник: в runtime-образе alpine ее нет
+	_ "time/tzdata"
 )
 
 func main() {
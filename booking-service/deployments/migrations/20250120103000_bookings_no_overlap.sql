@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Расширение нужно для использования "=" по uuid внутри GiST индекса
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+-- Период проживания [check_in, check_out) и признак активной брони (PENDING/CONFIRMED)
+ALTER TABLE bookings
+    ADD COLUMN stay TSTZRANGE GENERATED ALWAYS AS (tstzrange(check_in, check_out, '[)')) STORED,
+    ADD COLUMN is_active BOOLEAN NOT NULL DEFAULT TRUE;
+
+-- Заполняем признак активности по последнему статусу из истории
+UPDATE bookings AS b
+SET is_active = COALESCE(
+    (SELECT bsh.status IN (1, 2)
+     FROM booking_status_history AS bsh
+     WHERE bsh.booking_id = b.id
+     ORDER BY bsh.changed_at DESC
+     LIMIT 1),
+    TRUE
+);
+
+-- Две активные брони одной комнаты не могут пересекаться по датам
+ALTER TABLE bookings
+    ADD CONSTRAINT bookings_no_overlap
+    EXCLUDE USING gist (room_id WITH =, stay WITH &&) WHERE (is_active);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS bookings_no_overlap;
+ALTER TABLE bookings DROP COLUMN IF EXISTS is_active;
+ALTER TABLE bookings DROP COLUMN IF EXISTS stay;
+-- +goose StatementEnd
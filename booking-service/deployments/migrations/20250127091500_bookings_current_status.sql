@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Текущий статус брони, обновляется в той же транзакции, что и запись в booking_status_history
+ALTER TABLE bookings ADD COLUMN current_status INTEGER NOT NULL DEFAULT 1;
+
+-- Заполняем по последней записи истории
+UPDATE bookings AS b
+SET current_status = bsh.status
+FROM (
+    SELECT DISTINCT ON (booking_id) booking_id, status
+    FROM booking_status_history
+    ORDER BY booking_id, changed_at DESC, id DESC
+) AS bsh
+WHERE bsh.booking_id = b.id;
+
+-- Exclusion constraint теперь опирается на current_status, признак is_active больше не нужен
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS bookings_no_overlap;
+ALTER TABLE bookings DROP COLUMN IF EXISTS is_active;
+ALTER TABLE bookings
+    ADD CONSTRAINT bookings_no_overlap
+    EXCLUDE USING gist (room_id WITH =, stay WITH &&) WHERE (current_status IN (1, 2));
+
+CREATE INDEX idx_bookings_current_status ON bookings (current_status);
+-- Поиск занятых комнат на период среди активных броней (PENDING, CONFIRMED)
+CREATE INDEX idx_bookings_active_room_dates ON bookings (room_id, check_in, check_out)
+    WHERE current_status IN (1, 2);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_bookings_active_room_dates;
+DROP INDEX IF EXISTS idx_bookings_current_status;
+
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS bookings_no_overlap;
+ALTER TABLE bookings ADD COLUMN is_active BOOLEAN NOT NULL DEFAULT TRUE;
+UPDATE bookings SET is_active = current_status IN (1, 2);
+ALTER TABLE bookings
+    ADD CONSTRAINT bookings_no_overlap
+    EXCLUDE USING gist (room_id WITH =, stay WITH &&) WHERE (is_active);
+
+ALTER TABLE bookings DROP COLUMN IF EXISTS current_status;
+-- +goose StatementEnd
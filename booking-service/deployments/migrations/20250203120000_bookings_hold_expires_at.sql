@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Срок удержания неподтвержденной брони, по истечении PENDING бронь отменяется фоновым воркером
+ALTER TABLE bookings ADD COLUMN hold_expires_at TIMESTAMP WITH TIME ZONE;
+
+-- Существующим PENDING броням даем стандартный срок удержания от момента создания
+UPDATE bookings
+SET hold_expires_at = created_at + INTERVAL '15 minutes'
+WHERE current_status = 1;
+
+CREATE INDEX idx_bookings_pending_hold ON bookings (hold_expires_at)
+    WHERE current_status = 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_bookings_pending_hold;
+ALTER TABLE bookings DROP COLUMN IF EXISTS hold_expires_at;
+-- +goose StatementEnd
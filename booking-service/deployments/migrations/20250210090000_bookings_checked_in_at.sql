@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Фактическое время заселения гостя, NULL пока гость не заселился
+ALTER TABLE bookings ADD COLUMN checked_in_at TIMESTAMP WITH TIME ZONE;
+
+-- Ночной планировщик ищет подтвержденные брони по датам заезда и выезда
+CREATE INDEX idx_bookings_confirmed_check_in ON bookings (check_in)
+    WHERE current_status = 2;
+CREATE INDEX idx_bookings_confirmed_check_out ON bookings (check_out)
+    WHERE current_status = 2;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_bookings_confirmed_check_out;
+DROP INDEX IF EXISTS idx_bookings_confirmed_check_in;
+ALTER TABLE bookings DROP COLUMN IF EXISTS checked_in_at;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Фактическое время выезда и номер комнаты, в которую заселен гость
+ALTER TABLE bookings ADD COLUMN checked_out_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE bookings ADD COLUMN room_number VARCHAR(50) NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE bookings DROP COLUMN IF EXISTS room_number;
+ALTER TABLE bookings DROP COLUMN IF EXISTS checked_out_at;
+-- +goose StatementEnd
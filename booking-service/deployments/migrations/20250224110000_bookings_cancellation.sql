@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Результат применения политики отмены: удержанный штраф и сумма к возврату
+ALTER TABLE bookings ADD COLUMN cancellation_penalty DECIMAL(10,2);
+ALTER TABLE bookings ADD COLUMN refund_amount DECIMAL(10,2);
+ALTER TABLE bookings ADD COLUMN cancelled_at TIMESTAMP WITH TIME ZONE;
+
+ALTER TABLE bookings
+    ADD CONSTRAINT bookings_check_cancellation CHECK (
+        cancellation_penalty IS NULL OR (cancellation_penalty >= 0 AND refund_amount >= 0)
+    );
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS bookings_check_cancellation;
+ALTER TABLE bookings DROP COLUMN IF EXISTS cancelled_at;
+ALTER TABLE bookings DROP COLUMN IF EXISTS refund_amount;
+ALTER TABLE bookings DROP COLUMN IF EXISTS cancellation_penalty;
+-- +goose StatementEnd
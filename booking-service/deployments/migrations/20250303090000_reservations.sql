@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Групповая бронь: несколько комнат, забронированных одной операцией с общим кодом подтверждения
+CREATE TABLE IF NOT EXISTS reservations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    confirmation_code VARCHAR(16) NOT NULL,
+    user_id UUID,
+    contact_name VARCHAR(255) NOT NULL,
+    contact_email VARCHAR(255) NOT NULL,
+    contact_phone VARCHAR(50) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT reservations_confirmation_code_unique UNIQUE (confirmation_code)
+    );
+
+CREATE INDEX idx_reservations_user ON reservations (user_id);
+
+ALTER TABLE bookings ADD COLUMN reservation_id UUID REFERENCES reservations(id) ON DELETE CASCADE;
+CREATE INDEX idx_bookings_reservation ON bookings (reservation_id) WHERE reservation_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_bookings_reservation;
+ALTER TABLE bookings DROP COLUMN IF EXISTS reservation_id;
+DROP TABLE IF EXISTS reservations;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Ключи идемпотентности создания брони: повторный запрос с тем же ключом возвращает сохраненный ответ
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    key VARCHAR(255) PRIMARY KEY,
+    request_hash VARCHAR(64) NOT NULL,
+    booking_id UUID REFERENCES bookings(id) ON DELETE CASCADE,
+    response JSONB,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+    );
+
+CREATE INDEX idx_idempotency_keys_created_at ON idempotency_keys (created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS idempotency_keys;
+-- +goose StatementEnd
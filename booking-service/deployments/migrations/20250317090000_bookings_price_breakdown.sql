@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Цена каждой ночи по тарифному плану с примененными правилами
+ALTER TABLE bookings ADD COLUMN price_breakdown JSONB NOT NULL DEFAULT '[]';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE bookings DROP COLUMN IF EXISTS price_breakdown;
+-- +goose StatementEnd
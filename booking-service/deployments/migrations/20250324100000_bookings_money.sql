@@ -0,0 +1,89 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Денежная сумма в минимальных единицах валюты (копейках) с кодом валюты ISO 4217
+CREATE TYPE money_amount AS (
+    amount BIGINT,
+    currency CHAR(3)
+    );
+
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS bookings_check_price;
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS bookings_check_cancellation;
+
+-- Все существующие суммы были в рублях
+ALTER TABLE bookings
+    ALTER COLUMN total_price TYPE money_amount
+        USING ROW(ROUND(total_price * 100)::BIGINT, 'RUB')::money_amount,
+    ALTER COLUMN cancellation_penalty TYPE money_amount
+        USING CASE WHEN cancellation_penalty IS NOT NULL
+            THEN ROW(ROUND(cancellation_penalty * 100)::BIGINT, 'RUB')::money_amount END,
+    ALTER COLUMN refund_amount TYPE money_amount
+        USING CASE WHEN refund_amount IS NOT NULL
+            THEN ROW(ROUND(refund_amount * 100)::BIGINT, 'RUB')::money_amount END;
+
+ALTER TABLE bookings
+    ADD CONSTRAINT bookings_check_price CHECK ((total_price).amount >= 0);
+ALTER TABLE bookings
+    ADD CONSTRAINT bookings_check_cancellation CHECK (
+        cancellation_penalty IS NULL OR ((cancellation_penalty).amount >= 0 AND (refund_amount).amount >= 0)
+    );
+
+-- Цены ночей в разбивке: число -> {"amount": копейки, "currency": "RUB"}
+UPDATE bookings b
+SET price_breakdown = (
+    SELECT jsonb_agg(
+        n.night
+            || jsonb_build_object(
+                'base_price', jsonb_build_object(
+                    'amount', ROUND((n.night ->> 'base_price')::NUMERIC * 100)::BIGINT, 'currency', 'RUB'
+                ),
+                'price', jsonb_build_object(
+                    'amount', ROUND((n.night ->> 'price')::NUMERIC * 100)::BIGINT, 'currency', 'RUB'
+                )
+            )
+        ORDER BY n.idx
+    )
+    FROM jsonb_array_elements(b.price_breakdown) WITH ORDINALITY AS n(night, idx)
+)
+WHERE jsonb_array_length(b.price_breakdown) > 0;
+
+-- Миграция удаляет ВСЕ ключи идемпотентности: сохраненные ответы содержат суммы в старом формате
+-- и не читаются новой версией. Повтор запроса с ключом, выданным до миграции, создаст новую бронь
+-- вместо возврата исходной. Ключи и так живут не дольше суток (booking.idempotency_ttl)
+DELETE FROM idempotency_keys;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- Ответы в новом формате не читаются старой версией, ключи удаляются и при откате
+DELETE FROM idempotency_keys;
+
+UPDATE bookings b
+SET price_breakdown = (
+    SELECT jsonb_agg(
+        n.night
+            || jsonb_build_object(
+                'base_price', ((n.night -> 'base_price' ->> 'amount')::NUMERIC / 100),
+                'price', ((n.night -> 'price' ->> 'amount')::NUMERIC / 100)
+            )
+        ORDER BY n.idx
+    )
+    FROM jsonb_array_elements(b.price_breakdown) WITH ORDINALITY AS n(night, idx)
+)
+WHERE jsonb_array_length(b.price_breakdown) > 0;
+
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS bookings_check_price;
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS bookings_check_cancellation;
+
+ALTER TABLE bookings
+    ALTER COLUMN total_price TYPE DECIMAL(10,2) USING (total_price).amount / 100.0,
+    ALTER COLUMN cancellation_penalty TYPE DECIMAL(10,2) USING (cancellation_penalty).amount / 100.0,
+    ALTER COLUMN refund_amount TYPE DECIMAL(10,2) USING (refund_amount).amount / 100.0;
+
+ALTER TABLE bookings ADD CONSTRAINT bookings_check_price CHECK (total_price >= 0);
+ALTER TABLE bookings
+    ADD CONSTRAINT bookings_check_cancellation CHECK (
+        cancellation_penalty IS NULL OR (cancellation_penalty >= 0 AND refund_amount >= 0)
+    );
+
+DROP TYPE IF EXISTS money_amount;
+-- +goose StatementEnd
@@ -0,0 +1,48 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Промокоды: процентная скидка на каждую ночь или фиксированная сумма на бронь
+CREATE TABLE IF NOT EXISTS promo_codes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    code VARCHAR(64) NOT NULL,
+    discount_type INTEGER NOT NULL,
+    discount_percent INTEGER NOT NULL DEFAULT 0,
+    discount_amount money_amount,
+    valid_from TIMESTAMP WITH TIME ZONE,
+    valid_to TIMESTAMP WITH TIME ZONE,
+    room_types INTEGER[] NOT NULL DEFAULT '{}',
+    max_redemptions INTEGER,
+    max_redemptions_per_user INTEGER,
+    redemptions INTEGER NOT NULL DEFAULT 0,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT promo_codes_code_unique UNIQUE (code),
+    CONSTRAINT promo_codes_percent_range CHECK (discount_percent BETWEEN 0 AND 100),
+    CONSTRAINT promo_codes_redemptions_non_negative CHECK (redemptions >= 0)
+    );
+
+-- Погашения промокодов; удаляются при отмене брони, освобождая лимиты
+CREATE TABLE IF NOT EXISTS promo_redemptions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    promo_code_id UUID NOT NULL REFERENCES promo_codes(id),
+    booking_id UUID NOT NULL REFERENCES bookings(id) ON DELETE CASCADE,
+    user_id UUID,
+    guest_email VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT promo_redemptions_booking_unique UNIQUE (booking_id)
+    );
+
+CREATE INDEX idx_promo_redemptions_user ON promo_redemptions (promo_code_id, user_id);
+CREATE INDEX idx_promo_redemptions_email ON promo_redemptions (promo_code_id, guest_email);
+
+ALTER TABLE bookings ADD COLUMN promo_code VARCHAR(64);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE bookings DROP COLUMN IF EXISTS promo_code;
+DROP TABLE IF EXISTS promo_redemptions;
+DROP TABLE IF EXISTS promo_codes;
+-- +goose StatementEnd
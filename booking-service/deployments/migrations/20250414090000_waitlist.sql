@@ -0,0 +1,44 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Лист ожидания на занятые даты: при освобождении комнаты первая подходящая запись получает PENDING бронь
+CREATE TABLE IF NOT EXISTS waitlist_entries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL,
+    guest_name VARCHAR(255) NOT NULL,
+    guest_email VARCHAR(255) NOT NULL,
+    guest_phone VARCHAR(50) NOT NULL DEFAULT '',
+    check_in TIMESTAMP WITH TIME ZONE NOT NULL,
+    check_out TIMESTAMP WITH TIME ZONE NOT NULL,
+    capacity INTEGER,
+    room_type INTEGER,
+    status INTEGER NOT NULL,
+    booking_id UUID REFERENCES bookings(id) ON DELETE SET NULL,
+    offer_expires_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT waitlist_entries_dates_check CHECK (check_out > check_in)
+    );
+
+-- Очередь ожидающих записей в порядке поступления
+CREATE INDEX idx_waitlist_entries_waiting ON waitlist_entries (created_at) WHERE status = 1;
+CREATE INDEX idx_waitlist_entries_user ON waitlist_entries (user_id, created_at);
+CREATE INDEX idx_waitlist_entries_booking ON waitlist_entries (booking_id) WHERE booking_id IS NOT NULL;
+
+-- Исходящие уведомления (outbox): записываются в одной транзакции с изменением, доставляются отдельно
+CREATE TABLE IF NOT EXISTS notification_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_type VARCHAR(64) NOT NULL,
+    payload JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    published_at TIMESTAMP WITH TIME ZONE
+    );
+
+CREATE INDEX idx_notification_events_unpublished ON notification_events (created_at) WHERE published_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS notification_events;
+DROP TABLE IF EXISTS waitlist_entries;
+-- +goose StatementEnd
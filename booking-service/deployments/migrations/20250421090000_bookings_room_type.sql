@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Тип и вместимость комнаты, на которые оформлена бронь. Бронь сверх свободных комнат (овербукинг)
+-- создается без комнаты: она закрепляется при заселении
+ALTER TABLE bookings
+    ALTER COLUMN room_id DROP NOT NULL,
+    ADD COLUMN room_type INTEGER,
+    ADD COLUMN capacity INTEGER,
+    ADD CONSTRAINT bookings_room_or_type CHECK (room_id IS NOT NULL OR room_type IS NOT NULL);
+
+-- Активные брони без комнаты учитываются в продажах своего типа
+CREATE INDEX idx_bookings_unassigned ON bookings (room_type, check_in, check_out)
+    WHERE room_id IS NULL AND current_status IN (1, 2);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_bookings_unassigned;
+DELETE FROM bookings WHERE room_id IS NULL;
+ALTER TABLE bookings
+    DROP CONSTRAINT IF EXISTS bookings_room_or_type,
+    DROP COLUMN IF EXISTS capacity,
+    DROP COLUMN IF EXISTS room_type,
+    ALTER COLUMN room_id SET NOT NULL;
+-- +goose StatementEnd
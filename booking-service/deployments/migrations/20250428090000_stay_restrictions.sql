@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Ограничения продаж на период дат: длительность проживания, запрет заезда и выезда, окно бронирования
+CREATE TABLE IF NOT EXISTS stay_restrictions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(255) NOT NULL DEFAULT '',
+    date_from DATE NOT NULL,
+    date_to DATE NOT NULL,
+    room_type INTEGER,
+    weekdays INTEGER[] NOT NULL DEFAULT '{}',
+    min_stay INTEGER,
+    max_stay INTEGER,
+    closed_to_arrival BOOLEAN NOT NULL DEFAULT FALSE,
+    closed_to_departure BOOLEAN NOT NULL DEFAULT FALSE,
+    min_advance_days INTEGER,
+    max_advance_days INTEGER,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT stay_restrictions_dates CHECK (date_to >= date_from),
+    CONSTRAINT stay_restrictions_stay CHECK (min_stay IS NULL OR max_stay IS NULL OR min_stay <= max_stay),
+    CONSTRAINT stay_restrictions_advance CHECK (
+        min_advance_days IS NULL OR max_advance_days IS NULL OR min_advance_days <= max_advance_days
+    )
+    );
+
+CREATE INDEX idx_stay_restrictions_dates ON stay_restrictions (date_from, date_to);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS stay_restrictions;
+-- +goose StatementEnd
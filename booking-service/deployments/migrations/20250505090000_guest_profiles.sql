@@ -0,0 +1,73 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Профили гостей: предпочтения, заполняемые администратором. Статистика проживаний считается по броням
+CREATE TABLE IF NOT EXISTS guest_profiles (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(255) NOT NULL,
+    bed_type VARCHAR(64) NOT NULL DEFAULT '',
+    preferred_floor VARCHAR(64) NOT NULL DEFAULT '',
+    allergies TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+    );
+
+-- Нормализованные email и телефоны гостя: каждый контакт принадлежит одному профилю
+CREATE TABLE IF NOT EXISTS guest_contacts (
+    type VARCHAR(16) NOT NULL,
+    value VARCHAR(255) NOT NULL,
+    profile_id UUID NOT NULL REFERENCES guest_profiles(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (type, value),
+    CONSTRAINT guest_contacts_type CHECK (type IN ('email', 'phone'))
+    );
+
+CREATE INDEX idx_guest_contacts_profile ON guest_contacts (profile_id);
+
+ALTER TABLE bookings ADD COLUMN guest_profile_id UUID REFERENCES guest_profiles(id);
+CREATE INDEX idx_bookings_guest_profile ON bookings (guest_profile_id);
+
+-- Профили существующих гостей: по одному на email, имя берется из последней брони
+WITH guests AS (
+    SELECT DISTINCT ON (LOWER(TRIM(guest_email)))
+        gen_random_uuid() AS profile_id,
+        LOWER(TRIM(guest_email)) AS email,
+        guest_name
+    FROM bookings
+    WHERE TRIM(guest_email) <> ''
+    ORDER BY LOWER(TRIM(guest_email)), created_at DESC
+), profiles AS (
+    INSERT INTO guest_profiles (id, name)
+    SELECT profile_id, guest_name FROM guests
+)
+INSERT INTO guest_contacts (type, value, profile_id)
+SELECT 'email', email, profile_id FROM guests;
+
+UPDATE bookings b
+SET guest_profile_id = c.profile_id
+FROM guest_contacts c
+WHERE c.type = 'email' AND c.value = LOWER(TRIM(b.guest_email));
+
+-- Телефон: цифры с ведущим '+', достается профилю последней брони с этим номером
+INSERT INTO guest_contacts (type, value, profile_id)
+SELECT DISTINCT ON (phone) 'phone', phone, guest_profile_id
+FROM (
+    SELECT
+        CASE WHEN TRIM(guest_phone) LIKE '+%' THEN '+' ELSE '' END
+            || REGEXP_REPLACE(guest_phone, '[^0-9]', '', 'g') AS phone,
+        guest_profile_id,
+        created_at
+    FROM bookings
+    WHERE guest_profile_id IS NOT NULL
+) p
+WHERE LENGTH(REPLACE(phone, '+', '')) >= 5
+ORDER BY phone, created_at DESC
+ON CONFLICT DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE bookings DROP COLUMN IF EXISTS guest_profile_id;
+DROP TABLE IF EXISTS guest_contacts;
+DROP TABLE IF EXISTS guest_profiles;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Ключ идемпотентности уникален в пределах пользователя: одинаковые ключи разных клиентов не пересекаются.
+-- Анонимные запросы хранятся под нулевым UUID, первичный ключ не допускает NULL
+ALTER TABLE idempotency_keys
+    ADD COLUMN user_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000';
+
+UPDATE idempotency_keys k
+SET user_id = b.user_id
+FROM bookings b
+WHERE b.id = k.booking_id AND b.user_id IS NOT NULL;
+
+ALTER TABLE idempotency_keys DROP CONSTRAINT idempotency_keys_pkey;
+ALTER TABLE idempotency_keys ADD PRIMARY KEY (user_id, key);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- Из одинаковых ключей разных пользователей остается самый новый
+DELETE FROM idempotency_keys a
+    USING idempotency_keys b
+WHERE a.key = b.key AND (a.created_at, a.ctid) < (b.created_at, b.ctid);
+
+ALTER TABLE idempotency_keys DROP CONSTRAINT idempotency_keys_pkey;
+ALTER TABLE idempotency_keys ADD PRIMARY KEY (key);
+ALTER TABLE idempotency_keys DROP COLUMN user_id;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Попытки отправки уведомлений из outbox: после неудачи уведомление повторяется,
+-- пока не исчерпает число попыток; причина последней неудачи остается для разбора
+ALTER TABLE notification_events
+    ADD COLUMN attempts INT NOT NULL DEFAULT 0,
+    ADD COLUMN last_error TEXT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE notification_events
+    DROP COLUMN IF EXISTS last_error,
+    DROP COLUMN IF EXISTS attempts;
+-- +goose StatementEnd
@@ -2,8 +2,14 @@ package grpc
 
 import (
 	"context"
+	"time"
+
+	"github.com/google/uuid"
 	"github.com/semho/hotel-booking/booking-service/internal/api/grpc/mapper"
 	"github.com/semho/hotel-booking/booking-service/internal/domain/port"
+	"github.com/semho/hotel-booking/pkg/errors"
+	"github.com/semho/hotel-booking/pkg/logger"
+	"github.com/semho/hotel-booking/pkg/money"
 	bookingpb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
 )
 
@@ -11,12 +17,18 @@ type BookingHandler struct {
 	bookingpb.UnimplementedBookingServiceServer
 	bookingService port.BookingService
 	roomClient     port.RoomClient
+	nightlyRunner  port.NightlyRunner
 }
 
-func NewBookingHandler(bookingService port.BookingService, roomClient port.RoomClient) *BookingHandler {
+func NewBookingHandler(
+	bookingService port.BookingService,
+	roomClient port.RoomClient,
+	nightlyRunner port.NightlyRunner,
+) *BookingHandler {
 	return &BookingHandler{
 		bookingService: bookingService,
 		roomClient:     roomClient,
+		nightlyRunner:  nightlyRunner,
 	}
 }
 
@@ -47,14 +59,786 @@ func (h *BookingHandler) CreateBooking(
 	ctx context.Context,
 	req *bookingpb.CreateBookingRequest,
 ) (*bookingpb.CreateBookingResponse, error) {
-	booking := mapper.ProtoToBooking(req)
+	booking, err := mapper.ProtoToBooking(req)
+	if err != nil {
+		return nil, mapper.ToDomainError(err)
+	}
 
-	err := h.bookingService.CreateBooking(ctx, booking, req.GetType(), req.GetCapacity())
+	idempotencyKey, err := mapper.IdempotencyKeyFromContext(ctx, booking.UserID, req)
 	if err != nil {
-		return nil, err
+		return nil, mapper.ToDomainError(err)
 	}
 
+	err = h.bookingService.CreateBooking(ctx, booking, req.GetType(), req.GetCapacity(), idempotencyKey)
+	if err != nil {
+		logger.Log.Error(
+			"failed to create booking",
+			"error", err,
+		)
+		return nil, mapper.ToDomainError(err)
+	}
+
+	logger.Log.Info("booking successfully created", "booking id", booking.ID, "room id", booking.RoomID)
+
 	return &bookingpb.CreateBookingResponse{
 		Booking: mapper.BookingToProto(booking),
 	}, nil
 }
+
+func (h *BookingHandler) GetBooking(
+	ctx context.Context,
+	req *bookingpb.GetBookingRequest,
+) (*bookingpb.GetBookingResponse, error) {
+	bookingID, err := uuid.Parse(req.GetBookingId())
+	if err != nil {
+		return nil, mapper.ToDomainError(errors.WithMessage(errors.ErrInvalidInput, "invalid booking_id"))
+	}
+
+	booking, err := h.bookingService.GetBooking(ctx, bookingID)
+	if err != nil {
+		logger.Log.Error(
+			"failed to get booking",
+			"booking id", bookingID,
+			"error", err,
+		)
+		return nil, mapper.ToDomainError(err)
+	}
+
+	protoBooking := mapper.BookingToProto(booking)
+	if err := h.convertTotalPrices(ctx, req.Currency, protoBooking); err != nil {
+		return nil, mapper.ToDomainError(err)
+	}
+
+	return &bookingpb.GetBookingResponse{
+		Booking: protoBooking,
+	}, nil
+}
+
+func (h *BookingHandler) ListBookings(
+	ctx context.Context,
+	req *bookingpb.ListBookingsRequest,
+) (*bookingpb.ListBookingsResponse, error) {
+	filter, err := mapper.ProtoToBookingFilter(req)
+	if err != nil {
+		return nil, mapper.ToDomainError(err)
+	}
+
+	bookings, nextCursor, err := h.bookingService.ListBookings(ctx, filter)
+	if err != nil {
+		logger.Log.Error(
+			"failed to list bookings",
+			"error", err,
+		)
+		return nil, mapper.ToDomainError(err)
+	}
+
+	protoBookings := mapper.BookingsToProto(bookings)
+	if err := h.convertTotalPrices(ctx, req.Currency, protoBookings...); err != nil {
+		return nil, mapper.ToDomainError(err)
+	}
+
+	return &bookingpb.ListBookingsResponse{
+		Bookings:      protoBookings,
+		NextPageToken: mapper.EncodePageToken(nextCursor),
+	}, nil
+}
+
+// convertTotalPrices заполняет converted_total_price, если клиент запросил цены в другой валюте
+func (h *BookingHandler) convertTotalPrices(ctx context.Context, currency *string, bookings ...*bookingpb.Booking) error {
+	if currency == nil {
+		return nil
+	}
+	for _, booking := range bookings {
+		converted, err := h.bookingService.ConvertPrice(ctx, money.FromProto(booking.TotalPrice), *currency)
+		if err != nil {
+			return err
+		}
+		booking.ConvertedTotalPrice = converted.ToProto()
+	}
+	return nil
+}
+
+func (h *BookingHandler) GetBookingHistory(
+	ctx context.Context,
+	req *bookingpb.GetBookingHistoryRequest,
+) (*bookingpb.GetBookingHistoryResponse, error) {
+	bookingID, err := uuid.Parse(req.GetBookingId())
+	if err != nil {
+		return nil, mapper.ToDomainError(errors.WithMessage(errors.ErrInvalidInput, "invalid booking_id"))
+	}
+
+	history, err := h.bookingService.GetBookingHistory(ctx, bookingID)
+	if err != nil {
+		logger.Log.Error(
+			"failed to get booking history",
+			"booking id", bookingID,
+			"error", err,
+		)
+		return nil, mapper.ToDomainError(err)
+	}
+
+	return &bookingpb.GetBookingHistoryResponse{
+		History: mapper.StatusHistoryToProto(history),
+	}, nil
+}
+
+func (h *BookingHandler) UpdateBookingStatus(
+	ctx context.Context,
+	req *bookingpb.UpdateBookingStatusRequest,
+) (*bookingpb.UpdateBookingStatusResponse, error) {
+	bookingID, err := uuid.Parse(req.GetBookingId())
+	if err != nil {
+		return nil, mapper.ToDomainError(errors.WithMessage(errors.ErrInvalidInput, "invalid booking_id"))
+	}
+
+	booking, err := h.bookingService.UpdateBookingStatus(
+		ctx,
+		bookingID,
+		req.GetStatus(),
+		req.GetReason(),
+		req.GetChangedBy(),
+	)
+	if err != nil {
+		logger.Log.Error(
+			"failed to update booking status",
+			"booking id", bookingID,
+			"status", req.GetStatus(),
+			"error", err,
+		)
+		return nil, mapper.ToDomainError(err)
+	}
+
+	logger.Log.Info("booking status updated", "booking id", bookingID, "status", req.GetStatus())
+	return &bookingpb.UpdateBookingStatusResponse{
+		Booking: mapper.BookingToProto(booking),
+	}, nil
+}
+
+func (h *BookingHandler) RunNightlyTransitions(
+	ctx context.Context,
+	req *bookingpb.RunNightlyTransitionsRequest,
+) (*bookingpb.RunNightlyTransitionsResponse, error) {
+	asOf := time.Now()
+	if req.AsOf != nil {
+		asOf = req.AsOf.AsTime()
+	}
+
+	transitions, err := h.nightlyRunner.RunOnce(ctx, asOf, req.GetDryRun())
+	if err != nil {
+		logger.Log.Error("failed to run nightly transitions", "as of", asOf, "error", err)
+		return nil, mapper.ToDomainError(err)
+	}
+
+	logger.Log.Info(
+		"nightly transitions run manually",
+		"as of", asOf,
+		"dry run", req.GetDryRun(),
+		"count", len(transitions),
+	)
+	return &bookingpb.RunNightlyTransitionsResponse{
+		Transitions: mapper.StatusTransitionsToProto(transitions),
+	}, nil
+}
+
+func (h *BookingHandler) CreateReservation(
+	ctx context.Context,
+	req *bookingpb.CreateReservationRequest,
+) (*bookingpb.CreateReservationResponse, error) {
+	reservation, rooms, err := mapper.ProtoToReservation(req)
+	if err != nil {
+		return nil, mapper.ToDomainError(err)
+	}
+
+	err = h.bookingService.CreateReservation(ctx, reservation, req.CheckIn.AsTime(), req.CheckOut.AsTime(), rooms)
+	if err != nil {
+		logger.Log.Error("failed to create reservation", "rooms", len(rooms), "error", err)
+		return nil, mapper.ToDomainError(err)
+	}
+
+	logger.Log.Info(
+		"reservation created",
+		"reservation id", reservation.ID,
+		"confirmation code", reservation.ConfirmationCode,
+		"rooms", len(reservation.Bookings),
+	)
+	reservationProto, err := mapper.ReservationToProto(reservation)
+	if err != nil {
+		return nil, mapper.ToDomainError(err)
+	}
+
+	return &bookingpb.CreateReservationResponse{
+		Reservation: reservationProto,
+	}, nil
+}
+
+func (h *BookingHandler) GetReservation(
+	ctx context.Context,
+	req *bookingpb.GetReservationRequest,
+) (*bookingpb.GetReservationResponse, error) {
+	reservationID, err := uuid.Parse(req.GetReservationId())
+	if err != nil {
+		return nil, mapper.ToDomainError(errors.WithMessage(errors.ErrInvalidInput, "invalid reservation_id"))
+	}
+
+	reservation, err := h.bookingService.GetReservation(ctx, reservationID)
+	if err != nil {
+		logger.Log.Error("failed to get reservation", "reservation id", reservationID, "error", err)
+		return nil, mapper.ToDomainError(err)
+	}
+
+	reservationProto, err := mapper.ReservationToProto(reservation)
+	if err != nil {
+		return nil, mapper.ToDomainError(err)
+	}
+
+	return &bookingpb.GetReservationResponse{
+		Reservation: reservationProto,
+	}, nil
+}
+
+func (h *BookingHandler) ModifyBooking(
+	ctx context.Context,
+	req *bookingpb.ModifyBookingRequest,
+) (*bookingpb.ModifyBookingResponse, error) {
+	bookingID, err := uuid.Parse(req.GetBookingId())
+	if err != nil {
+		return nil, mapper.ToDomainError(errors.WithMessage(errors.ErrInvalidInput, "invalid booking_id"))
+	}
+
+	booking, err := h.bookingService.ModifyBooking(
+		ctx,
+		bookingID,
+		mapper.ProtoToBookingChanges(req),
+		req.GetChangedBy(),
+	)
+	if err != nil {
+		logger.Log.Error("failed to modify booking", "booking id", bookingID, "error", err)
+		return nil, mapper.ToDomainError(err)
+	}
+
+	logger.Log.Info("booking modified", "booking id", bookingID, "room id", booking.RoomID)
+	return &bookingpb.ModifyBookingResponse{
+		Booking: mapper.BookingToProto(booking),
+	}, nil
+}
+
+func (h *BookingHandler) GetCancellationQuote(
+	ctx context.Context,
+	req *bookingpb.GetCancellationQuoteRequest,
+) (*bookingpb.GetCancellationQuoteResponse, error) {
+	bookingID, err := uuid.Parse(req.GetBookingId())
+	if err != nil {
+		return nil, mapper.ToDomainError(errors.WithMessage(errors.ErrInvalidInput, "invalid booking_id"))
+	}
+
+	quote, err := h.bookingService.GetCancellationQuote(ctx, bookingID)
+	if err != nil {
+		logger.Log.Error("failed to get cancellation quote", "booking id", bookingID, "error", err)
+		return nil, mapper.ToDomainError(err)
+	}
+
+	return &bookingpb.GetCancellationQuoteResponse{
+		Quote: mapper.CancellationQuoteToProto(quote),
+	}, nil
+}
+
+func (h *BookingHandler) CancelBooking(
+	ctx context.Context,
+	req *bookingpb.CancelBookingRequest,
+) (*bookingpb.CancelBookingResponse, error) {
+	bookingID, err := uuid.Parse(req.GetBookingId())
+	if err != nil {
+		return nil, mapper.ToDomainError(errors.WithMessage(errors.ErrInvalidInput, "invalid booking_id"))
+	}
+
+	booking, quote, err := h.bookingService.CancelBooking(ctx, bookingID, req.GetReason(), req.GetChangedBy())
+	if err != nil {
+		logger.Log.Error("failed to cancel booking", "booking id", bookingID, "error", err)
+		return nil, mapper.ToDomainError(err)
+	}
+
+	logger.Log.Info(
+		"booking cancelled",
+		"booking id", bookingID,
+		"penalty", quote.Penalty,
+		"refund", quote.Refund,
+	)
+	return &bookingpb.CancelBookingResponse{
+		Booking: mapper.BookingToProto(booking),
+		Quote:   mapper.CancellationQuoteToProto(quote),
+	}, nil
+}
+
+func (h *BookingHandler) CheckIn(
+	ctx context.Context,
+	req *bookingpb.CheckInRequest,
+) (*bookingpb.CheckInResponse, error) {
+	bookingID, err := uuid.Parse(req.GetBookingId())
+	if err != nil {
+		return nil, mapper.ToDomainError(errors.WithMessage(errors.ErrInvalidInput, "invalid booking_id"))
+	}
+
+	var roomID *uuid.UUID
+	if req.RoomId != nil {
+		id, err := uuid.Parse(*req.RoomId)
+		if err != nil {
+			return nil, mapper.ToDomainError(errors.WithMessage(errors.ErrInvalidInput, "invalid room_id"))
+		}
+		roomID = &id
+	}
+
+	booking, err := h.bookingService.CheckIn(ctx, bookingID, roomID, req.GetChangedBy())
+	if err != nil {
+		logger.Log.Error("failed to check in", "booking id", bookingID, "error", err)
+		return nil, mapper.ToDomainError(err)
+	}
+
+	logger.Log.Info("guest checked in", "booking id", bookingID, "room number", booking.RoomNumber)
+	return &bookingpb.CheckInResponse{
+		Booking: mapper.BookingToProto(booking),
+	}, nil
+}
+
+func (h *BookingHandler) CheckOut(
+	ctx context.Context,
+	req *bookingpb.CheckOutRequest,
+) (*bookingpb.CheckOutResponse, error) {
+	bookingID, err := uuid.Parse(req.GetBookingId())
+	if err != nil {
+		return nil, mapper.ToDomainError(errors.WithMessage(errors.ErrInvalidInput, "invalid booking_id"))
+	}
+
+	booking, err := h.bookingService.CheckOut(ctx, bookingID, req.GetChangedBy())
+	if err != nil {
+		logger.Log.Error("failed to check out", "booking id", bookingID, "error", err)
+		return nil, mapper.ToDomainError(err)
+	}
+
+	logger.Log.Info("guest checked out", "booking id", bookingID, "total price", booking.TotalPrice)
+	return &bookingpb.CheckOutResponse{
+		Booking: mapper.BookingToProto(booking),
+	}, nil
+}
+
+func (h *BookingHandler) AssignRoom(
+	ctx context.Context,
+	req *bookingpb.AssignRoomRequest,
+) (*bookingpb.AssignRoomResponse, error) {
+	bookingID, err := uuid.Parse(req.GetBookingId())
+	if err != nil {
+		return nil, mapper.ToDomainError(errors.WithMessage(errors.ErrInvalidInput, "invalid booking_id"))
+	}
+	roomID, err := uuid.Parse(req.GetRoomId())
+	if err != nil {
+		return nil, mapper.ToDomainError(errors.WithMessage(errors.ErrInvalidInput, "invalid room_id"))
+	}
+
+	booking, err := h.bookingService.AssignRoom(ctx, bookingID, roomID, req.GetChangedBy())
+	if err != nil {
+		logger.Log.Error("failed to assign room", "booking id", bookingID, "error", err)
+		return nil, mapper.ToDomainError(err)
+	}
+
+	logger.Log.Info("room assigned", "booking id", bookingID, "room id", roomID)
+	return &bookingpb.AssignRoomResponse{
+		Booking: mapper.BookingToProto(booking),
+	}, nil
+}
+
+func (h *BookingHandler) CreatePromoCode(
+	ctx context.Context,
+	req *bookingpb.CreatePromoCodeRequest,
+) (*bookingpb.PromoCodeResponse, error) {
+	promo := mapper.ProtoToPromoCode(req)
+	if err := h.bookingService.CreatePromoCode(ctx, promo); err != nil {
+		logger.Log.Error("failed to create promo code", "code", req.GetCode(), "error", err)
+		return nil, mapper.ToDomainError(err)
+	}
+
+	logger.Log.Info("promo code created", "promo code id", promo.ID, "code", promo.Code)
+	return &bookingpb.PromoCodeResponse{
+		PromoCode: mapper.PromoCodeToProto(promo),
+	}, nil
+}
+
+func (h *BookingHandler) GetPromoCode(
+	ctx context.Context,
+	req *bookingpb.GetPromoCodeRequest,
+) (*bookingpb.PromoCodeResponse, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, mapper.ToDomainError(errors.WithMessage(errors.ErrInvalidInput, "invalid promo code id"))
+	}
+
+	promo, err := h.bookingService.GetPromoCode(ctx, id)
+	if err != nil {
+		return nil, mapper.ToDomainError(err)
+	}
+
+	return &bookingpb.PromoCodeResponse{
+		PromoCode: mapper.PromoCodeToProto(promo),
+	}, nil
+}
+
+func (h *BookingHandler) ListPromoCodes(
+	ctx context.Context,
+	req *bookingpb.ListPromoCodesRequest,
+) (*bookingpb.ListPromoCodesResponse, error) {
+	promos, err := h.bookingService.ListPromoCodes(ctx, req.GetActiveOnly())
+	if err != nil {
+		logger.Log.Error("failed to list promo codes", "error", err)
+		return nil, mapper.ToDomainError(err)
+	}
+
+	return &bookingpb.ListPromoCodesResponse{
+		PromoCodes: mapper.PromoCodesToProto(promos),
+	}, nil
+}
+
+func (h *BookingHandler) UpdatePromoCode(
+	ctx context.Context,
+	req *bookingpb.UpdatePromoCodeRequest,
+) (*bookingpb.PromoCodeResponse, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, mapper.ToDomainError(errors.WithMessage(errors.ErrInvalidInput, "invalid promo code id"))
+	}
+
+	promo := mapper.ProtoToPromoCodeUpdate(req)
+	promo.ID = id
+	if err = h.bookingService.UpdatePromoCode(ctx, promo); err != nil {
+		logger.Log.Error("failed to update promo code", "promo code id", id, "error", err)
+		return nil, mapper.ToDomainError(err)
+	}
+
+	return &bookingpb.PromoCodeResponse{
+		PromoCode: mapper.PromoCodeToProto(promo),
+	}, nil
+}
+
+func (h *BookingHandler) DeletePromoCode(
+	ctx context.Context,
+	req *bookingpb.DeletePromoCodeRequest,
+) (*bookingpb.DeletePromoCodeResponse, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, mapper.ToDomainError(errors.WithMessage(errors.ErrInvalidInput, "invalid promo code id"))
+	}
+
+	if err = h.bookingService.DeletePromoCode(ctx, id); err != nil {
+		logger.Log.Error("failed to delete promo code", "promo code id", id, "error", err)
+		return nil, mapper.ToDomainError(err)
+	}
+
+	return &bookingpb.DeletePromoCodeResponse{}, nil
+}
+
+func (h *BookingHandler) CreateStayRestriction(
+	ctx context.Context,
+	req *bookingpb.CreateStayRestrictionRequest,
+) (*bookingpb.StayRestrictionResponse, error) {
+	restriction := mapper.ProtoToStayRestriction(req)
+	if err := h.bookingService.CreateStayRestriction(ctx, restriction); err != nil {
+		logger.Log.Error("failed to create stay restriction", "name", req.GetName(), "error", err)
+		return nil, mapper.ToDomainError(err)
+	}
+
+	logger.Log.Info("stay restriction created", "restriction id", restriction.ID)
+	return &bookingpb.StayRestrictionResponse{
+		Restriction: mapper.StayRestrictionToProto(restriction),
+	}, nil
+}
+
+func (h *BookingHandler) GetStayRestriction(
+	ctx context.Context,
+	req *bookingpb.GetStayRestrictionRequest,
+) (*bookingpb.StayRestrictionResponse, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, mapper.ToDomainError(errors.WithMessage(errors.ErrInvalidInput, "invalid stay restriction id"))
+	}
+
+	restriction, err := h.bookingService.GetStayRestriction(ctx, id)
+	if err != nil {
+		return nil, mapper.ToDomainError(err)
+	}
+
+	return &bookingpb.StayRestrictionResponse{
+		Restriction: mapper.StayRestrictionToProto(restriction),
+	}, nil
+}
+
+func (h *BookingHandler) ListStayRestrictions(
+	ctx context.Context,
+	req *bookingpb.ListStayRestrictionsRequest,
+) (*bookingpb.ListStayRestrictionsResponse, error) {
+	restrictions, err := h.bookingService.ListStayRestrictions(ctx, mapper.ProtoToStayRestrictionFilter(req))
+	if err != nil {
+		logger.Log.Error("failed to list stay restrictions", "error", err)
+		return nil, mapper.ToDomainError(err)
+	}
+
+	return &bookingpb.ListStayRestrictionsResponse{
+		Restrictions: mapper.StayRestrictionsToProto(restrictions),
+	}, nil
+}
+
+func (h *BookingHandler) UpdateStayRestriction(
+	ctx context.Context,
+	req *bookingpb.UpdateStayRestrictionRequest,
+) (*bookingpb.StayRestrictionResponse, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, mapper.ToDomainError(errors.WithMessage(errors.ErrInvalidInput, "invalid stay restriction id"))
+	}
+
+	restriction := mapper.ProtoToStayRestrictionUpdate(req)
+	restriction.ID = id
+	if err = h.bookingService.UpdateStayRestriction(ctx, restriction); err != nil {
+		logger.Log.Error("failed to update stay restriction", "restriction id", id, "error", err)
+		return nil, mapper.ToDomainError(err)
+	}
+
+	return &bookingpb.StayRestrictionResponse{
+		Restriction: mapper.StayRestrictionToProto(restriction),
+	}, nil
+}
+
+func (h *BookingHandler) DeleteStayRestriction(
+	ctx context.Context,
+	req *bookingpb.DeleteStayRestrictionRequest,
+) (*bookingpb.DeleteStayRestrictionResponse, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, mapper.ToDomainError(errors.WithMessage(errors.ErrInvalidInput, "invalid stay restriction id"))
+	}
+
+	if err = h.bookingService.DeleteStayRestriction(ctx, id); err != nil {
+		logger.Log.Error("failed to delete stay restriction", "restriction id", id, "error", err)
+		return nil, mapper.ToDomainError(err)
+	}
+
+	return &bookingpb.DeleteStayRestrictionResponse{}, nil
+}
+
+func (h *BookingHandler) GetGuestProfile(
+	ctx context.Context,
+	req *bookingpb.GetGuestProfileRequest,
+) (*bookingpb.GuestProfileResponse, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, mapper.ToDomainError(errors.WithMessage(errors.ErrInvalidInput, "invalid guest profile id"))
+	}
+
+	profile, err := h.bookingService.GetGuestProfile(ctx, id)
+	if err != nil {
+		return nil, mapper.ToDomainError(err)
+	}
+
+	return &bookingpb.GuestProfileResponse{
+		Profile: mapper.GuestProfileToProto(profile),
+	}, nil
+}
+
+func (h *BookingHandler) SearchGuestProfiles(
+	ctx context.Context,
+	req *bookingpb.SearchGuestProfilesRequest,
+) (*bookingpb.SearchGuestProfilesResponse, error) {
+	profiles, err := h.bookingService.SearchGuestProfiles(ctx, mapper.ProtoToGuestProfileSearch(req))
+	if err != nil {
+		logger.Log.Error("failed to search guest profiles", "error", err)
+		return nil, mapper.ToDomainError(err)
+	}
+
+	return &bookingpb.SearchGuestProfilesResponse{
+		Profiles: mapper.GuestProfilesToProto(profiles),
+	}, nil
+}
+
+func (h *BookingHandler) UpdateGuestProfile(
+	ctx context.Context,
+	req *bookingpb.UpdateGuestProfileRequest,
+) (*bookingpb.GuestProfileResponse, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, mapper.ToDomainError(errors.WithMessage(errors.ErrInvalidInput, "invalid guest profile id"))
+	}
+
+	profile := mapper.ProtoToGuestProfileUpdate(req)
+	profile.ID = id
+	updated, err := h.bookingService.UpdateGuestProfile(ctx, profile)
+	if err != nil {
+		logger.Log.Error("failed to update guest profile", "profile id", id, "error", err)
+		return nil, mapper.ToDomainError(err)
+	}
+
+	return &bookingpb.GuestProfileResponse{
+		Profile: mapper.GuestProfileToProto(updated),
+	}, nil
+}
+
+func (h *BookingHandler) MergeGuestProfiles(
+	ctx context.Context,
+	req *bookingpb.MergeGuestProfilesRequest,
+) (*bookingpb.GuestProfileResponse, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, mapper.ToDomainError(errors.WithMessage(errors.ErrInvalidInput, "invalid guest profile id"))
+	}
+	sourceIDs, err := mapper.ProtoToGuestProfileIDs(req.GetSourceIds())
+	if err != nil {
+		return nil, mapper.ToDomainError(err)
+	}
+
+	profile, err := h.bookingService.MergeGuestProfiles(ctx, id, sourceIDs)
+	if err != nil {
+		logger.Log.Error("failed to merge guest profiles", "profile id", id, "sources", sourceIDs, "error", err)
+		return nil, mapper.ToDomainError(err)
+	}
+
+	logger.Log.Info("guest profiles merged", "profile id", id, "sources", sourceIDs)
+	return &bookingpb.GuestProfileResponse{
+		Profile: mapper.GuestProfileToProto(profile),
+	}, nil
+}
+
+// InternalMethods вызываются только другими сервисами: шлюз их не публикует, а сервер требует общий секрет
+var InternalMethods = []string{
+	"/hotel.booking.v1.BookingService/ClaimGuestBookings",
+}
+
+// ClaimGuestBookings вызывается auth-service после подтверждения email пользователем
+func (h *BookingHandler) ClaimGuestBookings(
+	ctx context.Context,
+	req *bookingpb.ClaimGuestBookingsRequest,
+) (*bookingpb.ClaimGuestBookingsResponse, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, mapper.ToDomainError(errors.WithMessage(errors.ErrInvalidInput, "invalid user id"))
+	}
+
+	claimed, err := h.bookingService.ClaimGuestBookings(ctx, userID, req.GetEmail())
+	if err != nil {
+		logger.Log.Error("failed to claim guest bookings", "user id", userID, "error", err)
+		return nil, mapper.ToDomainError(err)
+	}
+
+	bookingIDs := make([]string, len(claimed))
+	for i, id := range claimed {
+		bookingIDs[i] = id.String()
+	}
+
+	logger.Log.Info("guest bookings claimed", "user id", userID, "bookings", len(claimed))
+	return &bookingpb.ClaimGuestBookingsResponse{BookingIds: bookingIDs}, nil
+}
+
+func (h *BookingHandler) JoinWaitlist(
+	ctx context.Context,
+	req *bookingpb.JoinWaitlistRequest,
+) (*bookingpb.WaitlistEntryResponse, error) {
+	entry, err := mapper.ProtoToWaitlistEntry(req)
+	if err != nil {
+		return nil, mapper.ToDomainError(err)
+	}
+
+	if err = h.bookingService.JoinWaitlist(ctx, entry); err != nil {
+		logger.Log.Error("failed to join waitlist", "user id", req.GetUserId(), "error", err)
+		return nil, mapper.ToDomainError(err)
+	}
+
+	logger.Log.Info("waitlist entry created", "entry id", entry.ID, "status", entry.Status)
+	return &bookingpb.WaitlistEntryResponse{
+		Entry: mapper.WaitlistEntryToProto(entry),
+	}, nil
+}
+
+func (h *BookingHandler) GetWaitlistEntry(
+	ctx context.Context,
+	req *bookingpb.GetWaitlistEntryRequest,
+) (*bookingpb.WaitlistEntryResponse, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, mapper.ToDomainError(errors.WithMessage(errors.ErrInvalidInput, "invalid waitlist entry id"))
+	}
+
+	entry, err := h.bookingService.GetWaitlistEntry(ctx, id)
+	if err != nil {
+		return nil, mapper.ToDomainError(err)
+	}
+
+	return &bookingpb.WaitlistEntryResponse{
+		Entry: mapper.WaitlistEntryToProto(entry),
+	}, nil
+}
+
+func (h *BookingHandler) ListWaitlistEntries(
+	ctx context.Context,
+	req *bookingpb.ListWaitlistEntriesRequest,
+) (*bookingpb.ListWaitlistEntriesResponse, error) {
+	filter, err := mapper.ProtoToWaitlistFilter(req)
+	if err != nil {
+		return nil, mapper.ToDomainError(err)
+	}
+
+	entries, err := h.bookingService.ListWaitlistEntries(ctx, filter)
+	if err != nil {
+		logger.Log.Error("failed to list waitlist entries", "error", err)
+		return nil, mapper.ToDomainError(err)
+	}
+
+	return &bookingpb.ListWaitlistEntriesResponse{
+		Entries: mapper.WaitlistEntriesToProto(entries),
+	}, nil
+}
+
+func (h *BookingHandler) LeaveWaitlist(
+	ctx context.Context,
+	req *bookingpb.LeaveWaitlistRequest,
+) (*bookingpb.WaitlistEntryResponse, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, mapper.ToDomainError(errors.WithMessage(errors.ErrInvalidInput, "invalid waitlist entry id"))
+	}
+
+	entry, err := h.bookingService.LeaveWaitlist(ctx, id)
+	if err != nil {
+		logger.Log.Error("failed to leave waitlist", "entry id", id, "error", err)
+		return nil, mapper.ToDomainError(err)
+	}
+
+	return &bookingpb.WaitlistEntryResponse{
+		Entry: mapper.WaitlistEntryToProto(entry),
+	}, nil
+}
+
+func (h *BookingHandler) GetOversoldNights(
+	ctx context.Context,
+	req *bookingpb.GetOversoldNightsRequest,
+) (*bookingpb.GetOversoldNightsResponse, error) {
+	from, to := mapper.ProtoToOversoldPeriod(req)
+
+	nights, err := h.bookingService.GetOversoldNights(ctx, from, to)
+	if err != nil {
+		logger.Log.Error("failed to get oversold nights", "error", err)
+		return nil, mapper.ToDomainError(err)
+	}
+
+	return &bookingpb.GetOversoldNightsResponse{
+		Nights: mapper.OversoldNightsToProto(nights),
+	}, nil
+}
+
+func (h *BookingHandler) GetAvailabilityCalendar(
+	ctx context.Context,
+	req *bookingpb.GetAvailabilityCalendarRequest,
+) (*bookingpb.GetAvailabilityCalendarResponse, error) {
+	rows, err := h.bookingService.GetAvailabilityCalendar(ctx, mapper.ProtoToAvailabilityCalendarParams(req))
+	if err != nil {
+		logger.Log.Error("failed to get availability calendar", "error", err)
+		return nil, mapper.ToDomainError(err)
+	}
+
+	return &bookingpb.GetAvailabilityCalendarResponse{
+		Rows: mapper.CalendarRowsToProto(rows),
+	}, nil
+}
@@ -1,10 +1,18 @@
 package mapper
 
 import (
+	"encoding/base64"
+	"strings"
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+	"github.com/semho/hotel-booking/pkg/errors"
+	"github.com/semho/hotel-booking/pkg/money"
 	bookingpb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
 	roompb "github.com/semho/hotel-booking/pkg/proto/room_v1/room"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -20,6 +28,7 @@ func ProtoToSearchParamsInternal(req *bookingpb.GetAvailableRoomsRequest) model.
 		CheckOut: req.CheckOut.AsTime(),
 		Capacity: req.Capacity,
 		Type:     roomType,
+		Currency: req.Currency,
 	}
 }
 
@@ -36,7 +45,7 @@ func ProtoToSearchParams(req *bookingpb.GetAvailableRoomsRequest) model.SearchRo
 	}
 }
 
-func ProtoToBooking(req *bookingpb.CreateBookingRequest) *model.Booking {
+func ProtoToBooking(req *bookingpb.CreateBookingRequest) (*model.Booking, error) {
 	var userID *uuid.UUID
 	if req.UserId != nil {
 		if id, err := uuid.Parse(*req.UserId); err == nil {
@@ -44,17 +53,34 @@ func ProtoToBooking(req *bookingpb.CreateBookingRequest) *model.Booking {
 		}
 	}
 
+	// Если комната не указана, RoomID будет установлен в сервисе после выбора свободной комнаты
+	var roomID uuid.UUID
+	if req.RoomId != nil {
+		id, err := uuid.Parse(*req.RoomId)
+		if err != nil {
+			return nil, errors.WithMessage(errors.ErrInvalidInput, "invalid room_id")
+		}
+		roomID = id
+	}
+
+	var promoCode *string
+	if req.PromoCode != nil && strings.TrimSpace(*req.PromoCode) != "" {
+		code := model.NormalizePromoCode(*req.PromoCode)
+		promoCode = &code
+	}
+
 	return &model.Booking{
+		RoomID:     roomID,
 		UserID:     userID,
 		GuestName:  req.GuestName,
 		GuestEmail: req.GuestEmail,
 		GuestPhone: req.GuestPhone,
 		CheckIn:    req.CheckIn.AsTime(),
 		CheckOut:   req.CheckOut.AsTime(),
-		// RoomID будет установлен в сервисе после выбора свободной комнаты
+		PromoCode:  promoCode,
 		// TotalPrice будет рассчитана в сервисе
 		// CurrentStatus будет установлен в сервисе
-	}
+	}, nil
 }
 
 //TODO: подумать о композиции:
@@ -104,17 +130,254 @@ func BookingToProto(booking *model.Booking) *bookingpb.Booking {
 		currentStatus = booking.CurrentStatus.Status
 	}
 
+	var holdExpiresAt *timestamppb.Timestamp
+	if currentStatus == bookingpb.BookingStatus_BOOKING_STATUS_PENDING && booking.HoldExpiresAt != nil {
+		holdExpiresAt = timestamppb.New(*booking.HoldExpiresAt)
+	}
+
+	// Бронь на тип комнаты не имеет комнаты до распределения или заселения
+	var roomID string
+	if booking.IsRoomAssigned() {
+		roomID = booking.RoomID.String()
+	}
+	var roomType roompb.RoomType
+	if booking.RoomType != nil {
+		roomType = *booking.RoomType
+	}
+
 	return &bookingpb.Booking{
-		Id:            booking.ID.String(),
-		RoomId:        booking.RoomID.String(),
-		UserId:        userID,
-		GuestName:     booking.GuestName,
-		GuestEmail:    booking.GuestEmail,
-		GuestPhone:    booking.GuestPhone,
-		CheckIn:       timestamppb.New(booking.CheckIn),
-		CheckOut:      timestamppb.New(booking.CheckOut),
-		TotalPrice:    booking.TotalPrice,
-		CreatedAt:     timestamppb.New(booking.CreatedAt),
-		CurrentStatus: currentStatus,
+		Id:                  booking.ID.String(),
+		RoomId:              roomID,
+		UserId:              userID,
+		GuestName:           booking.GuestName,
+		GuestEmail:          booking.GuestEmail,
+		GuestPhone:          booking.GuestPhone,
+		CheckIn:             timestamppb.New(booking.CheckIn),
+		CheckOut:            timestamppb.New(booking.CheckOut),
+		TotalPrice:          booking.TotalPrice.ToProto(),
+		CreatedAt:           timestamppb.New(booking.CreatedAt),
+		CurrentStatus:       currentStatus,
+		AllowedTransitions:  model.AllowedTransitions(currentStatus),
+		HoldExpiresAt:       holdExpiresAt,
+		RoomNumber:          booking.RoomNumber,
+		CheckedInAt:         optionalTimestamp(booking.CheckedInAt),
+		CheckedOutAt:        optionalTimestamp(booking.CheckedOutAt),
+		CancellationPenalty: money.OptionalToProto(booking.CancellationPenalty),
+		RefundAmount:        money.OptionalToProto(booking.RefundAmount),
+		CancelledAt:         optionalTimestamp(booking.CancelledAt),
+		ReservationId:       optionalUUID(booking.ReservationID),
+		PriceBreakdown:      NightPricesToProto(booking.PriceBreakdown),
+		PromoCode:           booking.PromoCode,
+		RoomType:            roomType,
+		GuestProfileId:      optionalUUID(booking.GuestProfileID),
+	}
+}
+
+func optionalUUID(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	s := id.String()
+	return &s
+}
+
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func ToDomainError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	switch {
+	case errors.IsNotFound(err):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.IsInvalidInput(err):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.IsConflict(err):
+		return status.Error(codes.AlreadyExists, err.Error())
+	default:
+		return status.Error(codes.Internal, "internal server error")
+	}
+}
+
+func ProtoToBookingFilter(req *bookingpb.ListBookingsRequest) (model.BookingFilter, error) {
+	filter := model.BookingFilter{
+		Status: req.Status,
+		Limit:  int(req.GetPageSize()),
+	}
+
+	if req.UserId != nil {
+		id, err := uuid.Parse(*req.UserId)
+		if err != nil {
+			return model.BookingFilter{}, errors.WithMessage(errors.ErrInvalidInput, "invalid user_id")
+		}
+		filter.UserID = &id
+	}
+
+	if req.RoomId != nil {
+		id, err := uuid.Parse(*req.RoomId)
+		if err != nil {
+			return model.BookingFilter{}, errors.WithMessage(errors.ErrInvalidInput, "invalid room_id")
+		}
+		filter.RoomID = &id
+	}
+
+	if req.GuestProfileId != nil {
+		id, err := uuid.Parse(*req.GuestProfileId)
+		if err != nil {
+			return model.BookingFilter{}, errors.WithMessage(errors.ErrInvalidInput, "invalid guest_profile_id")
+		}
+		filter.GuestProfileID = &id
+	}
+
+	if req.From != nil {
+		from := req.From.AsTime()
+		filter.From = &from
+	}
+
+	if req.To != nil {
+		to := req.To.AsTime()
+		filter.To = &to
+	}
+
+	if req.PageToken != "" {
+		cursor, err := decodePageToken(req.PageToken)
+		if err != nil {
+			return model.BookingFilter{}, err
+		}
+		filter.Cursor = cursor
+	}
+
+	return filter, nil
+}
+
+// Токен страницы: base64(created_at|id) последней записи предыдущей страницы
+func EncodePageToken(cursor *model.BookingCursor) string {
+	if cursor == nil {
+		return ""
+	}
+
+	raw := cursor.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + cursor.ID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodePageToken(token string) (*model.BookingCursor, error) {
+	invalidToken := errors.WithMessage(errors.ErrInvalidInput, "invalid page_token")
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, invalidToken
+	}
+
+	parts := strings.SplitN(string(raw), "|", 2)
+	if len(parts) != 2 {
+		return nil, invalidToken
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return nil, invalidToken
+	}
+
+	id, err := uuid.Parse(parts[1])
+	if err != nil {
+		return nil, invalidToken
+	}
+
+	return &model.BookingCursor{CreatedAt: createdAt, ID: id}, nil
+}
+
+func BookingsToProto(bookings []model.Booking) []*bookingpb.Booking {
+	protoBookings := make([]*bookingpb.Booking, len(bookings))
+	for i := range bookings {
+		protoBookings[i] = BookingToProto(&bookings[i])
+	}
+	return protoBookings
+}
+
+func StatusHistoryToProto(history []model.BookingStatusHistory) []*bookingpb.BookingStatusChange {
+	protoHistory := make([]*bookingpb.BookingStatusChange, len(history))
+	for i, h := range history {
+		protoHistory[i] = &bookingpb.BookingStatusChange{
+			Id:        h.ID.String(),
+			Status:    h.Status,
+			Reason:    h.Reason,
+			ChangedBy: h.ChangedBy,
+			ChangedAt: timestamppb.New(h.ChangedAt),
+		}
+	}
+	return protoHistory
+}
+
+func StatusTransitionsToProto(transitions []model.StatusTransition) []*bookingpb.BookingStatusTransition {
+	result := make([]*bookingpb.BookingStatusTransition, len(transitions))
+	for i, t := range transitions {
+		result[i] = &bookingpb.BookingStatusTransition{
+			BookingId: t.BookingID.String(),
+			From:      t.From,
+			To:        t.To,
+			Reason:    t.Reason,
+		}
+	}
+	return result
+}
+
+func ProtoToBookingChanges(req *bookingpb.ModifyBookingRequest) model.BookingChanges {
+	changes := model.BookingChanges{
+		RoomType:   req.Type,
+		GuestName:  req.GuestName,
+		GuestEmail: req.GuestEmail,
+		GuestPhone: req.GuestPhone,
+	}
+
+	if req.CheckIn != nil {
+		checkIn := req.CheckIn.AsTime()
+		changes.CheckIn = &checkIn
+	}
+
+	if req.CheckOut != nil {
+		checkOut := req.CheckOut.AsTime()
+		changes.CheckOut = &checkOut
+	}
+
+	return changes
+}
+
+func CancellationQuoteToProto(quote *model.CancellationQuote) *bookingpb.CancellationQuote {
+	return &bookingpb.CancellationQuote{
+		TotalPrice:            quote.TotalPrice.ToProto(),
+		PenaltyPercent:        int32(quote.PenaltyPercent),
+		Penalty:               quote.Penalty.ToProto(),
+		Refund:                quote.Refund.ToProto(),
+		DaysBeforeCheckIn:     int32(quote.DaysBeforeCheckIn),
+		FreeCancellationUntil: optionalTimestamp(quote.FreeCancellationUntil),
+		NonRefundable:         quote.NonRefundable,
+	}
+}
+
+func NightPricesToProto(nights model.NightPrices) []*bookingpb.NightPrice {
+	result := make([]*bookingpb.NightPrice, len(nights))
+	for i, night := range nights {
+		adjustments := make([]*bookingpb.PriceAdjustment, len(night.Adjustments))
+		for j, adjustment := range night.Adjustments {
+			adjustments[j] = &bookingpb.PriceAdjustment{
+				Rule:    adjustment.Rule,
+				Percent: int32(adjustment.Percent),
+				Amount:  money.OptionalToProto(adjustment.Amount),
+			}
+		}
+
+		result[i] = &bookingpb.NightPrice{
+			Date:        timestamppb.New(night.Date),
+			BasePrice:   night.BasePrice.ToProto(),
+			Price:       night.Price.ToProto(),
+			Adjustments: adjustments,
+		}
 	}
+	return result
 }
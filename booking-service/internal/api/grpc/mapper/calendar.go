@@ -0,0 +1,68 @@
+package mapper
+
+import (
+	"time"
+
+	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+	bookingpb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
+	roompb "github.com/semho/hotel-booking/pkg/proto/room_v1/room"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ProtoToAvailabilityCalendarParams(req *bookingpb.GetAvailabilityCalendarRequest) model.AvailabilityCalendarParams {
+	// Незаданные даты остаются нулевыми, период по умолчанию определяет сервис
+	var from, to time.Time
+	if req.From != nil {
+		from = req.From.AsTime()
+	}
+	if req.To != nil {
+		to = req.To.AsTime()
+	}
+
+	// Любой тип комнаты — то же, что тип не задан
+	var roomType *model.RoomType
+	if req.RoomType != nil && *req.RoomType != roompb.RoomType_ROOM_TYPE_UNSPECIFIED {
+		roomType = req.RoomType
+	}
+
+	return model.AvailabilityCalendarParams{
+		From:     from,
+		To:       to,
+		GroupBy:  req.GroupBy,
+		RoomType: roomType,
+	}
+}
+
+func CalendarRowsToProto(rows []model.CalendarRow) []*bookingpb.AvailabilityCalendarRow {
+	result := make([]*bookingpb.AvailabilityCalendarRow, len(rows))
+	for i, row := range rows {
+		protoRow := &bookingpb.AvailabilityCalendarRow{
+			RoomType: row.RoomType,
+			Nights:   make([]*bookingpb.AvailabilityCalendarNight, len(row.Nights)),
+		}
+		if row.Room != nil {
+			protoRow.RoomId = &row.Room.ID
+			protoRow.RoomNumber = &row.Room.Number
+		}
+
+		for n, night := range row.Nights {
+			var bookingID *string
+			if night.BookingID != nil {
+				id := night.BookingID.String()
+				bookingID = &id
+			}
+
+			protoRow.Nights[n] = &bookingpb.AvailabilityCalendarNight{
+				Night:        timestamppb.New(night.Night),
+				Status:       night.Status,
+				BookingId:    bookingID,
+				Free:         int32(night.Free),
+				Booked:       int32(night.Booked),
+				Blocked:      int32(night.Blocked),
+				OutOfService: int32(night.OutOfService),
+			}
+		}
+		result[i] = protoRow
+	}
+	return result
+}
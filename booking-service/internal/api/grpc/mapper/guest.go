@@ -0,0 +1,64 @@
+package mapper
+
+import (
+	"github.com/google/uuid"
+	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+	"github.com/semho/hotel-booking/pkg/errors"
+	bookingpb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ProtoToGuestProfileUpdate(req *bookingpb.UpdateGuestProfileRequest) *model.GuestProfile {
+	return &model.GuestProfile{
+		Name: req.Name,
+		GuestPreferences: model.GuestPreferences{
+			BedType:        req.BedType,
+			PreferredFloor: req.PreferredFloor,
+			Allergies:      req.Allergies,
+		},
+	}
+}
+
+func ProtoToGuestProfileSearch(req *bookingpb.SearchGuestProfilesRequest) model.GuestProfileSearch {
+	return model.GuestProfileSearch{
+		Query: req.Query,
+		Limit: int(req.Limit),
+	}
+}
+
+func ProtoToGuestProfileIDs(ids []string) ([]uuid.UUID, error) {
+	result := make([]uuid.UUID, 0, len(ids))
+	for _, raw := range ids {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, errors.WithMessage(errors.ErrInvalidInput, "invalid source guest profile id")
+		}
+		result = append(result, id)
+	}
+	return result, nil
+}
+
+func GuestProfileToProto(profile *model.GuestProfile) *bookingpb.GuestProfile {
+	return &bookingpb.GuestProfile{
+		Id:             profile.ID.String(),
+		Name:           profile.Name,
+		Emails:         append([]string{}, profile.Emails...),
+		Phones:         append([]string{}, profile.Phones...),
+		BedType:        profile.BedType,
+		PreferredFloor: profile.PreferredFloor,
+		Allergies:      profile.Allergies,
+		StayCount:      int32(profile.StayCount),
+		LifetimeValue:  profile.LifetimeValue.ToProto(),
+		LastStayAt:     optionalTimestamp(profile.LastStayAt),
+		CreatedAt:      timestamppb.New(profile.CreatedAt),
+		UpdatedAt:      timestamppb.New(profile.UpdatedAt),
+	}
+}
+
+func GuestProfilesToProto(profiles []model.GuestProfile) []*bookingpb.GuestProfile {
+	result := make([]*bookingpb.GuestProfile, len(profiles))
+	for i := range profiles {
+		result[i] = GuestProfileToProto(&profiles[i])
+	}
+	return result
+}
@@ -0,0 +1,63 @@
+package mapper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+	"github.com/semho/hotel-booking/pkg/errors"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// Ключ метаданных gRPC, в который шлюз передает заголовок Idempotency-Key
+	IdempotencyKeyMetadata = "idempotency-key"
+
+	maxIdempotencyKeyLength = 255
+)
+
+// IdempotencyKeyFromContext достает ключ идемпотентности пользователя userID из метаданных и считает хеш запроса.
+// Возвращает nil, если ключ не передан
+func IdempotencyKeyFromContext(
+	ctx context.Context,
+	userID *uuid.UUID,
+	req proto.Message,
+) (*model.IdempotencyKey, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	values := md.Get(IdempotencyKeyMetadata)
+	if len(values) == 0 || values[0] == "" {
+		return nil, nil
+	}
+
+	key := values[0]
+	if len(key) > maxIdempotencyKeyLength {
+		return nil, errors.WithMessage(
+			errors.ErrInvalidInput,
+			fmt.Sprintf("idempotency key must not be longer than %d characters", maxIdempotencyKeyLength),
+		)
+	}
+
+	// Детерминированная сериализация дает одинаковый хеш для одинаковых запросов
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+	hash := sha256.Sum256(data)
+
+	idempotencyKey := &model.IdempotencyKey{
+		Key:         key,
+		RequestHash: hex.EncodeToString(hash[:]),
+	}
+	if userID != nil {
+		idempotencyKey.UserID = *userID
+	}
+
+	return idempotencyKey, nil
+}
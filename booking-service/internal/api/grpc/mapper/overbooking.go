@@ -0,0 +1,37 @@
+package mapper
+
+import (
+	"time"
+
+	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+	bookingpb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ProtoToOversoldPeriod возвращает даты периода отчета, незаданные остаются нулевыми
+func ProtoToOversoldPeriod(req *bookingpb.GetOversoldNightsRequest) (time.Time, time.Time) {
+	var from, to time.Time
+	if req.From != nil {
+		from = req.From.AsTime()
+	}
+	if req.To != nil {
+		to = req.To.AsTime()
+	}
+	return from, to
+}
+
+func OversoldNightsToProto(nights []model.OversoldNight) []*bookingpb.OversoldNight {
+	result := make([]*bookingpb.OversoldNight, len(nights))
+	for i, night := range nights {
+		result[i] = &bookingpb.OversoldNight{
+			Night:      timestamppb.New(night.Night),
+			RoomType:   night.RoomType,
+			Inventory:  int32(night.Inventory),
+			Ceiling:    int32(night.Ceiling),
+			Booked:     int32(night.Booked),
+			Unassigned: int32(night.Unassigned),
+			Oversold:   int32(night.Oversold()),
+		}
+	}
+	return result
+}
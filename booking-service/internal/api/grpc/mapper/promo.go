@@ -0,0 +1,113 @@
+package mapper
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+	"github.com/semho/hotel-booking/pkg/money"
+	bookingpb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
+	moneypb "github.com/semho/hotel-booking/pkg/proto/money_v1/money"
+	roompb "github.com/semho/hotel-booking/pkg/proto/room_v1/room"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ProtoToPromoCode(req *bookingpb.CreatePromoCodeRequest) *model.PromoCode {
+	return &model.PromoCode{
+		Code:                  req.Code,
+		DiscountType:          req.DiscountType,
+		DiscountPercent:       int(req.DiscountPercent),
+		DiscountAmount:        optionalMoney(req.DiscountAmount),
+		ValidFrom:             optionalTime(req.ValidFrom),
+		ValidTo:               optionalTime(req.ValidTo),
+		RoomTypes:             roomTypesToArray(req.RoomTypes),
+		MaxRedemptions:        optionalInt(req.MaxRedemptions),
+		MaxRedemptionsPerUser: optionalInt(req.MaxRedemptionsPerUser),
+	}
+}
+
+func ProtoToPromoCodeUpdate(req *bookingpb.UpdatePromoCodeRequest) *model.PromoCode {
+	return &model.PromoCode{
+		DiscountType:          req.DiscountType,
+		DiscountPercent:       int(req.DiscountPercent),
+		DiscountAmount:        optionalMoney(req.DiscountAmount),
+		ValidFrom:             optionalTime(req.ValidFrom),
+		ValidTo:               optionalTime(req.ValidTo),
+		RoomTypes:             roomTypesToArray(req.RoomTypes),
+		MaxRedemptions:        optionalInt(req.MaxRedemptions),
+		MaxRedemptionsPerUser: optionalInt(req.MaxRedemptionsPerUser),
+		Active:                req.Active,
+	}
+}
+
+func PromoCodeToProto(promo *model.PromoCode) *bookingpb.PromoCode {
+	roomTypes := make([]roompb.RoomType, len(promo.RoomTypes))
+	for i, t := range promo.RoomTypes {
+		roomTypes[i] = roompb.RoomType(t)
+	}
+
+	return &bookingpb.PromoCode{
+		Id:                    promo.ID.String(),
+		Code:                  promo.Code,
+		DiscountType:          promo.DiscountType,
+		DiscountPercent:       int32(promo.DiscountPercent),
+		DiscountAmount:        money.OptionalToProto(promo.DiscountAmount),
+		ValidFrom:             optionalTimestamp(promo.ValidFrom),
+		ValidTo:               optionalTimestamp(promo.ValidTo),
+		RoomTypes:             roomTypes,
+		MaxRedemptions:        optionalInt32(promo.MaxRedemptions),
+		MaxRedemptionsPerUser: optionalInt32(promo.MaxRedemptionsPerUser),
+		Redemptions:           int32(promo.Redemptions),
+		Active:                promo.Active,
+		CreatedAt:             timestamppb.New(promo.CreatedAt),
+		UpdatedAt:             timestamppb.New(promo.UpdatedAt),
+	}
+}
+
+func PromoCodesToProto(promos []model.PromoCode) []*bookingpb.PromoCode {
+	result := make([]*bookingpb.PromoCode, len(promos))
+	for i := range promos {
+		result[i] = PromoCodeToProto(&promos[i])
+	}
+	return result
+}
+
+func roomTypesToArray(roomTypes []roompb.RoomType) pq.Int32Array {
+	result := make(pq.Int32Array, len(roomTypes))
+	for i, t := range roomTypes {
+		result[i] = int32(t)
+	}
+	return result
+}
+
+func optionalMoney(m *moneypb.Money) *money.Money {
+	if m == nil {
+		return nil
+	}
+	amount := money.FromProto(m)
+	return &amount
+}
+
+func optionalTime(t *timestamppb.Timestamp) *time.Time {
+	if t == nil {
+		return nil
+	}
+	value := t.AsTime()
+	return &value
+}
+
+func optionalInt(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	value := int(*v)
+	return &value
+}
+
+func optionalInt32(v *int) *int32 {
+	if v == nil {
+		return nil
+	}
+	value := int32(*v)
+	return &value
+}
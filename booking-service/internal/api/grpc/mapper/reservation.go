@@ -0,0 +1,67 @@
+package mapper
+
+import (
+	"github.com/google/uuid"
+	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+	"github.com/semho/hotel-booking/pkg/errors"
+	"github.com/semho/hotel-booking/pkg/money"
+	bookingpb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ProtoToReservation(req *bookingpb.CreateReservationRequest) (*model.Reservation, []model.ReservationRoom, error) {
+	var userID *uuid.UUID
+	if req.UserId != nil {
+		if id, err := uuid.Parse(*req.UserId); err == nil {
+			userID = &id
+		}
+	}
+
+	rooms := make([]model.ReservationRoom, len(req.Rooms))
+	for i, r := range req.Rooms {
+		rooms[i] = model.ReservationRoom{
+			Type:      r.GetType(),
+			Capacity:  r.GetCapacity(),
+			GuestName: r.GuestName,
+		}
+		if r.RoomId != nil {
+			id, err := uuid.Parse(*r.RoomId)
+			if err != nil {
+				return nil, nil, errors.WithMessage(errors.ErrInvalidInput, "invalid room_id")
+			}
+			rooms[i].RoomID = &id
+		}
+	}
+
+	return &model.Reservation{
+		UserID:       userID,
+		ContactName:  req.ContactName,
+		ContactEmail: req.ContactEmail,
+		ContactPhone: req.ContactPhone,
+	}, rooms, nil
+}
+
+func ReservationToProto(reservation *model.Reservation) (*bookingpb.Reservation, error) {
+	var userID *string
+	if reservation.UserID != nil {
+		id := reservation.UserID.String()
+		userID = &id
+	}
+
+	total, err := reservation.TotalPrice()
+	if err != nil {
+		return nil, err
+	}
+
+	return &bookingpb.Reservation{
+		Id:               reservation.ID.String(),
+		ConfirmationCode: reservation.ConfirmationCode,
+		UserId:           userID,
+		ContactName:      reservation.ContactName,
+		ContactEmail:     reservation.ContactEmail,
+		ContactPhone:     reservation.ContactPhone,
+		CreatedAt:        timestamppb.New(reservation.CreatedAt),
+		Bookings:         BookingsToProto(reservation.Bookings),
+		TotalPrice:       money.OptionalToProto(total),
+	}, nil
+}
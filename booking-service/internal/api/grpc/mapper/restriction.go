@@ -0,0 +1,97 @@
+package mapper
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+	bookingpb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
+	roompb "github.com/semho/hotel-booking/pkg/proto/room_v1/room"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ProtoToStayRestriction(req *bookingpb.CreateStayRestrictionRequest) *model.StayRestriction {
+	return &model.StayRestriction{
+		Name:              req.Name,
+		DateFrom:          timeOrZero(req.DateFrom),
+		DateTo:            timeOrZero(req.DateTo),
+		RoomType:          optionalRoomType(req.RoomType),
+		Weekdays:          pq.Int32Array(req.Weekdays),
+		MinStay:           optionalInt(req.MinStay),
+		MaxStay:           optionalInt(req.MaxStay),
+		ClosedToArrival:   req.ClosedToArrival,
+		ClosedToDeparture: req.ClosedToDeparture,
+		MinAdvanceDays:    optionalInt(req.MinAdvanceDays),
+		MaxAdvanceDays:    optionalInt(req.MaxAdvanceDays),
+	}
+}
+
+func ProtoToStayRestrictionUpdate(req *bookingpb.UpdateStayRestrictionRequest) *model.StayRestriction {
+	return &model.StayRestriction{
+		Name:              req.Name,
+		DateFrom:          timeOrZero(req.DateFrom),
+		DateTo:            timeOrZero(req.DateTo),
+		RoomType:          optionalRoomType(req.RoomType),
+		Weekdays:          pq.Int32Array(req.Weekdays),
+		MinStay:           optionalInt(req.MinStay),
+		MaxStay:           optionalInt(req.MaxStay),
+		ClosedToArrival:   req.ClosedToArrival,
+		ClosedToDeparture: req.ClosedToDeparture,
+		MinAdvanceDays:    optionalInt(req.MinAdvanceDays),
+		MaxAdvanceDays:    optionalInt(req.MaxAdvanceDays),
+	}
+}
+
+func ProtoToStayRestrictionFilter(req *bookingpb.ListStayRestrictionsRequest) model.StayRestrictionFilter {
+	return model.StayRestrictionFilter{
+		From:     optionalTime(req.From),
+		To:       optionalTime(req.To),
+		RoomType: optionalRoomType(req.RoomType),
+	}
+}
+
+func StayRestrictionToProto(restriction *model.StayRestriction) *bookingpb.StayRestriction {
+	weekdays := make([]int32, len(restriction.Weekdays))
+	copy(weekdays, restriction.Weekdays)
+
+	return &bookingpb.StayRestriction{
+		Id:                restriction.ID.String(),
+		Name:              restriction.Name,
+		DateFrom:          timestamppb.New(restriction.DateFrom),
+		DateTo:            timestamppb.New(restriction.DateTo),
+		RoomType:          restriction.RoomType,
+		Weekdays:          weekdays,
+		MinStay:           optionalInt32(restriction.MinStay),
+		MaxStay:           optionalInt32(restriction.MaxStay),
+		ClosedToArrival:   restriction.ClosedToArrival,
+		ClosedToDeparture: restriction.ClosedToDeparture,
+		MinAdvanceDays:    optionalInt32(restriction.MinAdvanceDays),
+		MaxAdvanceDays:    optionalInt32(restriction.MaxAdvanceDays),
+		CreatedAt:         timestamppb.New(restriction.CreatedAt),
+		UpdatedAt:         timestamppb.New(restriction.UpdatedAt),
+	}
+}
+
+func StayRestrictionsToProto(restrictions []model.StayRestriction) []*bookingpb.StayRestriction {
+	result := make([]*bookingpb.StayRestriction, len(restrictions))
+	for i := range restrictions {
+		result[i] = StayRestrictionToProto(&restrictions[i])
+	}
+	return result
+}
+
+// Любой тип комнаты — то же, что тип не задан
+func optionalRoomType(roomType *roompb.RoomType) *model.RoomType {
+	if roomType == nil || *roomType == roompb.RoomType_ROOM_TYPE_UNSPECIFIED {
+		return nil
+	}
+	value := *roomType
+	return &value
+}
+
+func timeOrZero(t *timestamppb.Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.AsTime()
+}
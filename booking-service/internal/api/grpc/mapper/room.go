@@ -19,6 +19,10 @@ func SearchParamsToProto(params model.SearchRoomsParams) *roompb.GetAvailableRoo
 	// По умолчанию ищем только доступные комнаты, т.к. запрос именно на свободные комнаты и фильтр по статусу лишний
 	availableStatus := roompb.RoomStatus_ROOM_STATUS_AVAILABLE
 	status = &availableStatus
+	if params.Status != nil {
+		st := *params.Status
+		status = &st
+	}
 
 	return &roompb.GetAvailableRoomsRequest{
 		Capacity: params.Capacity,
@@ -35,6 +39,7 @@ func ProtoToRooms(protoRooms []*roompb.Room) []model.Room {
 			Number:    pr.RoomNumber,
 			Type:      pr.Type,
 			Price:     pr.Price,
+			Currency:  pr.Currency,
 			Capacity:  int(pr.Capacity),
 			Status:    pr.Status,
 			Amenities: pr.Amenities,
@@ -51,6 +56,7 @@ func RoomsToProto(rooms []model.Room) []*roompb.Room {
 			RoomNumber: r.Number,
 			Type:       r.Type,
 			Price:      r.Price,
+			Currency:   r.Currency,
 			Capacity:   int32(r.Capacity),
 			Status:     r.Status,
 			Amenities:  r.Amenities,
@@ -69,6 +75,7 @@ func ProtoToRoom(protoRoom *roompb.Room) *model.Room {
 		Number:    protoRoom.RoomNumber,
 		Type:      protoRoom.Type,
 		Price:     protoRoom.Price,
+		Currency:  protoRoom.Currency,
 		Capacity:  int(protoRoom.Capacity),
 		Status:    protoRoom.Status,
 		Amenities: protoRoom.Amenities,
@@ -0,0 +1,86 @@
+package mapper
+
+import (
+	"github.com/google/uuid"
+	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+	"github.com/semho/hotel-booking/pkg/errors"
+	bookingpb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
+	roompb "github.com/semho/hotel-booking/pkg/proto/room_v1/room"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ProtoToWaitlistEntry(req *bookingpb.JoinWaitlistRequest) (*model.WaitlistEntry, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, errors.WithMessage(errors.ErrInvalidInput, "invalid user_id")
+	}
+	if req.CheckIn == nil || req.CheckOut == nil {
+		return nil, errors.WithMessage(errors.ErrInvalidInput, "check-in and check-out dates are required")
+	}
+
+	// Любой тип комнаты — то же, что тип не задан
+	var roomType *model.RoomType
+	if req.Type != nil && *req.Type != roompb.RoomType_ROOM_TYPE_UNSPECIFIED {
+		roomType = req.Type
+	}
+
+	return &model.WaitlistEntry{
+		UserID:     userID,
+		GuestName:  req.GuestName,
+		GuestEmail: req.GuestEmail,
+		GuestPhone: req.GuestPhone,
+		CheckIn:    req.CheckIn.AsTime(),
+		CheckOut:   req.CheckOut.AsTime(),
+		Capacity:   req.Capacity,
+		RoomType:   roomType,
+	}, nil
+}
+
+func ProtoToWaitlistFilter(req *bookingpb.ListWaitlistEntriesRequest) (model.WaitlistFilter, error) {
+	filter := model.WaitlistFilter{
+		Status: req.Status,
+	}
+
+	if req.UserId != nil {
+		id, err := uuid.Parse(*req.UserId)
+		if err != nil {
+			return model.WaitlistFilter{}, errors.WithMessage(errors.ErrInvalidInput, "invalid user_id")
+		}
+		filter.UserID = &id
+	}
+
+	return filter, nil
+}
+
+func WaitlistEntryToProto(entry *model.WaitlistEntry) *bookingpb.WaitlistEntry {
+	var bookingID *string
+	if entry.BookingID != nil {
+		id := entry.BookingID.String()
+		bookingID = &id
+	}
+
+	return &bookingpb.WaitlistEntry{
+		Id:             entry.ID.String(),
+		UserId:         entry.UserID.String(),
+		GuestName:      entry.GuestName,
+		GuestEmail:     entry.GuestEmail,
+		GuestPhone:     entry.GuestPhone,
+		CheckIn:        timestamppb.New(entry.CheckIn),
+		CheckOut:       timestamppb.New(entry.CheckOut),
+		Capacity:       entry.Capacity,
+		Type:           entry.RoomType,
+		Status:         entry.Status,
+		BookingId:      bookingID,
+		OfferExpiresAt: optionalTimestamp(entry.OfferExpiresAt),
+		CreatedAt:      timestamppb.New(entry.CreatedAt),
+		UpdatedAt:      timestamppb.New(entry.UpdatedAt),
+	}
+}
+
+func WaitlistEntriesToProto(entries []model.WaitlistEntry) []*bookingpb.WaitlistEntry {
+	result := make([]*bookingpb.WaitlistEntry, len(entries))
+	for i := range entries {
+		result[i] = WaitlistEntryToProto(&entries[i])
+	}
+	return result
+}
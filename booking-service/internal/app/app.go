@@ -3,19 +3,26 @@ package app
 import (
 	"context"
 	"fmt"
+	grpcHandler "github.com/semho/hotel-booking/booking-service/internal/api/grpc"
 	"github.com/semho/hotel-booking/booking-service/internal/config"
+	"github.com/semho/hotel-booking/pkg/auth/servicetoken"
 	"github.com/semho/hotel-booking/pkg/logger"
 	pb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 	"net"
+	"sync"
 )
 
 type App struct {
 	grpcServer *grpc.Server
 	deps       *Deps
 	cfg        *config.Config
+
+	// Остановка фоновых воркеров
+	workersCancel context.CancelFunc
+	workersDone   chan struct{}
 }
 
 func New(cfg *config.Config) (*App, error) {
@@ -25,17 +32,23 @@ func New(cfg *config.Config) (*App, error) {
 		return nil, fmt.Errorf("failed to init dependencies: %w", err)
 	}
 
-	// Создаем gRPC сервер
-	grpcServer := grpc.NewServer(grpc.Creds(insecure.NewCredentials()))
+	// Создаем gRPC сервер, внутренние методы доступны только сервисам с общим секретом
+	grpcServer := grpc.NewServer(
+		grpc.Creds(insecure.NewCredentials()),
+		grpc.UnaryInterceptor(
+			servicetoken.UnaryServerInterceptor(cfg.Internal.ServiceToken, grpcHandler.InternalMethods...),
+		),
+	)
 	// Регистрируем сервисы
 	pb.RegisterBookingServiceServer(grpcServer, deps.BookingHandler)
 
 	reflection.Register(grpcServer)
 
 	return &App{
-		grpcServer: grpcServer,
-		deps:       deps,
-		cfg:        cfg,
+		grpcServer:  grpcServer,
+		deps:        deps,
+		cfg:         cfg,
+		workersDone: make(chan struct{}),
 	}, nil
 }
 
@@ -45,6 +58,33 @@ func (a *App) Run() error {
 		return fmt.Errorf("failed to listen: %w", err)
 	}
 
+	// Запускаем фоновые воркеры
+	workersCtx, cancel := context.WithCancel(context.Background())
+	a.workersCancel = cancel
+	go func() {
+		defer close(a.workersDone)
+
+		var wg sync.WaitGroup
+		wg.Add(4)
+		go func() {
+			defer wg.Done()
+			a.deps.HoldExpiry.Run(workersCtx)
+		}()
+		go func() {
+			defer wg.Done()
+			a.deps.Nightly.Run(workersCtx)
+		}()
+		go func() {
+			defer wg.Done()
+			a.deps.RoomAssignment.Run(workersCtx)
+		}()
+		go func() {
+			defer wg.Done()
+			a.deps.Notifications.Run(workersCtx)
+		}()
+		wg.Wait()
+	}()
+
 	logger.Log.Info("starting gRPC server", "port", a.cfg.GRPC.Port)
 	if err := a.grpcServer.Serve(lis); err != nil {
 		return fmt.Errorf("failed to serve: %w", err)
@@ -53,9 +93,20 @@ func (a *App) Run() error {
 	return nil
 }
 
-func (a *App) Stop(_ context.Context) error {
+func (a *App) Stop(ctx context.Context) error {
 	logger.Log.Info("shutting down gRPC server")
 	a.grpcServer.GracefulStop()
+
+	// Дожидаемся завершения воркеров до закрытия соединения с БД
+	if a.workersCancel != nil {
+		a.workersCancel()
+		select {
+		case <-a.workersDone:
+		case <-ctx.Done():
+			logger.Log.Warn("background workers did not stop in time")
+		}
+	}
+
 	if err := a.deps.DB.Close(); err != nil {
 		return fmt.Errorf("failed to close db connection: %w", err)
 	}
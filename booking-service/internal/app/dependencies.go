@@ -6,14 +6,29 @@ import (
 	_ "github.com/lib/pq"
 	grpcHandler "github.com/semho/hotel-booking/booking-service/internal/api/grpc"
 	"github.com/semho/hotel-booking/booking-service/internal/config"
+	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
 	"github.com/semho/hotel-booking/booking-service/internal/domain/port"
 	"github.com/semho/hotel-booking/booking-service/internal/domain/service"
 	"github.com/semho/hotel-booking/booking-service/internal/infrastructure/client/room"
+	"github.com/semho/hotel-booking/booking-service/internal/infrastructure/exchangerate"
+	"github.com/semho/hotel-booking/booking-service/internal/infrastructure/notification"
 	"github.com/semho/hotel-booking/booking-service/internal/infrastructure/repository/postgres"
 	"github.com/semho/hotel-booking/booking-service/internal/infrastructure/unitofwork"
+	"github.com/semho/hotel-booking/booking-service/internal/worker"
+	"github.com/semho/hotel-booking/pkg/money"
 	roompb "github.com/semho/hotel-booking/pkg/proto/room_v1/room"
+	"github.com/shopspring/decimal"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"strings"
+	"time"
+)
+
+const (
+	timeOfDayLayout = "15:04"
+	// Стандартное время заезда и выезда, если не задано в конфиге
+	defaultCheckInTime  = "14:00"
+	defaultCheckOutTime = "12:00"
 )
 
 type Deps struct {
@@ -21,6 +36,10 @@ type Deps struct {
 	BookingHandler *grpcHandler.BookingHandler
 	RoomClient     roompb.RoomServiceClient
 	BookingUoW     port.BookingUnitOfWork
+	HoldExpiry     *worker.HoldExpiryWorker
+	Nightly        *worker.NightlyScheduler
+	RoomAssignment *worker.RoomAssignmentWorker
+	Notifications  *worker.NotificationWorker
 }
 
 func initDeps(cfg *config.Config) (*Deps, error) {
@@ -45,14 +64,89 @@ func initDeps(cfg *config.Config) (*Deps, error) {
 	roomClientWrapper := room.NewRoomClient(roomClient)
 	bookingUoW := unitofwork.NewBookingUnitOfWork(db)
 
-	bookingService := service.NewBookingService(bookingRepo, bookingUoW, roomClientWrapper)
-	bookingHandler := grpcHandler.NewBookingHandler(bookingService, roomClientWrapper)
+	cancellationPolicies, err := initCancellationPolicies(cfg.Booking.Cancellation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init cancellation policies: %w", err)
+	}
+
+	if cfg.Booking.Currency != "" {
+		if err := money.ValidateCurrency(cfg.Booking.Currency); err != nil {
+			return nil, fmt.Errorf("invalid hotel currency: %w", err)
+		}
+	}
+
+	var exchangeRates port.ExchangeRateProvider
+	if cfg.Booking.ExchangeRatesFile != "" {
+		exchangeRates, err = exchangerate.NewFileProvider(cfg.Booking.ExchangeRatesFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to init exchange rates: %w", err)
+		}
+	}
+
+	ratePlans, err := initRatePlans(cfg.Booking.Pricing)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init rate plans: %w", err)
+	}
+
+	overbooking, err := initOverbookingLimits(cfg.Booking.Overbooking)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init overbooking limits: %w", err)
+	}
+
+	calendar, err := initHotelCalendar(cfg.Booking)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init hotel calendar: %w", err)
+	}
+
+	bookingService := service.NewBookingService(
+		bookingRepo,
+		bookingUoW,
+		roomClientWrapper,
+		service.Settings{
+			HoldTTL:              cfg.Booking.HoldTTL,
+			CancellationPolicies: cancellationPolicies,
+			RatePlans:            ratePlans,
+			Currency:             cfg.Booking.Currency,
+			IdempotencyTTL:       cfg.Booking.IdempotencyTTL,
+			ExchangeRates:        exchangeRates,
+			WaitlistHoldTTL:      cfg.Booking.WaitlistHoldTTL,
+			Overbooking:          overbooking,
+			AssignmentDaysBefore: cfg.Booking.Assignment.DaysBeforeArrival,
+			Calendar:             calendar,
+			Notifications:        notification.NewEmailSender(cfg.Email),
+		},
+	)
+	nightlyScheduler, err := worker.NewNightlyScheduler(
+		bookingService,
+		calendar,
+		cfg.Booking.Nightly.Cutoff,
+		cfg.Booking.Nightly.DryRun,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init nightly scheduler: %w", err)
+	}
+	bookingHandler := grpcHandler.NewBookingHandler(bookingService, roomClientWrapper, nightlyScheduler)
+	holdExpiryWorker := worker.NewHoldExpiryWorker(
+		bookingService,
+		cfg.Booking.HoldExpiryInterval,
+		cfg.Booking.HoldExpiryBatchSize,
+	)
+	roomAssignmentWorker := worker.NewRoomAssignmentWorker(bookingService, cfg.Booking.Assignment.Interval)
+	notificationWorker := worker.NewNotificationWorker(
+		bookingService,
+		cfg.Booking.NotificationInterval,
+		cfg.Booking.NotificationBatchSize,
+	)
 
 	return &Deps{
 		DB:             db,
 		BookingHandler: bookingHandler,
 		RoomClient:     roomClient,
 		BookingUoW:     bookingUoW,
+		HoldExpiry:     holdExpiryWorker,
+		Nightly:        nightlyScheduler,
+		RoomAssignment: roomAssignmentWorker,
+		Notifications:  notificationWorker,
 	}, nil
 }
 
@@ -77,3 +171,236 @@ func initDB(cfg *config.Config) (*sqlx.DB, error) {
 
 	return db, nil
 }
+
+// initCancellationPolicies проверяет политики отмены из конфига и переводит их в доменную модель
+func initCancellationPolicies(cfg config.CancellationConfig) (model.CancellationPolicies, error) {
+	defaultPolicy, err := toCancellationPolicy(cfg.Default)
+	if err != nil {
+		return model.CancellationPolicies{}, fmt.Errorf("default policy: %w", err)
+	}
+
+	policies := model.CancellationPolicies{
+		Default:    defaultPolicy,
+		ByRoomType: make(map[model.RoomType]model.CancellationPolicy, len(cfg.RoomTypes)),
+	}
+	for name, policyCfg := range cfg.RoomTypes {
+		// viper приводит ключи к нижнему регистру
+		roomType, ok := roompb.RoomType_value[strings.ToUpper(name)]
+		if !ok {
+			return model.CancellationPolicies{}, fmt.Errorf("unknown room type %q", name)
+		}
+
+		policy, err := toCancellationPolicy(policyCfg)
+		if err != nil {
+			return model.CancellationPolicies{}, fmt.Errorf("policy for %s: %w", name, err)
+		}
+		policies.ByRoomType[model.RoomType(roomType)] = policy
+	}
+
+	return policies, nil
+}
+
+func toCancellationPolicy(cfg config.CancellationPolicyConfig) (model.CancellationPolicy, error) {
+	if cfg.FreeUntilDays < 0 {
+		return model.CancellationPolicy{}, fmt.Errorf("free_until_days must not be negative")
+	}
+
+	penalties := make([]model.PenaltyTier, len(cfg.Penalties))
+	for i, tier := range cfg.Penalties {
+		if tier.Percent < 0 || tier.Percent > 100 {
+			return model.CancellationPolicy{}, fmt.Errorf("penalty percent must be between 0 and 100")
+		}
+		penalties[i] = model.PenaltyTier{DaysBefore: tier.DaysBefore, Percent: tier.Percent}
+	}
+
+	return model.CancellationPolicy{
+		FreeUntilDays: cfg.FreeUntilDays,
+		Penalties:     penalties,
+		NonRefundable: cfg.NonRefundable,
+	}, nil
+}
+
+// initHotelCalendar загружает часовой пояс отеля и стандартное время заезда и выезда
+func initHotelCalendar(cfg config.BookingConfig) (model.HotelCalendar, error) {
+	location, err := time.LoadLocation(cfg.Timezone)
+	if err != nil {
+		return model.HotelCalendar{}, fmt.Errorf("invalid hotel timezone %q: %w", cfg.Timezone, err)
+	}
+
+	checkInTime, err := parseTimeOfDay(cfg.CheckInTime, defaultCheckInTime)
+	if err != nil {
+		return model.HotelCalendar{}, fmt.Errorf("invalid check_in_time: %w", err)
+	}
+	checkOutTime, err := parseTimeOfDay(cfg.CheckOutTime, defaultCheckOutTime)
+	if err != nil {
+		return model.HotelCalendar{}, fmt.Errorf("invalid check_out_time: %w", err)
+	}
+	// Иначе выезд одного гостя пересекался бы с заездом следующего в тот же день
+	if checkOutTime > checkInTime {
+		return model.HotelCalendar{}, fmt.Errorf("check_out_time must not be later than check_in_time")
+	}
+
+	return model.HotelCalendar{
+		Location:     location,
+		CheckInTime:  checkInTime,
+		CheckOutTime: checkOutTime,
+	}, nil
+}
+
+// parseTimeOfDay переводит время HH:MM в смещение от полуночи, пустое значение заменяется на fallback
+func parseTimeOfDay(value, fallback string) (time.Duration, error) {
+	if value == "" {
+		value = fallback
+	}
+	t, err := time.Parse(timeOfDayLayout, value)
+	if err != nil {
+		return 0, fmt.Errorf("expected HH:MM, got %q", value)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// initOverbookingLimits проверяет проценты овербукинга из конфига и переводит их в доменную модель
+func initOverbookingLimits(cfg config.OverbookingConfig) (model.OverbookingLimits, error) {
+	if cfg.DefaultPercent < 0 {
+		return model.OverbookingLimits{}, fmt.Errorf("default_percent must not be negative")
+	}
+
+	limits := model.OverbookingLimits{
+		DefaultPercent: cfg.DefaultPercent,
+		ByRoomType:     make(map[model.RoomType]int, len(cfg.RoomTypes)),
+	}
+	for name, percent := range cfg.RoomTypes {
+		// viper приводит ключи к нижнему регистру
+		roomType, ok := roompb.RoomType_value[strings.ToUpper(name)]
+		if !ok {
+			return model.OverbookingLimits{}, fmt.Errorf("unknown room type %q", name)
+		}
+		if percent < 0 {
+			return model.OverbookingLimits{}, fmt.Errorf("percent for %s must not be negative", name)
+		}
+		limits.ByRoomType[model.RoomType(roomType)] = percent
+	}
+
+	return limits, nil
+}
+
+// initRatePlans проверяет тарифные планы из конфига и переводит их в доменную модель
+func initRatePlans(cfg config.PricingConfig) (model.RatePlans, error) {
+	defaultPlan, err := toRatePlan(cfg.Default)
+	if err != nil {
+		return model.RatePlans{}, fmt.Errorf("default plan: %w", err)
+	}
+
+	plans := model.RatePlans{
+		Default:    defaultPlan,
+		ByRoomType: make(map[model.RoomType]model.RatePlan, len(cfg.RoomTypes)),
+	}
+	for name, planCfg := range cfg.RoomTypes {
+		// viper приводит ключи к нижнему регистру
+		roomType, ok := roompb.RoomType_value[strings.ToUpper(name)]
+		if !ok {
+			return model.RatePlans{}, fmt.Errorf("unknown room type %q", name)
+		}
+
+		plan, err := toRatePlan(planCfg)
+		if err != nil {
+			return model.RatePlans{}, fmt.Errorf("plan for %s: %w", name, err)
+		}
+		plans.ByRoomType[model.RoomType(roomType)] = plan
+	}
+
+	return plans, nil
+}
+
+func toRatePlan(cfg config.RatePlanConfig) (model.RatePlan, error) {
+	plan := model.RatePlan{
+		WeekendPercent: cfg.WeekendPercent,
+		DateOverrides:  make(map[string]decimal.Decimal, len(cfg.DateOverrides)),
+	}
+
+	for _, name := range cfg.WeekendDays {
+		day, ok := parseWeekday(name)
+		if !ok {
+			return model.RatePlan{}, fmt.Errorf("unknown weekend day %q", name)
+		}
+		plan.WeekendDays = append(plan.WeekendDays, day)
+	}
+
+	for _, seasonCfg := range cfg.Seasons {
+		from, err := parseMonthDay(seasonCfg.From)
+		if err != nil {
+			return model.RatePlan{}, fmt.Errorf("season %q: %w", seasonCfg.Name, err)
+		}
+		to, err := parseMonthDay(seasonCfg.To)
+		if err != nil {
+			return model.RatePlan{}, fmt.Errorf("season %q: %w", seasonCfg.Name, err)
+		}
+		if seasonCfg.Percent <= -100 {
+			return model.RatePlan{}, fmt.Errorf("season %q: percent must be greater than -100", seasonCfg.Name)
+		}
+		plan.Seasons = append(
+			plan.Seasons, model.Season{
+				Name:    seasonCfg.Name,
+				From:    from,
+				To:      to,
+				Percent: seasonCfg.Percent,
+			},
+		)
+	}
+
+	for _, override := range cfg.DateOverrides {
+		date, err := time.Parse("2006-01-02", override.Date)
+		if err != nil {
+			return model.RatePlan{}, fmt.Errorf("invalid override date %q, expected YYYY-MM-DD", override.Date)
+		}
+		price, err := decimal.NewFromString(override.Price)
+		if err != nil || !price.IsPositive() {
+			return model.RatePlan{}, fmt.Errorf("invalid override price %q for %s", override.Price, override.Date)
+		}
+		plan.DateOverrides[model.NightDate(date)] = price
+	}
+
+	for _, discount := range cfg.LengthOfStay {
+		if discount.MinNights < 1 || discount.Percent < 0 || discount.Percent >= 100 {
+			return model.RatePlan{}, fmt.Errorf("length of stay discount needs min_nights >= 1 and percent in [0, 100)")
+		}
+		plan.LengthOfStayDiscounts = append(
+			plan.LengthOfStayDiscounts,
+			model.LengthOfStayDiscount{MinNights: discount.MinNights, Percent: discount.Percent},
+		)
+	}
+
+	for _, surcharge := range cfg.Occupancy {
+		if surcharge.MinPercent < 0 || surcharge.MinPercent > 100 || surcharge.Percent < 0 {
+			return model.RatePlan{}, fmt.Errorf("occupancy surcharge needs min_percent in [0, 100] and non-negative percent")
+		}
+		plan.OccupancySurcharges = append(
+			plan.OccupancySurcharges,
+			model.OccupancySurcharge{MinOccupancyPercent: surcharge.MinPercent, Percent: surcharge.Percent},
+		)
+	}
+
+	if cfg.WeekendPercent <= -100 {
+		return model.RatePlan{}, fmt.Errorf("weekend percent must be greater than -100")
+	}
+
+	return plan, nil
+}
+
+func parseWeekday(name string) (time.Weekday, bool) {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.EqualFold(day.String(), name) {
+			return day, true
+		}
+	}
+	return 0, false
+}
+
+// Дата сезона в формате MM-DD; год високосный, чтобы 02-29 было допустимым
+func parseMonthDay(value string) (model.MonthDay, error) {
+	date, err := time.Parse("2006-01-02", "2000-"+value)
+	if err != nil {
+		return model.MonthDay{}, fmt.Errorf("invalid date %q, expected MM-DD", value)
+	}
+	return model.MonthDay{Month: date.Month(), Day: date.Day()}, nil
+}
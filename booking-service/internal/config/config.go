@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/spf13/viper"
 	"os"
+	"time"
 )
 
 type Config struct {
@@ -11,6 +12,9 @@ type Config struct {
 	DB          DBConfig          `mapstructure:"db"`
 	GRPC        GRPCConfig        `mapstructure:"grpc"`
 	RoomService RoomServiceConfig `mapstructure:"room_service"`
+	Booking     BookingConfig     `mapstructure:"booking"`
+	Internal    InternalConfig    `mapstructure:"internal"`
+	Email       EmailConfig       `mapstructure:"email"`
 }
 
 type DBConfig struct {
@@ -29,6 +33,135 @@ type RoomServiceConfig struct {
 	Address string `mapstructure:"address"`
 }
 
+type InternalConfig struct {
+	// Общий секрет, с которым другие сервисы вызывают внутренние методы (привязку броней гостя)
+	ServiceToken string `mapstructure:"service_token"`
+}
+
+type EmailConfig struct {
+	// SMTP сервер для уведомлений гостям; если host не задан, уведомления только пишутся в лог (для разработки)
+	SMTPHost     string `mapstructure:"smtp_host"`
+	SMTPPort     int    `mapstructure:"smtp_port"`
+	SMTPUser     string `mapstructure:"smtp_user"`
+	SMTPPassword string `mapstructure:"smtp_password"`
+	From         string `mapstructure:"from"`
+}
+
+type BookingConfig struct {
+	// Сколько PENDING бронь удерживает комнату без подтверждения
+	HoldTTL time.Duration `mapstructure:"hold_ttl"`
+	// Периодичность запуска воркера отмены просроченных броней
+	HoldExpiryInterval time.Duration `mapstructure:"hold_expiry_interval"`
+	// Сколько броней отменяется в одной транзакции
+	HoldExpiryBatchSize int `mapstructure:"hold_expiry_batch_size"`
+	// Сколько хранится ключ идемпотентности создания брони
+	IdempotencyTTL time.Duration `mapstructure:"idempotency_ttl"`
+	// Сколько гость из листа ожидания может подтвердить предложенную ему бронь
+	WaitlistHoldTTL time.Duration `mapstructure:"waitlist_hold_ttl"`
+	// Периодичность отправки уведомлений из outbox и сколько уведомлений отправляется в одной транзакции
+	NotificationInterval  time.Duration `mapstructure:"notification_interval"`
+	NotificationBatchSize int           `mapstructure:"notification_batch_size"`
+	// Валюта расчетов отеля (ISO 4217), в ней хранятся цены броней
+	Currency string `mapstructure:"currency"`
+	// JSON-файл с курсами валют для пересчета цен; пустой — пересчет отключен
+	ExchangeRatesFile string `mapstructure:"exchange_rates_file"`
+	// Часовой пояс отеля (IANA), например Europe/Moscow
+	Timezone string `mapstructure:"timezone"`
+	// Стандартное время заезда и выезда по времени отеля (HH:MM)
+	CheckInTime  string        `mapstructure:"check_in_time"`
+	CheckOutTime string        `mapstructure:"check_out_time"`
+	Nightly      NightlyConfig `mapstructure:"nightly"`
+	// Политики отмены: default и переопределения по типам комнат (ключ — имя enum RoomType)
+	Cancellation CancellationConfig `mapstructure:"cancellation"`
+	// Тарифные планы: default и переопределения по типам комнат (ключ — имя enum RoomType)
+	Pricing PricingConfig `mapstructure:"pricing"`
+	// Овербукинг: допустимые продажи сверх числа комнат по типам (ключ — имя enum RoomType)
+	Overbooking OverbookingConfig `mapstructure:"overbooking"`
+	Assignment  AssignmentConfig  `mapstructure:"assignment"`
+}
+
+type AssignmentConfig struct {
+	// За сколько дней до заезда броням назначаются конкретные комнаты
+	DaysBeforeArrival int `mapstructure:"days_before_arrival"`
+	// Периодичность запуска воркера назначения комнат
+	Interval time.Duration `mapstructure:"interval"`
+}
+
+type OverbookingConfig struct {
+	// Процент от числа комнат типа, который можно продать сверх него; 0 — овербукинг запрещен
+	DefaultPercent int            `mapstructure:"default_percent"`
+	RoomTypes      map[string]int `mapstructure:"room_types"`
+}
+
+type PricingConfig struct {
+	Default   RatePlanConfig            `mapstructure:"default"`
+	RoomTypes map[string]RatePlanConfig `mapstructure:"room_types"`
+}
+
+type RatePlanConfig struct {
+	// Дни недели (FRIDAY, SATURDAY, ...), ночи которых считаются выходными
+	WeekendDays []string `mapstructure:"weekend_days"`
+	// Наценка на выходные ночи в процентах
+	WeekendPercent int            `mapstructure:"weekend_percent"`
+	Seasons        []SeasonConfig `mapstructure:"seasons"`
+	// Цена ночи на конкретные даты вместо базовой цены комнаты
+	DateOverrides []DateOverrideConfig `mapstructure:"date_overrides"`
+	// Скидки за длительное проживание
+	LengthOfStay []LengthOfStayConfig `mapstructure:"length_of_stay"`
+	// Наценки при высокой загрузке отеля
+	Occupancy []OccupancyConfig `mapstructure:"occupancy"`
+}
+
+type SeasonConfig struct {
+	Name string `mapstructure:"name"`
+	// Границы сезона включительно в формате MM-DD, повторяются каждый год
+	From    string `mapstructure:"from"`
+	To      string `mapstructure:"to"`
+	Percent int    `mapstructure:"percent"`
+}
+
+type DateOverrideConfig struct {
+	// Дата ночи в формате YYYY-MM-DD
+	Date  string `mapstructure:"date"`
+	Price string `mapstructure:"price"`
+}
+
+type LengthOfStayConfig struct {
+	MinNights int `mapstructure:"min_nights"`
+	Percent   int `mapstructure:"percent"`
+}
+
+type OccupancyConfig struct {
+	MinPercent int `mapstructure:"min_percent"`
+	Percent    int `mapstructure:"percent"`
+}
+
+type CancellationConfig struct {
+	Default   CancellationPolicyConfig            `mapstructure:"default"`
+	RoomTypes map[string]CancellationPolicyConfig `mapstructure:"room_types"`
+}
+
+type CancellationPolicyConfig struct {
+	// Бесплатная отмена, если до заезда осталось не меньше указанного числа дней
+	FreeUntilDays int `mapstructure:"free_until_days"`
+	// Ступени штрафа: процент стоимости при отмене не раньше days_before дней до заезда
+	Penalties []PenaltyTierConfig `mapstructure:"penalties"`
+	// Невозвратный тариф
+	NonRefundable bool `mapstructure:"non_refundable"`
+}
+
+type PenaltyTierConfig struct {
+	DaysBefore int `mapstructure:"days_before"`
+	Percent    int `mapstructure:"percent"`
+}
+
+type NightlyConfig struct {
+	// Время суток по часовому поясу отеля (HH:MM), после которого брони переводятся в NO_SHOW и COMPLETED
+	Cutoff string `mapstructure:"cutoff"`
+	// Только логировать планируемые переходы, не меняя статусы
+	DryRun bool `mapstructure:"dry_run"`
+}
+
 func Load() (*Config, error) {
 	v := viper.New()
 
@@ -56,6 +189,29 @@ func Load() (*Config, error) {
 		v.BindEnv("db.name", "DB_NAME")
 		v.BindEnv("grpc.port", "GRPC_PORT")
 		v.BindEnv("room_service.address", "ROOM_SERVICE_ADDR")
+		v.BindEnv("booking.hold_ttl", "BOOKING_HOLD_TTL")
+		v.BindEnv("booking.hold_expiry_interval", "BOOKING_HOLD_EXPIRY_INTERVAL")
+		v.BindEnv("booking.hold_expiry_batch_size", "BOOKING_HOLD_EXPIRY_BATCH_SIZE")
+		v.BindEnv("booking.idempotency_ttl", "BOOKING_IDEMPOTENCY_TTL")
+		v.BindEnv("booking.waitlist_hold_ttl", "BOOKING_WAITLIST_HOLD_TTL")
+		v.BindEnv("booking.timezone", "HOTEL_TIMEZONE")
+		v.BindEnv("booking.check_in_time", "HOTEL_CHECK_IN_TIME")
+		v.BindEnv("booking.check_out_time", "HOTEL_CHECK_OUT_TIME")
+		v.BindEnv("booking.currency", "HOTEL_CURRENCY")
+		v.BindEnv("booking.exchange_rates_file", "BOOKING_EXCHANGE_RATES_FILE")
+		v.BindEnv("booking.nightly.cutoff", "BOOKING_NIGHTLY_CUTOFF")
+		v.BindEnv("booking.nightly.dry_run", "BOOKING_NIGHTLY_DRY_RUN")
+		v.BindEnv("booking.overbooking.default_percent", "BOOKING_OVERBOOKING_DEFAULT_PERCENT")
+		v.BindEnv("booking.assignment.days_before_arrival", "BOOKING_ASSIGNMENT_DAYS_BEFORE_ARRIVAL")
+		v.BindEnv("booking.assignment.interval", "BOOKING_ASSIGNMENT_INTERVAL")
+		v.BindEnv("internal.service_token", "INTERNAL_SERVICE_TOKEN")
+		v.BindEnv("booking.notification_interval", "BOOKING_NOTIFICATION_INTERVAL")
+		v.BindEnv("booking.notification_batch_size", "BOOKING_NOTIFICATION_BATCH_SIZE")
+		v.BindEnv("email.smtp_host", "SMTP_HOST")
+		v.BindEnv("email.smtp_port", "SMTP_PORT")
+		v.BindEnv("email.smtp_user", "SMTP_USER")
+		v.BindEnv("email.smtp_password", "SMTP_PASSWORD")
+		v.BindEnv("email.from", "EMAIL_FROM")
 	}
 
 	// 4. Загрузка конфига
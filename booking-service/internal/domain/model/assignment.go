@@ -0,0 +1,11 @@
+package model
+
+import "github.com/google/uuid"
+
+// Итог прогона распределения комнат
+type RoomAssignmentRun struct {
+	// Сколько броней получили комнату
+	Assigned int
+	// Брони, для которых не нашлось одной свободной комнаты на весь период проживания
+	Unplaced []uuid.UUID
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/semho/hotel-booking/pkg/money"
 	pb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
 )
 
@@ -17,21 +18,50 @@ type BookingWithStatus struct {
 }
 
 type Booking struct {
-	ID         uuid.UUID  `db:"id" json:"id"`
-	RoomID     uuid.UUID  `db:"room_id" json:"room_id"`
-	UserID     *uuid.UUID `db:"user_id" json:"user_id,omitempty"` // может быть nil для анонимных бронирований
-	GuestName  string     `db:"guest_name" json:"guest_name"`
-	GuestEmail string     `db:"guest_email" json:"guest_email"`
-	GuestPhone string     `db:"guest_phone" json:"guest_phone"`
-	CheckIn    time.Time  `db:"check_in" json:"check_in"`
-	CheckOut   time.Time  `db:"check_out" json:"check_out"`
-	TotalPrice float64    `db:"total_price" json:"total_price"`
-	CreatedAt  time.Time  `db:"created_at" json:"created_at"`
+	ID         uuid.UUID   `db:"id" json:"id"`
+	RoomID     uuid.UUID   `db:"room_id" json:"room_id"`
+	UserID     *uuid.UUID  `db:"user_id" json:"user_id,omitempty"` // может быть nil для анонимных бронирований
+	GuestName  string      `db:"guest_name" json:"guest_name"`
+	GuestEmail string      `db:"guest_email" json:"guest_email"`
+	GuestPhone string      `db:"guest_phone" json:"guest_phone"`
+	CheckIn    time.Time   `db:"check_in" json:"check_in"`
+	CheckOut   time.Time   `db:"check_out" json:"check_out"`
+	TotalPrice money.Money `db:"total_price" json:"total_price"`
+	CreatedAt  time.Time   `db:"created_at" json:"created_at"`
+	// Срок удержания PENDING брони, после него бронь отменяется автоматически
+	HoldExpiresAt *time.Time `db:"hold_expires_at" json:"hold_expires_at,omitempty"`
+	// Фактическое время заселения, nil пока гость не заселился
+	CheckedInAt *time.Time `db:"checked_in_at" json:"checked_in_at,omitempty"`
+	// Фактическое время выезда, nil пока гость не выехал
+	CheckedOutAt *time.Time `db:"checked_out_at" json:"checked_out_at,omitempty"`
+	// Номер комнаты, закрепленный при заселении
+	RoomNumber string `db:"room_number" json:"room_number,omitempty"`
+	// Результат применения политики отмены, заполняется при CancelBooking
+	CancellationPenalty *money.Money `db:"cancellation_penalty" json:"cancellation_penalty,omitempty"`
+	RefundAmount        *money.Money `db:"refund_amount" json:"refund_amount,omitempty"`
+	CancelledAt         *time.Time   `db:"cancelled_at" json:"cancelled_at,omitempty"`
+	// Групповая бронь, в которую входит бронь комнаты
+	ReservationID *uuid.UUID `db:"reservation_id" json:"reservation_id,omitempty"`
+	// Цена каждой ночи по тарифному плану, в сумме дает TotalPrice
+	PriceBreakdown NightPrices `db:"price_breakdown" json:"price_breakdown,omitempty"`
+	// Промокод, скидка по которому учтена в PriceBreakdown
+	PromoCode *string `db:"promo_code" json:"promo_code,omitempty"`
+	// Тип и вместимость комнаты, на которые оформлена бронь. Бронь без выбранной гостем комнаты
+	// создается без комнаты: RoomID остается uuid.Nil до распределения перед заездом или заселения
+	RoomType *RoomType `db:"room_type" json:"room_type,omitempty"`
+	Capacity *int32    `db:"capacity" json:"capacity,omitempty"`
+	// Профиль гостя, найденный по email или телефону брони
+	GuestProfileID *uuid.UUID `db:"guest_profile_id" json:"guest_profile_id,omitempty"`
 
 	// Добавляем поле для текущего статуса, которое не хранится в БД
 	CurrentStatus *BookingStatusHistory `db:"-" json:"current_status,omitempty"`
 }
 
+// IsRoomAssigned сообщает, закреплена ли за бронью конкретная комната
+func (b *Booking) IsRoomAssigned() bool {
+	return b.RoomID != uuid.Nil
+}
+
 type BookingStatusHistory struct {
 	ID        uuid.UUID     `db:"id" json:"id"`
 	BookingID uuid.UUID     `db:"booking_id" json:"booking_id"`
@@ -46,6 +76,28 @@ type SearchParams struct {
 	CheckOut time.Time
 	Capacity *int32
 	Type     *RoomType
+	// Валюта, в которую пересчитываются цены комнат
+	Currency *string
+}
+
+// Фильтр для выборки броней, nil-поля не учитываются
+type BookingFilter struct {
+	UserID         *uuid.UUID
+	RoomID         *uuid.UUID
+	GuestProfileID *uuid.UUID
+	Status         *BookingStatus
+	// Брони, пересекающиеся с периодом [From, To)
+	From *time.Time
+	To   *time.Time
+
+	Limit  int
+	Cursor *BookingCursor
+}
+
+// Курсор для постраничной выборки (сортировка по created_at DESC, id DESC)
+type BookingCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
 }
 
 type BookingRow struct {
@@ -56,3 +108,31 @@ type BookingRow struct {
 	StatusChangedBy string        `db:"status_changed_by"`
 	StatusChangedAt time.Time     `db:"status_changed_at"`
 }
+
+// Параметры ночного перевода броней в NO_SHOW и COMPLETED
+type NightlyRun struct {
+	// Подтвержденные брони с заездом раньше этого момента и без заселения становятся NO_SHOW
+	NoShowCheckInBefore time.Time
+	// Заселенные брони с выездом не позже этого момента становятся COMPLETED
+	CompleteCheckOutBefore time.Time
+	// Только вернуть планируемые переходы, не сохраняя их
+	DryRun bool
+}
+
+// Переход брони из одного статуса в другой
+type StatusTransition struct {
+	BookingID uuid.UUID
+	From      BookingStatus
+	To        BookingStatus
+	Reason    string
+}
+
+// Изменения брони, nil-поля остаются без изменений
+type BookingChanges struct {
+	CheckIn    *time.Time
+	CheckOut   *time.Time
+	RoomType   *RoomType
+	GuestName  *string
+	GuestEmail *string
+	GuestPhone *string
+}
@@ -0,0 +1,43 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	pb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
+)
+
+// Используем типы из proto напрямую
+type AvailabilityStatus = pb.AvailabilityStatus
+type CalendarGrouping = pb.CalendarGrouping
+
+// Параметры календаря доступности
+type AvailabilityCalendarParams struct {
+	// Период ночей [From, To), нулевые значения — период по умолчанию
+	From time.Time
+	To   time.Time
+	// Строки по комнатам или по типам комнат
+	GroupBy CalendarGrouping
+	// Только комнаты типа, nil — все
+	RoomType *RoomType
+}
+
+// Состояние строки календаря на одну ночь
+type CalendarNight struct {
+	Night  time.Time
+	Status AvailabilityStatus
+	// Бронь, занимающая комнату; только в строках комнат
+	BookingID *uuid.UUID
+	// Число комнат в каждом состоянии, брони типа без назначенной комнаты считаются занятыми
+	Free         int
+	Booked       int
+	Blocked      int
+	OutOfService int
+}
+
+// Строка календаря: комната или тип комнат (Room == nil)
+type CalendarRow struct {
+	Room     *Room
+	RoomType RoomType
+	Nights   []CalendarNight
+}
@@ -0,0 +1,77 @@
+package model
+
+import (
+	"sort"
+	"time"
+
+	"github.com/semho/hotel-booking/pkg/money"
+)
+
+// Штраф за отмену, действующий, если до заезда осталось не меньше DaysBefore дней
+type PenaltyTier struct {
+	DaysBefore int
+	Percent    int
+}
+
+// Политика отмены для типа комнаты
+type CancellationPolicy struct {
+	// Бесплатная отмена, если до заезда осталось не меньше FreeUntilDays дней
+	FreeUntilDays int
+	// Ступени штрафа после окончания бесплатной отмены
+	Penalties []PenaltyTier
+	// Невозвратный тариф: при отмене удерживается полная стоимость
+	NonRefundable bool
+}
+
+// Политики отмены по типам комнат, Default применяется к типам без своей политики
+type CancellationPolicies struct {
+	Default    CancellationPolicy
+	ByRoomType map[RoomType]CancellationPolicy
+}
+
+func (p CancellationPolicies) ForRoomType(roomType RoomType) CancellationPolicy {
+	if policy, ok := p.ByRoomType[roomType]; ok {
+		return policy
+	}
+	return p.Default
+}
+
+// PenaltyPercent возвращает процент штрафа при отмене за daysBefore дней до заезда
+func (p CancellationPolicy) PenaltyPercent(daysBefore int) int {
+	if p.NonRefundable {
+		return 100
+	}
+	if daysBefore >= p.FreeUntilDays {
+		return 0
+	}
+
+	// Ступени проверяются от самой ранней (наибольшее число дней до заезда)
+	tiers := make([]PenaltyTier, len(p.Penalties))
+	copy(tiers, p.Penalties)
+	sort.Slice(
+		tiers, func(i, j int) bool {
+			return tiers[i].DaysBefore > tiers[j].DaysBefore
+		},
+	)
+	for _, tier := range tiers {
+		if daysBefore >= tier.DaysBefore {
+			return tier.Percent
+		}
+	}
+
+	// Заезд уже наступил или ни одна ступень не подошла
+	return 100
+}
+
+// Расчет отмены брони
+type CancellationQuote struct {
+	TotalPrice     money.Money
+	PenaltyPercent int
+	Penalty        money.Money
+	Refund         money.Money
+	// Полных дней до заезда на момент расчета (отрицательное значение — заезд прошел)
+	DaysBeforeCheckIn int
+	// До какого момента отмена бесплатна, nil для невозвратного тарифа
+	FreeCancellationUntil *time.Time
+	NonRefundable         bool
+}
@@ -0,0 +1,105 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/semho/hotel-booking/pkg/money"
+)
+
+// Виды контактов, по которым узнается гость
+const (
+	GuestContactEmail = "email"
+	GuestContactPhone = "phone"
+)
+
+// Телефон короче этого числа цифр не считается контактом гостя
+const minPhoneDigits = 5
+
+// Профиль гостя, объединяющий его брони по email и телефону
+type GuestProfile struct {
+	ID   uuid.UUID `db:"id"`
+	Name string    `db:"name"`
+	// Нормализованные контакты профиля
+	Emails pq.StringArray `db:"emails"`
+	Phones pq.StringArray `db:"phones"`
+	GuestPreferences
+	// Завершенные проживания, их суммарная стоимость в валюте расчетов отеля и дата последнего выезда
+	StayCount     int         `db:"stay_count"`
+	LifetimeValue money.Money `db:"lifetime_value"`
+	LastStayAt    *time.Time  `db:"last_stay_at"`
+	CreatedAt     time.Time   `db:"created_at"`
+	UpdatedAt     time.Time   `db:"updated_at"`
+}
+
+// Предпочтения гостя, заполняются администратором
+type GuestPreferences struct {
+	BedType        string `db:"bed_type"`
+	PreferredFloor string `db:"preferred_floor"`
+	Allergies      string `db:"allergies"`
+}
+
+// Merge дополняет незаполненные предпочтения значениями other
+func (p *GuestPreferences) Merge(other GuestPreferences) {
+	if p.BedType == "" {
+		p.BedType = other.BedType
+	}
+	if p.PreferredFloor == "" {
+		p.PreferredFloor = other.PreferredFloor
+	}
+	if p.Allergies == "" {
+		p.Allergies = other.Allergies
+	}
+}
+
+// Контакт гостя в нормализованном виде
+type GuestContact struct {
+	Type  string
+	Value string
+}
+
+// Поиск профилей по имени, email или телефону
+type GuestProfileSearch struct {
+	Query string
+	Limit int
+}
+
+// NormalizeEmail приводит email к виду, в котором он хранится в контактах
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// NormalizePhone оставляет цифры телефона и ведущий '+'. Пустая строка — номер слишком короткий
+func NormalizePhone(phone string) string {
+	phone = strings.TrimSpace(phone)
+
+	var b strings.Builder
+	if strings.HasPrefix(phone, "+") {
+		b.WriteByte('+')
+	}
+	digits := 0
+	for _, r := range phone {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+			digits++
+		}
+	}
+	if digits < minPhoneDigits {
+		return ""
+	}
+	return b.String()
+}
+
+// GuestContacts возвращает нормализованные контакты гостя брони: email, затем телефон, если он задан
+func GuestContacts(email, phone string) []GuestContact {
+	var contacts []GuestContact
+	if value := NormalizeEmail(email); value != "" {
+		contacts = append(contacts, GuestContact{Type: GuestContactEmail, Value: value})
+	}
+	if value := NormalizePhone(phone); value != "" {
+		contacts = append(contacts, GuestContact{Type: GuestContactPhone, Value: value})
+	}
+	return contacts
+}
@@ -0,0 +1,26 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Ключ идемпотентности запроса и хеш его содержимого. Ключ уникален в пределах пользователя:
+// одинаковые ключи разных пользователей не пересекаются
+type IdempotencyKey struct {
+	// Пользователь, от имени которого сделан запрос; uuid.Nil для анонимных запросов
+	UserID      uuid.UUID
+	Key         string
+	RequestHash string
+}
+
+// Сохраненный результат запроса с ключом идемпотентности
+type IdempotencyRecord struct {
+	Key         string     `db:"key"`
+	RequestHash string     `db:"request_hash"`
+	BookingID   *uuid.UUID `db:"booking_id"`
+	// Ответ на исходный запрос (JSON брони)
+	Response  []byte    `db:"response"`
+	CreatedAt time.Time `db:"created_at"`
+}
@@ -0,0 +1,44 @@
+package model
+
+import "time"
+
+// Допустимый овербукинг по типам комнат: сколько процентов от числа комнат типа можно продать сверх него.
+// DefaultPercent применяется к типам без своего значения, 0 — овербукинг запрещен
+type OverbookingLimits struct {
+	DefaultPercent int
+	ByRoomType     map[RoomType]int
+}
+
+func (l OverbookingLimits) PercentFor(roomType RoomType) int {
+	if percent, ok := l.ByRoomType[roomType]; ok {
+		return percent
+	}
+	return l.DefaultPercent
+}
+
+// Ceiling возвращает, сколько броней типа можно продать на одну ночь при inventory комнатах
+func (l OverbookingLimits) Ceiling(roomType RoomType, inventory int) int {
+	return inventory + inventory*l.PercentFor(roomType)/100
+}
+
+// Продажи типа комнат на одну ночь
+type RoomTypeNight struct {
+	// Активные брони комнат типа и брони типа без комнаты
+	Booked int `db:"booked"`
+	// Из них без закрепленной комнаты
+	Unassigned int `db:"unassigned"`
+}
+
+// Ночь, на которую броней типа продано больше, чем есть комнат
+type OversoldNight struct {
+	Night     time.Time
+	RoomType  RoomType
+	Inventory int
+	Ceiling   int
+	RoomTypeNight
+}
+
+// Oversold возвращает, на сколько броней продажи превышают число комнат
+func (n OversoldNight) Oversold() int {
+	return n.Booked - n.Inventory
+}
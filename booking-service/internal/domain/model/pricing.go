@@ -0,0 +1,232 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/semho/hotel-booking/pkg/money"
+	"github.com/shopspring/decimal"
+)
+
+// Правила тарифа, попадающие в разбивку стоимости
+const (
+	PriceRuleDateOverride = "date_override"
+	PriceRuleWeekend      = "weekend"
+	PriceRuleSeason       = "season"
+	PriceRuleOccupancy    = "occupancy"
+	PriceRuleLengthOfStay = "length_of_stay"
+	// Скидка промокода, правило записывается как promo:<код>
+	PriceRulePromo = "promo"
+)
+
+const nightDateLayout = "2006-01-02"
+
+// День года без привязки к году (сезоны повторяются ежегодно)
+type MonthDay struct {
+	Month time.Month
+	Day   int
+}
+
+func (d MonthDay) before(other MonthDay) bool {
+	return d.Month < other.Month || (d.Month == other.Month && d.Day < other.Day)
+}
+
+// Сезон: наценка (или скидка при отрицательном Percent) на ночи с From по To включительно.
+// Если From позже To, сезон переходит через Новый год
+type Season struct {
+	Name    string
+	From    MonthDay
+	To      MonthDay
+	Percent int
+}
+
+func (s Season) contains(date time.Time) bool {
+	day := MonthDay{Month: date.Month(), Day: date.Day()}
+	if s.To.before(s.From) {
+		return !day.before(s.From) || !s.To.before(day)
+	}
+	return !day.before(s.From) && !s.To.before(day)
+}
+
+// Скидка за длительное проживание от MinNights ночей
+type LengthOfStayDiscount struct {
+	MinNights int
+	Percent   int
+}
+
+// Наценка при загрузке отеля не ниже MinOccupancyPercent
+type OccupancySurcharge struct {
+	MinOccupancyPercent int
+	Percent             int
+}
+
+// Тарифный план типа комнаты. Корректировки применяются к цене ночи последовательно:
+// цена на дату (или базовая цена комнаты), выходные, сезон, загрузка, длительность проживания
+type RatePlan struct {
+	// Ночи, начинающиеся в эти дни недели, считаются выходными
+	WeekendDays    []time.Weekday
+	WeekendPercent int
+	Seasons        []Season
+	// Цена ночи на конкретную дату (YYYY-MM-DD) вместо базовой цены комнаты
+	DateOverrides         map[string]decimal.Decimal
+	LengthOfStayDiscounts []LengthOfStayDiscount
+	OccupancySurcharges   []OccupancySurcharge
+}
+
+// Тарифные планы по типам комнат, Default применяется к типам без своего плана
+type RatePlans struct {
+	Default    RatePlan
+	ByRoomType map[RoomType]RatePlan
+}
+
+func (p RatePlans) ForRoomType(roomType RoomType) RatePlan {
+	if plan, ok := p.ByRoomType[roomType]; ok {
+		return plan
+	}
+	return p.Default
+}
+
+// UsesOccupancy сообщает, нужны ли плану данные о загрузке отеля
+func (p RatePlan) UsesOccupancy() bool {
+	return len(p.OccupancySurcharges) > 0
+}
+
+// PriceNights рассчитывает цену каждой ночи в валюте currency.
+// occupancy — загрузка отеля в процентах по датам ночей (YYYY-MM-DD)
+func (p RatePlan) PriceNights(
+	basePrice decimal.Decimal,
+	currency string,
+	nights []time.Time,
+	occupancy map[string]int,
+) NightPrices {
+	stayDiscount := p.lengthOfStayPercent(len(nights))
+
+	prices := make(NightPrices, len(nights))
+	for i, night := range nights {
+		date := NightDate(night)
+		base := basePrice
+		var adjustments []PriceAdjustment
+
+		if override, ok := p.DateOverrides[date]; ok {
+			base = override
+			adjustments = append(adjustments, PriceAdjustment{Rule: PriceRuleDateOverride})
+		}
+
+		price := base
+		apply := func(rule string, percent int) {
+			if percent == 0 {
+				return
+			}
+			price = price.Mul(decimal.NewFromInt(int64(100 + percent))).Div(decimal.NewFromInt(100))
+			adjustments = append(adjustments, PriceAdjustment{Rule: rule, Percent: percent})
+		}
+
+		if p.isWeekend(night.Weekday()) {
+			apply(PriceRuleWeekend, p.WeekendPercent)
+		}
+		for _, season := range p.Seasons {
+			if season.contains(night) {
+				apply(PriceRuleSeason+":"+season.Name, season.Percent)
+				break
+			}
+		}
+		apply(PriceRuleOccupancy, p.occupancyPercent(occupancy[date]))
+		apply(PriceRuleLengthOfStay, -stayDiscount)
+
+		prices[i] = NightPrice{
+			Date:        night,
+			BasePrice:   money.FromDecimal(base, currency),
+			Price:       money.FromDecimal(price, currency),
+			Adjustments: adjustments,
+		}
+	}
+
+	return prices
+}
+
+func (p RatePlan) isWeekend(day time.Weekday) bool {
+	for _, weekend := range p.WeekendDays {
+		if weekend == day {
+			return true
+		}
+	}
+	return false
+}
+
+// Наибольшая скидка, для которой набрано нужное число ночей
+func (p RatePlan) lengthOfStayPercent(nights int) int {
+	percent := 0
+	for _, discount := range p.LengthOfStayDiscounts {
+		if nights >= discount.MinNights && discount.Percent > percent {
+			percent = discount.Percent
+		}
+	}
+	return percent
+}
+
+// Наценка самой высокой достигнутой ступени загрузки
+func (p RatePlan) occupancyPercent(occupancy int) int {
+	percent, threshold := 0, -1
+	for _, surcharge := range p.OccupancySurcharges {
+		if occupancy >= surcharge.MinOccupancyPercent && surcharge.MinOccupancyPercent > threshold {
+			percent, threshold = surcharge.Percent, surcharge.MinOccupancyPercent
+		}
+	}
+	return percent
+}
+
+// Примененное к цене ночи правило тарифа
+type PriceAdjustment struct {
+	Rule    string `json:"rule"`
+	Percent int    `json:"percent,omitempty"`
+	// Изменение цены суммой, для фиксированной скидки промокода
+	Amount *money.Money `json:"amount,omitempty"`
+}
+
+// Цена одной ночи проживания
+type NightPrice struct {
+	Date        time.Time         `json:"date"`
+	BasePrice   money.Money       `json:"base_price"`
+	Price       money.Money       `json:"price"`
+	Adjustments []PriceAdjustment `json:"adjustments,omitempty"`
+}
+
+// Разбивка стоимости по ночам, хранится в JSONB
+type NightPrices []NightPrice
+
+// Total суммирует цены ночей; все ночи рассчитываются в одной валюте
+func (n NightPrices) Total(currency string) (money.Money, error) {
+	prices := make([]money.Money, len(n))
+	for i, night := range n {
+		prices[i] = night.Price
+	}
+	return money.Sum(currency, prices...)
+}
+
+func (n NightPrices) Value() (driver.Value, error) {
+	if n == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(n)
+}
+
+func (n *NightPrices) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*n = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, n)
+	case string:
+		return json.Unmarshal([]byte(v), n)
+	default:
+		return fmt.Errorf("unsupported price breakdown type %T", src)
+	}
+}
+
+// NightDate — ключ ночи в тарифах и данных загрузки
+func NightDate(night time.Time) string {
+	return night.Format(nightDateLayout)
+}
@@ -0,0 +1,272 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/semho/hotel-booking/pkg/money"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	summer   = Season{Name: "summer", From: MonthDay{time.June, 1}, To: MonthDay{time.August, 31}, Percent: 30}
+	peak     = Season{Name: "peak", From: MonthDay{time.July, 1}, To: MonthDay{time.July, 31}, Percent: 50}
+	newYear  = Season{Name: "new_year", From: MonthDay{time.December, 28}, To: MonthDay{time.January, 8}, Percent: 50}
+	lowMarch = Season{Name: "low", From: MonthDay{time.March, 1}, To: MonthDay{time.March, 31}, Percent: -20}
+)
+
+func night(date string) time.Time {
+	t, err := time.Parse(nightDateLayout, date)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// consecutiveNights — count ночей подряд начиная с from
+func consecutiveNights(from string, count int) []time.Time {
+	nights := make([]time.Time, count)
+	for i := range nights {
+		nights[i] = night(from).AddDate(0, 0, i)
+	}
+	return nights
+}
+
+func adjustmentRules(adjustments []PriceAdjustment) []string {
+	var rules []string
+	for _, adjustment := range adjustments {
+		rules = append(rules, adjustment.Rule)
+	}
+	return rules
+}
+
+func TestPriceNightsSeasons(t *testing.T) {
+	tests := []struct {
+		name      string
+		seasons   []Season
+		night     string
+		wantPrice int64
+		wantRules []string
+	}{
+		{name: "before season", seasons: []Season{summer}, night: "2025-05-31", wantPrice: 10000},
+		{name: "first day of season", seasons: []Season{summer}, night: "2025-06-01", wantPrice: 13000,
+			wantRules: []string{"season:summer"}},
+		{name: "last day of season", seasons: []Season{summer}, night: "2025-08-31", wantPrice: 13000,
+			wantRules: []string{"season:summer"}},
+		{name: "after season", seasons: []Season{summer}, night: "2025-09-01", wantPrice: 10000},
+		{name: "overlapping seasons apply the first listed", seasons: []Season{summer, peak}, night: "2025-07-15",
+			wantPrice: 13000, wantRules: []string{"season:summer"}},
+		{name: "overlapping seasons in reverse order", seasons: []Season{peak, summer}, night: "2025-07-15",
+			wantPrice: 15000, wantRules: []string{"season:peak"}},
+		{name: "overlap outside the inner season", seasons: []Season{peak, summer}, night: "2025-08-01",
+			wantPrice: 13000, wantRules: []string{"season:summer"}},
+		{name: "season across new year in december", seasons: []Season{newYear}, night: "2025-12-31",
+			wantPrice: 15000, wantRules: []string{"season:new_year"}},
+		{name: "season across new year in january", seasons: []Season{newYear}, night: "2026-01-08",
+			wantPrice: 15000, wantRules: []string{"season:new_year"}},
+		{name: "after season across new year", seasons: []Season{newYear}, night: "2026-01-09", wantPrice: 10000},
+		{name: "season discount", seasons: []Season{lowMarch}, night: "2025-03-10", wantPrice: 8000,
+			wantRules: []string{"season:low"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := RatePlan{Seasons: tt.seasons}
+			prices := plan.PriceNights(decimal.NewFromInt(100), "RUB", []time.Time{night(tt.night)}, nil)
+
+			if got := prices[0].Price; got != money.New(tt.wantPrice, "RUB") {
+				t.Errorf("price = %+v, want %d", got, tt.wantPrice)
+			}
+			if got := adjustmentRules(prices[0].Adjustments); !reflect.DeepEqual(got, tt.wantRules) {
+				t.Errorf("rules = %v, want %v", got, tt.wantRules)
+			}
+		})
+	}
+}
+
+func TestPriceNightsLengthOfStay(t *testing.T) {
+	plan := RatePlan{
+		LengthOfStayDiscounts: []LengthOfStayDiscount{
+			{MinNights: 7, Percent: 10},
+			{MinNights: 14, Percent: 15},
+		},
+	}
+
+	tests := []struct {
+		nights      int
+		wantPercent int
+		wantPrice   int64
+	}{
+		{nights: 1, wantPercent: 0, wantPrice: 10000},
+		{nights: 6, wantPercent: 0, wantPrice: 10000},
+		{nights: 7, wantPercent: 10, wantPrice: 9000},
+		{nights: 13, wantPercent: 10, wantPrice: 9000},
+		{nights: 14, wantPercent: 15, wantPrice: 8500},
+		{nights: 30, wantPercent: 15, wantPrice: 8500},
+	}
+
+	for _, tt := range tests {
+		prices := plan.PriceNights(decimal.NewFromInt(100), "RUB", consecutiveNights("2025-03-03", tt.nights), nil)
+		if len(prices) != tt.nights {
+			t.Fatalf("%d nights: got %d prices", tt.nights, len(prices))
+		}
+
+		// Скидка за длительность применяется ко всем ночам проживания одинаково
+		for _, price := range prices {
+			if price.Price != money.New(tt.wantPrice, "RUB") {
+				t.Errorf(
+					"%d nights: price of %s = %+v, want %d",
+					tt.nights, NightDate(price.Date), price.Price, tt.wantPrice,
+				)
+			}
+			var wantAdjustments []PriceAdjustment
+			if tt.wantPercent > 0 {
+				wantAdjustments = []PriceAdjustment{{Rule: PriceRuleLengthOfStay, Percent: -tt.wantPercent}}
+			}
+			if !reflect.DeepEqual(price.Adjustments, wantAdjustments) {
+				t.Errorf("%d nights: adjustments = %+v, want %+v", tt.nights, price.Adjustments, wantAdjustments)
+			}
+		}
+	}
+}
+
+func TestPriceNightsOccupancy(t *testing.T) {
+	const stayNight = "2025-03-03"
+	plan := RatePlan{
+		OccupancySurcharges: []OccupancySurcharge{
+			{MinOccupancyPercent: 90, Percent: 25},
+			{MinOccupancyPercent: 70, Percent: 10},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		occupancy   map[string]int
+		wantPercent int
+		wantPrice   int64
+	}{
+		{name: "no occupancy data", occupancy: nil, wantPercent: 0, wantPrice: 10000},
+		{name: "below first threshold", occupancy: map[string]int{stayNight: 69}, wantPercent: 0, wantPrice: 10000},
+		{name: "exactly first threshold", occupancy: map[string]int{stayNight: 70}, wantPercent: 10, wantPrice: 11000},
+		{name: "below second threshold", occupancy: map[string]int{stayNight: 89}, wantPercent: 10, wantPrice: 11000},
+		{name: "exactly second threshold", occupancy: map[string]int{stayNight: 90}, wantPercent: 25, wantPrice: 12500},
+		{name: "full hotel", occupancy: map[string]int{stayNight: 100}, wantPercent: 25, wantPrice: 12500},
+		{name: "other night occupied", occupancy: map[string]int{"2025-03-04": 100}, wantPercent: 0, wantPrice: 10000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prices := plan.PriceNights(decimal.NewFromInt(100), "RUB", []time.Time{night(stayNight)}, tt.occupancy)
+
+			if got := prices[0].Price; got != money.New(tt.wantPrice, "RUB") {
+				t.Errorf("price = %+v, want %d", got, tt.wantPrice)
+			}
+			var wantAdjustments []PriceAdjustment
+			if tt.wantPercent > 0 {
+				wantAdjustments = []PriceAdjustment{{Rule: PriceRuleOccupancy, Percent: tt.wantPercent}}
+			}
+			if !reflect.DeepEqual(prices[0].Adjustments, wantAdjustments) {
+				t.Errorf("adjustments = %+v, want %+v", prices[0].Adjustments, wantAdjustments)
+			}
+		})
+	}
+}
+
+// Разбивка по ночам со всеми правилами тарифа: корректировки применяются последовательно,
+// цена каждой ночи округляется, итог — сумма округленных цен ночей
+func TestPriceNightsBreakdownTotal(t *testing.T) {
+	plan := RatePlan{
+		WeekendDays:    []time.Weekday{time.Friday, time.Saturday},
+		WeekendPercent: 20,
+		Seasons:        []Season{summer},
+		DateOverrides:  map[string]decimal.Decimal{"2025-07-05": decimal.NewFromInt(200)},
+		LengthOfStayDiscounts: []LengthOfStayDiscount{
+			{MinNights: 3, Percent: 10},
+		},
+		OccupancySurcharges: []OccupancySurcharge{
+			{MinOccupancyPercent: 70, Percent: 10},
+		},
+	}
+
+	// Четверг, пятница и суббота
+	prices := plan.PriceNights(
+		decimal.NewFromInt(100),
+		"RUB",
+		consecutiveNights("2025-07-03", 3),
+		map[string]int{"2025-07-03": 75},
+	)
+
+	want := NightPrices{
+		{
+			Date:      night("2025-07-03"),
+			BasePrice: money.New(10000, "RUB"),
+			// 100 * 1.3 * 1.1 * 0.9
+			Price: money.New(12870, "RUB"),
+			Adjustments: []PriceAdjustment{
+				{Rule: "season:summer", Percent: 30},
+				{Rule: PriceRuleOccupancy, Percent: 10},
+				{Rule: PriceRuleLengthOfStay, Percent: -10},
+			},
+		},
+		{
+			Date:      night("2025-07-04"),
+			BasePrice: money.New(10000, "RUB"),
+			// 100 * 1.2 * 1.3 * 0.9
+			Price: money.New(14040, "RUB"),
+			Adjustments: []PriceAdjustment{
+				{Rule: PriceRuleWeekend, Percent: 20},
+				{Rule: "season:summer", Percent: 30},
+				{Rule: PriceRuleLengthOfStay, Percent: -10},
+			},
+		},
+		{
+			Date:      night("2025-07-05"),
+			BasePrice: money.New(20000, "RUB"),
+			// 200 * 1.2 * 1.3 * 0.9
+			Price: money.New(28080, "RUB"),
+			Adjustments: []PriceAdjustment{
+				{Rule: PriceRuleDateOverride},
+				{Rule: PriceRuleWeekend, Percent: 20},
+				{Rule: "season:summer", Percent: 30},
+				{Rule: PriceRuleLengthOfStay, Percent: -10},
+			},
+		},
+	}
+	if !reflect.DeepEqual(prices, want) {
+		t.Fatalf("breakdown = %+v, want %+v", prices, want)
+	}
+
+	total, err := prices.Total("RUB")
+	if err != nil {
+		t.Fatalf("total: %v", err)
+	}
+	if total != money.New(54990, "RUB") {
+		t.Fatalf("total = %+v, want 549.90 RUB", total)
+	}
+}
+
+// Итог складывается из цен ночей, округленных по отдельности: 3 × 90.045 дает 270.12, а не 270.14
+func TestPriceNightsTotalOfRoundedNights(t *testing.T) {
+	plan := RatePlan{LengthOfStayDiscounts: []LengthOfStayDiscount{{MinNights: 1, Percent: 10}}}
+
+	nights := consecutiveNights("2025-03-03", 3)
+	prices := plan.PriceNights(decimal.RequireFromString("100.05"), "RUB", nights, nil)
+	for _, price := range prices {
+		if price.Price != money.New(9004, "RUB") {
+			t.Fatalf("price of %s = %+v, want 90.04 RUB", NightDate(price.Date), price.Price)
+		}
+	}
+
+	total, err := prices.Total("RUB")
+	if err != nil {
+		t.Fatalf("total: %v", err)
+	}
+	if total != money.New(27012, "RUB") {
+		t.Fatalf("total = %+v, want 270.12 RUB", total)
+	}
+
+	if _, err := prices.Total("USD"); err == nil {
+		t.Fatal("expected currency mismatch for total in another currency")
+	}
+}
@@ -0,0 +1,191 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/semho/hotel-booking/pkg/errors"
+	"github.com/semho/hotel-booking/pkg/money"
+	pb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
+)
+
+// Используем тип из proto напрямую
+type PromoDiscountType = pb.PromoDiscountType
+
+// Латинские буквы, цифры, дефис и подчеркивание; код хранится в верхнем регистре
+var promoCodePattern = regexp.MustCompile(`^[A-Z0-9_-]{3,64}$`)
+
+type PromoCode struct {
+	ID           uuid.UUID         `db:"id"`
+	Code         string            `db:"code"`
+	DiscountType PromoDiscountType `db:"discount_type"`
+	// Скидка в процентах на каждую ночь (PERCENT)
+	DiscountPercent int `db:"discount_percent"`
+	// Скидка на всю бронь (FIXED), в валюте расчетов отеля
+	DiscountAmount *money.Money `db:"discount_amount"`
+	// Период действия по моменту создания брони, nil — без ограничения
+	ValidFrom *time.Time `db:"valid_from"`
+	ValidTo   *time.Time `db:"valid_to"`
+	// Типы комнат, на которые действует код; пустой список — на все
+	RoomTypes pq.Int32Array `db:"room_types"`
+	// Лимиты погашений всего и на одного гостя, nil — без лимита
+	MaxRedemptions        *int      `db:"max_redemptions"`
+	MaxRedemptionsPerUser *int      `db:"max_redemptions_per_user"`
+	Redemptions           int       `db:"redemptions"`
+	Active                bool      `db:"active"`
+	CreatedAt             time.Time `db:"created_at"`
+	UpdatedAt             time.Time `db:"updated_at"`
+}
+
+// NormalizePromoCode приводит введенный гостем код к виду, в котором он хранится
+func NormalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// Validate проверяет условия промокода; currency — валюта расчетов отеля для фиксированной скидки
+func (p *PromoCode) Validate(currency string) error {
+	if !promoCodePattern.MatchString(p.Code) {
+		return errors.WithMessage(
+			errors.ErrInvalidInput,
+			"promo code must be 3-64 latin letters, digits, '-' or '_'",
+		)
+	}
+
+	switch p.DiscountType {
+	case pb.PromoDiscountType_PROMO_DISCOUNT_TYPE_PERCENT:
+		if p.DiscountPercent <= 0 || p.DiscountPercent > 100 {
+			return errors.WithMessage(errors.ErrInvalidInput, "discount percent must be between 1 and 100")
+		}
+		p.DiscountAmount = nil
+	case pb.PromoDiscountType_PROMO_DISCOUNT_TYPE_FIXED:
+		if p.DiscountAmount == nil || p.DiscountAmount.Amount <= 0 {
+			return errors.WithMessage(errors.ErrInvalidInput, "discount amount must be greater than 0")
+		}
+		if p.DiscountAmount.Currency != currency {
+			return errors.WithMessage(
+				errors.ErrInvalidInput,
+				fmt.Sprintf("discount amount must be in %s", currency),
+			)
+		}
+		p.DiscountPercent = 0
+	default:
+		return errors.WithMessage(errors.ErrInvalidInput, "invalid discount type")
+	}
+
+	if p.ValidFrom != nil && p.ValidTo != nil && !p.ValidTo.After(*p.ValidFrom) {
+		return errors.WithMessage(errors.ErrInvalidInput, "valid_to must be after valid_from")
+	}
+	if p.MaxRedemptions != nil && *p.MaxRedemptions <= 0 {
+		return errors.WithMessage(errors.ErrInvalidInput, "max redemptions must be positive")
+	}
+	if p.MaxRedemptionsPerUser != nil && *p.MaxRedemptionsPerUser <= 0 {
+		return errors.WithMessage(errors.ErrInvalidInput, "max redemptions per user must be positive")
+	}
+	return nil
+}
+
+// CheckRedeemable проверяет, что код можно применить к брони комнаты roomType в момент now.
+// Лимиты погашений проверяются отдельно под блокировкой кода
+func (p *PromoCode) CheckRedeemable(roomType RoomType, now time.Time) error {
+	if !p.Active {
+		return errors.WithMessage(errors.ErrInvalidInput, "promo code is not active")
+	}
+	if p.ValidFrom != nil && now.Before(*p.ValidFrom) {
+		return errors.WithMessage(errors.ErrInvalidInput, "promo code is not valid yet")
+	}
+	if p.ValidTo != nil && !now.Before(*p.ValidTo) {
+		return errors.WithMessage(errors.ErrInvalidInput, "promo code has expired")
+	}
+	if !p.AppliesTo(roomType) {
+		return errors.WithMessage(errors.ErrInvalidInput, "promo code does not apply to this room type")
+	}
+	if p.MaxRedemptions != nil && p.Redemptions >= *p.MaxRedemptions {
+		return errors.WithMessage(errors.ErrConflict, "promo code has been fully redeemed")
+	}
+	return nil
+}
+
+// AppliesTo сообщает, действует ли код на тип комнаты
+func (p *PromoCode) AppliesTo(roomType RoomType) bool {
+	if len(p.RoomTypes) == 0 {
+		return true
+	}
+	for _, t := range p.RoomTypes {
+		if RoomType(t) == roomType {
+			return true
+		}
+	}
+	return false
+}
+
+// Apply добавляет скидку промокода в разбивку стоимости и возвращает новую разбивку.
+// Процентная скидка применяется к каждой ночи, фиксированная распределяется по ночам
+// и не может превысить стоимость проживания
+func (p *PromoCode) Apply(breakdown NightPrices) NightPrices {
+	rule := PriceRulePromo + ":" + p.Code
+	discounted := make(NightPrices, len(breakdown))
+	copy(discounted, breakdown)
+
+	if p.DiscountType == pb.PromoDiscountType_PROMO_DISCOUNT_TYPE_PERCENT {
+		for i, night := range discounted {
+			night.Price = night.Price.Percent(100 - p.DiscountPercent)
+			night.Adjustments = appendAdjustment(night.Adjustments, PriceAdjustment{Rule: rule, Percent: -p.DiscountPercent})
+			discounted[i] = night
+		}
+		return discounted
+	}
+
+	if p.DiscountAmount == nil || len(discounted) == 0 {
+		return discounted
+	}
+
+	// Распределяем скидку начиная с самых дешевых ночей: каждой достается равная доля остатка,
+	// но не больше ее цены, поэтому вся скидка (в пределах стоимости) распределяется без остатка
+	order := make([]int, len(discounted))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return discounted[order[a]].Price.Amount < discounted[order[b]].Price.Amount
+	})
+
+	remaining := p.DiscountAmount.Amount
+	for left, idx := len(order), 0; idx < len(order); idx, left = idx+1, left-1 {
+		i := order[idx]
+		night := discounted[i]
+		share := min(remaining/int64(left), night.Price.Amount)
+		if idx == len(order)-1 {
+			share = min(remaining, night.Price.Amount)
+		}
+		remaining -= share
+		if share == 0 {
+			continue
+		}
+
+		amount := money.New(-share, night.Price.Currency)
+		night.Price = money.New(night.Price.Amount-share, night.Price.Currency)
+		night.Adjustments = appendAdjustment(night.Adjustments, PriceAdjustment{Rule: rule, Amount: &amount})
+		discounted[i] = night
+	}
+	return discounted
+}
+
+// Копия слайса корректировок, чтобы не менять исходную разбивку
+func appendAdjustment(adjustments []PriceAdjustment, adjustment PriceAdjustment) []PriceAdjustment {
+	result := make([]PriceAdjustment, len(adjustments), len(adjustments)+1)
+	copy(result, adjustments)
+	return append(result, adjustment)
+}
+
+// Погашение промокода бронью
+type PromoRedemption struct {
+	PromoCodeID uuid.UUID
+	BookingID   uuid.UUID
+	UserID      *uuid.UUID
+	GuestEmail  string
+}
@@ -0,0 +1,47 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/semho/hotel-booking/pkg/money"
+)
+
+// Групповая бронь нескольких комнат с общим кодом подтверждения
+type Reservation struct {
+	ID               uuid.UUID  `db:"id" json:"id"`
+	ConfirmationCode string     `db:"confirmation_code" json:"confirmation_code"`
+	UserID           *uuid.UUID `db:"user_id" json:"user_id,omitempty"`
+	ContactName      string     `db:"contact_name" json:"contact_name"`
+	ContactEmail     string     `db:"contact_email" json:"contact_email"`
+	ContactPhone     string     `db:"contact_phone" json:"contact_phone"`
+	CreatedAt        time.Time  `db:"created_at" json:"created_at"`
+
+	// Брони комнат, входящих в групповую бронь
+	Bookings []Booking `db:"-" json:"bookings"`
+}
+
+// Комната, запрашиваемая в групповой брони: конкретная или первая свободная по типу и вместимости
+type ReservationRoom struct {
+	RoomID    *uuid.UUID
+	Type      RoomType
+	Capacity  int32
+	GuestName string
+}
+
+// TotalPrice суммирует стоимость броней комнат; nil, если в групповой брони нет комнат
+func (r *Reservation) TotalPrice() (*money.Money, error) {
+	if len(r.Bookings) == 0 {
+		return nil, nil
+	}
+
+	prices := make([]money.Money, len(r.Bookings))
+	for i, booking := range r.Bookings {
+		prices[i] = booking.TotalPrice
+	}
+	total, err := money.Sum(r.Bookings[0].TotalPrice.Currency, prices...)
+	if err != nil {
+		return nil, err
+	}
+	return &total, nil
+}
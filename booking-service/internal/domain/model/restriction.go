@@ -0,0 +1,185 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/semho/hotel-booking/pkg/errors"
+	"github.com/semho/hotel-booking/pkg/proto/room_v1/room"
+)
+
+// Ограничение продаж на период дат. Правило применяется к дате, если она попадает в период
+// и ее день недели есть в Weekdays
+type StayRestriction struct {
+	ID   uuid.UUID `db:"id"`
+	Name string    `db:"name"`
+	// Период дат, обе границы включительно
+	DateFrom time.Time `db:"date_from"`
+	DateTo   time.Time `db:"date_to"`
+	// Тип комнаты, nil — все типы
+	RoomType *RoomType `db:"room_type"`
+	// Дни недели (time.Weekday, 0 — воскресенье); пустой список — все дни
+	Weekdays pq.Int32Array `db:"weekdays"`
+	// Длительность проживания в ночах, если хотя бы одна ночь проживания попадает под правило
+	MinStay *int `db:"min_stay"`
+	MaxStay *int `db:"max_stay"`
+	// Запрет заезда и выезда в даты, попадающие под правило
+	ClosedToArrival   bool `db:"closed_to_arrival"`
+	ClosedToDeparture bool `db:"closed_to_departure"`
+	// За сколько дней до заезда можно бронировать: не позже MinAdvanceDays и не раньше MaxAdvanceDays
+	MinAdvanceDays *int      `db:"min_advance_days"`
+	MaxAdvanceDays *int      `db:"max_advance_days"`
+	CreatedAt      time.Time `db:"created_at"`
+	UpdatedAt      time.Time `db:"updated_at"`
+}
+
+// Фильтр списка ограничений, nil — без фильтра
+type StayRestrictionFilter struct {
+	// Правила, пересекающиеся с периодом дат [From, To]
+	From *time.Time
+	To   *time.Time
+	// Правила типа и правила для всех типов
+	RoomType *RoomType
+}
+
+// Проживание, проверяемое ограничениями. Даты — полночь по времени отеля
+type RestrictedStay struct {
+	Arrival   time.Time
+	Departure time.Time
+	Nights    []time.Time
+	// За сколько дней до заезда делается бронь
+	AdvanceDays int
+}
+
+func (r *StayRestriction) Validate() error {
+	if r.DateFrom.IsZero() || r.DateTo.IsZero() {
+		return errors.WithMessage(errors.ErrInvalidInput, "date_from and date_to are required")
+	}
+	if NightDate(r.DateTo) < NightDate(r.DateFrom) {
+		return errors.WithMessage(errors.ErrInvalidInput, "date_to must not be before date_from")
+	}
+	if r.RoomType != nil {
+		if _, ok := room.RoomType_name[int32(*r.RoomType)]; !ok || *r.RoomType == room.RoomType_ROOM_TYPE_UNSPECIFIED {
+			return errors.WithMessage(errors.ErrInvalidInput, "invalid room type")
+		}
+	}
+	for _, weekday := range r.Weekdays {
+		if weekday < int32(time.Sunday) || weekday > int32(time.Saturday) {
+			return errors.WithMessage(errors.ErrInvalidInput, "weekdays must be between 0 (Sunday) and 6 (Saturday)")
+		}
+	}
+
+	if r.MinStay == nil && r.MaxStay == nil && !r.ClosedToArrival && !r.ClosedToDeparture &&
+		r.MinAdvanceDays == nil && r.MaxAdvanceDays == nil {
+		return errors.WithMessage(errors.ErrInvalidInput, "restriction must set at least one rule")
+	}
+	if r.MinStay != nil && *r.MinStay <= 0 || r.MaxStay != nil && *r.MaxStay <= 0 {
+		return errors.WithMessage(errors.ErrInvalidInput, "min_stay and max_stay must be positive")
+	}
+	if r.MinStay != nil && r.MaxStay != nil && *r.MinStay > *r.MaxStay {
+		return errors.WithMessage(errors.ErrInvalidInput, "min_stay must not exceed max_stay")
+	}
+	if r.MinAdvanceDays != nil && *r.MinAdvanceDays < 0 || r.MaxAdvanceDays != nil && *r.MaxAdvanceDays < 0 {
+		return errors.WithMessage(errors.ErrInvalidInput, "advance days must not be negative")
+	}
+	if r.MinAdvanceDays != nil && r.MaxAdvanceDays != nil && *r.MinAdvanceDays > *r.MaxAdvanceDays {
+		return errors.WithMessage(errors.ErrInvalidInput, "min_advance_days must not exceed max_advance_days")
+	}
+	return nil
+}
+
+// AppliesTo сообщает, действует ли правило на тип комнаты
+func (r *StayRestriction) AppliesTo(roomType RoomType) bool {
+	return r.RoomType == nil || *r.RoomType == roomType
+}
+
+// Covers сообщает, попадает ли дата под правило. Даты сравниваются без учета часового пояса
+func (r *StayRestriction) Covers(date time.Time) bool {
+	day := NightDate(date)
+	if day < NightDate(r.DateFrom) || day > NightDate(r.DateTo) {
+		return false
+	}
+	if len(r.Weekdays) == 0 {
+		return true
+	}
+	for _, weekday := range r.Weekdays {
+		if time.Weekday(weekday) == date.Weekday() {
+			return true
+		}
+	}
+	return false
+}
+
+// Check проверяет проживание в комнате типа roomType, возвращает первое нарушенное правило
+func (r *StayRestriction) Check(roomType RoomType, stay RestrictedStay) error {
+	if !r.AppliesTo(roomType) {
+		return nil
+	}
+
+	if r.ClosedToArrival && r.Covers(stay.Arrival) {
+		return restrictionError(fmt.Sprintf("arrival is not allowed on %s", NightDate(stay.Arrival)))
+	}
+	if r.ClosedToDeparture && r.Covers(stay.Departure) {
+		return restrictionError(fmt.Sprintf("departure is not allowed on %s", NightDate(stay.Departure)))
+	}
+
+	if r.MinStay != nil || r.MaxStay != nil {
+		for _, night := range stay.Nights {
+			if !r.Covers(night) {
+				continue
+			}
+			if r.MinStay != nil && len(stay.Nights) < *r.MinStay {
+				return restrictionError(
+					fmt.Sprintf("minimum stay including %s is %d nights", NightDate(night), *r.MinStay),
+				)
+			}
+			if r.MaxStay != nil && len(stay.Nights) > *r.MaxStay {
+				return restrictionError(
+					fmt.Sprintf("maximum stay including %s is %d nights", NightDate(night), *r.MaxStay),
+				)
+			}
+			break
+		}
+	}
+
+	if (r.MinAdvanceDays != nil || r.MaxAdvanceDays != nil) && r.Covers(stay.Arrival) {
+		if r.MinAdvanceDays != nil && stay.AdvanceDays < *r.MinAdvanceDays {
+			return restrictionError(
+				fmt.Sprintf(
+					"arrival on %s must be booked at least %d days in advance",
+					NightDate(stay.Arrival),
+					*r.MinAdvanceDays,
+				),
+			)
+		}
+		if r.MaxAdvanceDays != nil && stay.AdvanceDays > *r.MaxAdvanceDays {
+			return restrictionError(
+				fmt.Sprintf(
+					"arrival on %s cannot be booked more than %d days in advance",
+					NightDate(stay.Arrival),
+					*r.MaxAdvanceDays,
+				),
+			)
+		}
+	}
+	return nil
+}
+
+// Ограничения, действующие на проживание
+type StayRestrictions []StayRestriction
+
+// Check проверяет проживание по всем правилам, возвращает первое нарушенное
+func (rs StayRestrictions) Check(roomType RoomType, stay RestrictedStay) error {
+	for i := range rs {
+		if err := rs[i].Check(roomType, stay); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func restrictionError(message string) error {
+	return errors.WithMessage(errors.ErrInvalidInput, "stay restriction: "+message)
+}
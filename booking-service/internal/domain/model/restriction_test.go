@@ -0,0 +1,174 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/semho/hotel-booking/pkg/errors"
+	"github.com/semho/hotel-booking/pkg/proto/room_v1/room"
+)
+
+func intPtr(v int) *int {
+	return &v
+}
+
+// julyRestriction — правило на июль 2025 года
+func julyRestriction(rule StayRestriction) StayRestriction {
+	rule.DateFrom = night("2025-07-01")
+	rule.DateTo = night("2025-07-31")
+	return rule
+}
+
+// restrictedStay — проживание на count ночей с заезда arrival, забронированное за advanceDays дней
+func restrictedStay(arrival string, count, advanceDays int) RestrictedStay {
+	nights := consecutiveNights(arrival, count)
+	return RestrictedStay{
+		Arrival:     nights[0],
+		Departure:   nights[0].AddDate(0, 0, count),
+		Nights:      nights,
+		AdvanceDays: advanceDays,
+	}
+}
+
+func TestStayRestrictionCovers(t *testing.T) {
+	weekends := julyRestriction(StayRestriction{Weekdays: []int32{int32(time.Friday), int32(time.Saturday)}})
+	allDays := julyRestriction(StayRestriction{})
+	moscow := time.FixedZone("MSK", 3*60*60)
+
+	tests := []struct {
+		name        string
+		restriction StayRestriction
+		date        time.Time
+		want        bool
+	}{
+		{name: "day before period", restriction: allDays, date: night("2025-06-30"), want: false},
+		{name: "first day of period", restriction: allDays, date: night("2025-07-01"), want: true},
+		{name: "last day of period", restriction: allDays, date: night("2025-07-31"), want: true},
+		{name: "day after period", restriction: allDays, date: night("2025-08-01"), want: false},
+		{name: "date in hotel time zone", restriction: allDays, date: time.Date(2025, 7, 31, 23, 0, 0, 0, moscow),
+			want: true},
+		{name: "listed weekday", restriction: weekends, date: night("2025-07-04"), want: true},
+		{name: "other weekday", restriction: weekends, date: night("2025-07-03"), want: false},
+		{name: "listed weekday outside period", restriction: weekends, date: night("2025-08-01"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.restriction.Covers(tt.date); got != tt.want {
+				t.Fatalf("Covers(%s) = %v, want %v", NightDate(tt.date), got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStayRestrictionCheck(t *testing.T) {
+	deluxe := room.RoomType_ROOM_TYPE_DELUXE
+	suite := room.RoomType_ROOM_TYPE_SUITE
+
+	saturday := []int32{int32(time.Saturday)}
+	var (
+		minStay               = julyRestriction(StayRestriction{MinStay: intPtr(3)})
+		minStaySaturday       = julyRestriction(StayRestriction{MinStay: intPtr(3), Weekdays: saturday})
+		maxStay               = julyRestriction(StayRestriction{MaxStay: intPtr(7)})
+		closedToArrival       = julyRestriction(StayRestriction{ClosedToArrival: true})
+		closedSaturdayArrival = julyRestriction(StayRestriction{ClosedToArrival: true, Weekdays: saturday})
+		closedToDeparture     = julyRestriction(StayRestriction{ClosedToDeparture: true})
+		minAdvance            = julyRestriction(StayRestriction{MinAdvanceDays: intPtr(3)})
+		maxAdvance            = julyRestriction(StayRestriction{MaxAdvanceDays: intPtr(30)})
+	)
+
+	tests := []struct {
+		name        string
+		restriction StayRestriction
+		stay        RestrictedStay
+		wantErr     bool
+	}{
+		// Минимальная длительность
+		{name: "min stay shorter", restriction: minStay,
+			stay: restrictedStay("2025-07-10", 2, 0), wantErr: true},
+		{name: "min stay exact", restriction: minStay,
+			stay: restrictedStay("2025-07-10", 3, 0)},
+		{name: "min stay with only the last night covered", restriction: minStay,
+			stay: restrictedStay("2025-06-30", 2, 0), wantErr: true},
+		{name: "min stay with only the departure covered", restriction: minStay,
+			stay: restrictedStay("2025-06-29", 2, 0)},
+		{name: "min stay on uncovered weekday", restriction: minStaySaturday,
+			stay: restrictedStay("2025-07-02", 2, 0)},
+
+		// Максимальная длительность
+		{name: "max stay longer", restriction: maxStay,
+			stay: restrictedStay("2025-07-10", 8, 0), wantErr: true},
+		{name: "max stay exact", restriction: maxStay,
+			stay: restrictedStay("2025-07-10", 7, 0)},
+		{name: "max stay with the first night covered", restriction: maxStay,
+			stay: restrictedStay("2025-07-31", 8, 0), wantErr: true},
+
+		// Запрет заезда
+		{name: "closed to arrival", restriction: closedToArrival,
+			stay: restrictedStay("2025-07-01", 2, 0), wantErr: true},
+		{name: "closed to arrival, arrival before period", restriction: closedToArrival,
+			stay: restrictedStay("2025-06-30", 5, 0)},
+		{name: "closed to arrival on listed weekday", restriction: closedSaturdayArrival,
+			stay: restrictedStay("2025-07-05", 2, 0), wantErr: true},
+		{name: "closed to arrival on other weekday", restriction: closedSaturdayArrival,
+			stay: restrictedStay("2025-07-04", 2, 0)},
+
+		// Запрет выезда
+		{name: "closed to departure on first day", restriction: closedToDeparture,
+			stay: restrictedStay("2025-06-29", 2, 0), wantErr: true},
+		{name: "closed to departure on last day", restriction: closedToDeparture,
+			stay: restrictedStay("2025-07-29", 2, 0), wantErr: true},
+		{name: "closed to departure, departure after period", restriction: closedToDeparture,
+			stay: restrictedStay("2025-07-30", 2, 0)},
+
+		// Окно бронирования
+		{name: "booked later than min advance", restriction: minAdvance,
+			stay: restrictedStay("2025-07-10", 2, 2), wantErr: true},
+		{name: "booked exactly min advance", restriction: minAdvance,
+			stay: restrictedStay("2025-07-10", 2, 3)},
+		{name: "booked earlier than max advance", restriction: maxAdvance,
+			stay: restrictedStay("2025-07-10", 2, 31), wantErr: true},
+		{name: "booked exactly max advance", restriction: maxAdvance,
+			stay: restrictedStay("2025-07-10", 2, 30)},
+		{name: "advance window with arrival before period", restriction: minAdvance,
+			stay: restrictedStay("2025-06-30", 3, 0)},
+
+		// Тип комнаты
+		{name: "restriction of other room type",
+			restriction: julyRestriction(StayRestriction{ClosedToArrival: true, RoomType: &suite}),
+			stay:        restrictedStay("2025-07-01", 2, 0)},
+		{name: "restriction of same room type",
+			restriction: julyRestriction(StayRestriction{ClosedToArrival: true, RoomType: &deluxe}),
+			stay:        restrictedStay("2025-07-01", 2, 0), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.restriction.Check(deluxe, tt.stay)
+			if tt.wantErr {
+				if !errors.IsInvalidInput(err) {
+					t.Fatalf("Check() error = %v, want invalid input", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Check() unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+// Набор правил отклоняет проживание, если его нарушает хотя бы одно правило
+func TestStayRestrictionsCheck(t *testing.T) {
+	restrictions := StayRestrictions{
+		julyRestriction(StayRestriction{MaxStay: intPtr(14)}),
+		julyRestriction(StayRestriction{MinStay: intPtr(3)}),
+	}
+
+	if err := restrictions.Check(room.RoomType_ROOM_TYPE_DELUXE, restrictedStay("2025-07-10", 3, 0)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := restrictions.Check(room.RoomType_ROOM_TYPE_DELUXE, restrictedStay("2025-07-10", 2, 0)); err == nil {
+		t.Fatal("expected stay shorter than the second rule allows to be rejected")
+	}
+}
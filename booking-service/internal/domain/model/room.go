@@ -13,6 +13,7 @@ type Room struct {
 	Number    string
 	Type      RoomType
 	Price     string
+	Currency  string
 	Capacity  int
 	Status    RoomStatus
 	Amenities []string
@@ -0,0 +1,33 @@
+package model
+
+import (
+	pb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
+)
+
+// Допустимые переходы между статусами брони. Терминальные статусы (CANCELLED, COMPLETED, NO_SHOW) не меняются
+var bookingStatusTransitions = map[BookingStatus][]BookingStatus{
+	pb.BookingStatus_BOOKING_STATUS_PENDING: {
+		pb.BookingStatus_BOOKING_STATUS_CONFIRMED,
+		pb.BookingStatus_BOOKING_STATUS_CANCELLED,
+	},
+	pb.BookingStatus_BOOKING_STATUS_CONFIRMED: {
+		pb.BookingStatus_BOOKING_STATUS_COMPLETED,
+		pb.BookingStatus_BOOKING_STATUS_NO_SHOW,
+		pb.BookingStatus_BOOKING_STATUS_CANCELLED,
+	},
+}
+
+// AllowedTransitions возвращает статусы, в которые можно перейти из текущего
+func AllowedTransitions(from BookingStatus) []BookingStatus {
+	return bookingStatusTransitions[from]
+}
+
+// CanTransition проверяет, допустим ли переход из одного статуса в другой
+func CanTransition(from, to BookingStatus) bool {
+	for _, status := range bookingStatusTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
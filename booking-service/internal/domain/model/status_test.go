@@ -0,0 +1,59 @@
+package model
+
+import (
+	"testing"
+
+	pb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
+)
+
+var allBookingStatuses = []BookingStatus{
+	pb.BookingStatus_BOOKING_STATUS_UNSPECIFIED,
+	pb.BookingStatus_BOOKING_STATUS_PENDING,
+	pb.BookingStatus_BOOKING_STATUS_CONFIRMED,
+	pb.BookingStatus_BOOKING_STATUS_CANCELLED,
+	pb.BookingStatus_BOOKING_STATUS_COMPLETED,
+	pb.BookingStatus_BOOKING_STATUS_NO_SHOW,
+}
+
+// Полная матрица переходов: каждая пара статусов, не перечисленная как допустимая, запрещена
+func TestCanTransition(t *testing.T) {
+	if len(allBookingStatuses) != len(pb.BookingStatus_name) {
+		t.Fatalf("test covers %d statuses, proto defines %d", len(allBookingStatuses), len(pb.BookingStatus_name))
+	}
+
+	allowed := map[BookingStatus]map[BookingStatus]bool{
+		pb.BookingStatus_BOOKING_STATUS_PENDING: {
+			pb.BookingStatus_BOOKING_STATUS_CONFIRMED: true,
+			pb.BookingStatus_BOOKING_STATUS_CANCELLED: true,
+		},
+		pb.BookingStatus_BOOKING_STATUS_CONFIRMED: {
+			pb.BookingStatus_BOOKING_STATUS_COMPLETED: true,
+			pb.BookingStatus_BOOKING_STATUS_NO_SHOW:   true,
+			pb.BookingStatus_BOOKING_STATUS_CANCELLED: true,
+		},
+	}
+
+	for _, from := range allBookingStatuses {
+		for _, to := range allBookingStatuses {
+			t.Run(from.String()+"->"+to.String(), func(t *testing.T) {
+				want := allowed[from][to]
+				if got := CanTransition(from, to); got != want {
+					t.Fatalf("CanTransition(%s, %s) = %v, want %v", from, to, got, want)
+				}
+			})
+		}
+	}
+}
+
+// Из терминальных статусов переходов нет
+func TestAllowedTransitionsFromTerminalStatuses(t *testing.T) {
+	for _, status := range []BookingStatus{
+		pb.BookingStatus_BOOKING_STATUS_CANCELLED,
+		pb.BookingStatus_BOOKING_STATUS_COMPLETED,
+		pb.BookingStatus_BOOKING_STATUS_NO_SHOW,
+	} {
+		if transitions := AllowedTransitions(status); len(transitions) != 0 {
+			t.Errorf("AllowedTransitions(%s) = %v, want none", status, transitions)
+		}
+	}
+}
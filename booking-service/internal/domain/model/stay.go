@@ -0,0 +1,74 @@
+package model
+
+import "time"
+
+// Календарь отеля: проживание считается календарными ночами по времени отеля,
+// заезд и выезд происходят в стандартное время
+type HotelCalendar struct {
+	Location *time.Location
+	// Смещения от полуночи по времени отеля
+	CheckInTime  time.Duration
+	CheckOutTime time.Duration
+}
+
+// Stay переводит даты заезда и выезда в моменты стандартного заезда и выезда по времени отеля
+func (c HotelCalendar) Stay(checkInDate, checkOutDate time.Time) (time.Time, time.Time) {
+	return c.CheckInAt(checkInDate), c.CheckOutAt(checkOutDate)
+}
+
+func (c HotelCalendar) CheckInAt(date time.Time) time.Time {
+	return c.at(date, c.CheckInTime)
+}
+
+func (c HotelCalendar) CheckOutAt(date time.Time) time.Time {
+	return c.at(date, c.CheckOutTime)
+}
+
+// at задает время по часам отеля, чтобы переход на летнее время не сдвигал время заезда и выезда
+func (c HotelCalendar) at(date time.Time, offset time.Duration) time.Time {
+	year, month, day := date.UTC().Date()
+	hour, minute := int(offset/time.Hour), int(offset%time.Hour/time.Minute)
+	return time.Date(year, month, day, hour, minute, 0, 0, c.location())
+}
+
+// FromDate возвращает полночь по времени отеля календарной даты date. Учитывается только дата в UTC:
+// так передаются даты без времени (YYYY-MM-DD)
+func (c HotelCalendar) FromDate(date time.Time) time.Time {
+	year, month, day := date.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, c.location())
+}
+
+// DateOf возвращает полночь даты, на которую приходится момент t по времени отеля
+func (c HotelCalendar) DateOf(t time.Time) time.Time {
+	local := t.In(c.location())
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, c.location())
+}
+
+// Nights возвращает ночи проживания — даты с даты заезда до даты выезда по времени отеля.
+// Проживание внутри одних суток считается за одну ночь
+func (c HotelCalendar) Nights(checkIn, checkOut time.Time) []time.Time {
+	first, last := c.DateOf(checkIn), c.DateOf(checkOut)
+
+	var nights []time.Time
+	for night := first; night.Before(last); night = night.AddDate(0, 0, 1) {
+		nights = append(nights, night)
+	}
+	if len(nights) == 0 && checkOut.After(checkIn) {
+		nights = append(nights, first)
+	}
+	return nights
+}
+
+// Occupies сообщает, занимает ли проживание [checkIn, checkOut) ночь night: гость находится в номере
+// в полночь после нее
+func (c HotelCalendar) Occupies(checkIn, checkOut, night time.Time) bool {
+	midnight := night.AddDate(0, 0, 1)
+	return checkIn.Before(midnight) && !checkOut.Before(midnight)
+}
+
+func (c HotelCalendar) location() *time.Location {
+	if c.Location == nil {
+		return time.UTC
+	}
+	return c.Location
+}
@@ -0,0 +1,81 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/semho/hotel-booking/pkg/money"
+	pb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
+)
+
+// Используем тип из proto напрямую
+type WaitlistStatus = pb.WaitlistStatus
+
+// Запись листа ожидания: интерес гостя к датам, на которые нет свободных комнат
+type WaitlistEntry struct {
+	ID         uuid.UUID `db:"id"`
+	UserID     uuid.UUID `db:"user_id"`
+	GuestName  string    `db:"guest_name"`
+	GuestEmail string    `db:"guest_email"`
+	GuestPhone string    `db:"guest_phone"`
+	CheckIn    time.Time `db:"check_in"`
+	CheckOut   time.Time `db:"check_out"`
+	// Критерии комнаты, nil — любая
+	Capacity *int32         `db:"capacity"`
+	RoomType *RoomType      `db:"room_type"`
+	Status   WaitlistStatus `db:"status"`
+	// PENDING бронь, созданная при освобождении комнаты, и срок ее подтверждения
+	BookingID      *uuid.UUID `db:"booking_id"`
+	OfferExpiresAt *time.Time `db:"offer_expires_at"`
+	CreatedAt      time.Time  `db:"created_at"`
+	UpdatedAt      time.Time  `db:"updated_at"`
+}
+
+// NewBooking формирует бронь для предложения гостю из записи листа ожидания
+func (e *WaitlistEntry) NewBooking() *Booking {
+	userID := e.UserID
+	return &Booking{
+		UserID:     &userID,
+		GuestName:  e.GuestName,
+		GuestEmail: e.GuestEmail,
+		GuestPhone: e.GuestPhone,
+		CheckIn:    e.CheckIn,
+		CheckOut:   e.CheckOut,
+	}
+}
+
+// Фильтр записей листа ожидания, nil-поля не учитываются
+type WaitlistFilter struct {
+	UserID *uuid.UUID
+	Status *WaitlistStatus
+}
+
+// Типы исходящих уведомлений
+const (
+	// Гостю из листа ожидания удержана комната
+	NotificationWaitlistOffer = "waitlist.offer"
+)
+
+// Исходящее уведомление, записывается в outbox в транзакции изменения
+type NotificationEvent struct {
+	ID        uuid.UUID       `db:"id"`
+	Type      string          `db:"event_type"`
+	Payload   json.RawMessage `db:"payload"`
+	CreatedAt time.Time       `db:"created_at"`
+	// Число неудачных попыток отправки
+	Attempts int `db:"attempts"`
+}
+
+// Содержимое уведомления NotificationWaitlistOffer
+type WaitlistOfferPayload struct {
+	EntryID        uuid.UUID   `json:"entry_id"`
+	BookingID      uuid.UUID   `json:"booking_id"`
+	UserID         uuid.UUID   `json:"user_id"`
+	GuestName      string      `json:"guest_name"`
+	GuestEmail     string      `json:"guest_email"`
+	CheckIn        time.Time   `json:"check_in"`
+	CheckOut       time.Time   `json:"check_out"`
+	TotalPrice     money.Money `json:"total_price"`
+	OfferExpiresAt time.Time   `json:"offer_expires_at"`
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/google/uuid"
 	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+	"github.com/shopspring/decimal"
 )
 
 type BookingAPI interface {
@@ -15,7 +16,21 @@ type BookingAPI interface {
 // RoomClient определяет интерфейс для взаимодействия с Room Service
 type RoomClient interface {
 	GetAvailableRooms(ctx context.Context, params model.SearchRoomsParams) ([]model.Room, error)
+	// Комнаты в любом статусе, params.Status не учитывается
+	GetAllRooms(ctx context.Context, params model.SearchRoomsParams) ([]model.Room, error)
 	GetRoomsCount(ctx context.Context, params model.SearchRoomsParams) (int32, error)
 	GetRoomInfo(ctx context.Context, roomID uuid.UUID) (*model.Room, error)
 	GetFirstAvailableRoom(ctx context.Context, params model.SearchRoomsParams) (*model.Room, error)
+	UpdateRoomStatus(ctx context.Context, roomID uuid.UUID, status model.RoomStatus) error
+}
+
+// NotificationSender доставляет уведомления из outbox получателю
+type NotificationSender interface {
+	Send(ctx context.Context, event model.NotificationEvent) error
+}
+
+// ExchangeRateProvider отдает курсы для пересчета цен в другие валюты
+type ExchangeRateProvider interface {
+	// Rate возвращает, сколько единиц валюты to стоит одна единица валюты from
+	Rate(ctx context.Context, from, to string) (decimal.Decimal, error)
 }
@@ -19,12 +19,140 @@ type SQLExecutor interface {
 type BookingRepository interface {
 	GetBookingsForPeriod(ctx context.Context, checkIn, checkOut time.Time) ([]model.Booking, error)
 	Create(ctx context.Context, booking *model.Booking) error
+	// Создание групповой брони (брони комнат создаются через Create с ReservationID).
+	// Возвращает false, если код подтверждения уже занят: транзакция при этом остается рабочей
+	CreateReservation(ctx context.Context, reservation *model.Reservation) (bool, error)
+	// Получение групповой брони вместе с бронями комнат и их текущими статусами
+	GetReservation(ctx context.Context, reservationID uuid.UUID) (*model.Reservation, error)
+	// Резервирование ключа идемпотентности пользователя в текущей транзакции. Если ключ уже использован
+	// и не истек, возвращает сохраненную запись и false
+	ClaimIdempotencyKey(
+		ctx context.Context,
+		key model.IdempotencyKey,
+		expiredBefore time.Time,
+	) (*model.IdempotencyRecord, bool, error)
+	// Сохранение ответа на запрос с ключом идемпотентности
+	SaveIdempotencyResponse(
+		ctx context.Context,
+		key model.IdempotencyKey,
+		bookingID uuid.UUID,
+		response []byte,
+	) error
+	// Число активных броней на каждую из ночей nights (полночи дат по времени отеля) для расчета загрузки отеля
+	CountActiveBookingsByNight(
+		ctx context.Context,
+		nights []time.Time,
+		excludeBookingID uuid.UUID,
+	) (map[string]int, error)
+	// Промокоды
+	CreatePromoCode(ctx context.Context, promo *model.PromoCode) error
+	GetPromoCode(ctx context.Context, id uuid.UUID) (*model.PromoCode, error)
+	// Получение кода по значению, forUpdate блокирует строку до конца транзакции
+	GetPromoCodeByCode(ctx context.Context, code string, forUpdate bool) (*model.PromoCode, error)
+	ListPromoCodes(ctx context.Context, activeOnly bool) ([]model.PromoCode, error)
+	UpdatePromoCode(ctx context.Context, promo *model.PromoCode) error
+	// Удаление кода без погашений, для погашенного кода возвращает конфликт
+	DeletePromoCode(ctx context.Context, id uuid.UUID) error
+	// Число погашений кода пользователем, для анонимных броней — гостем с email
+	CountPromoRedemptions(ctx context.Context, promoCodeID uuid.UUID, userID *uuid.UUID, guestEmail string) (int, error)
+	// Запись погашения с увеличением счетчика кода
+	AddPromoRedemption(ctx context.Context, redemption model.PromoRedemption) error
+	// Освобождение погашения отмененной брони, если оно было
+	ReleasePromoRedemption(ctx context.Context, bookingID uuid.UUID) error
+	// Ограничения продаж
+	CreateStayRestriction(ctx context.Context, restriction *model.StayRestriction) error
+	GetStayRestriction(ctx context.Context, id uuid.UUID) (*model.StayRestriction, error)
+	// Правила в порядке начала периода
+	ListStayRestrictions(ctx context.Context, filter model.StayRestrictionFilter) ([]model.StayRestriction, error)
+	UpdateStayRestriction(ctx context.Context, restriction *model.StayRestriction) error
+	DeleteStayRestriction(ctx context.Context, id uuid.UUID) error
+	// Профили гостей: блокировка поиска и создания профиля по контакту до конца транзакции
+	LockGuestContact(ctx context.Context, contact model.GuestContact) error
+	// Профиль по первому найденному контакту в порядке перечисления, nil — профиля нет
+	FindGuestProfileID(ctx context.Context, contacts []model.GuestContact) (*uuid.UUID, error)
+	CreateGuestProfile(ctx context.Context, profile *model.GuestProfile) error
+	// Привязка контактов, еще не принадлежащих другим профилям
+	AddGuestContacts(ctx context.Context, profileID uuid.UUID, contacts []model.GuestContact) error
+	// Профиль со статистикой проживаний, стоимость — в валюте currency
+	GetGuestProfile(ctx context.Context, id uuid.UUID, currency string) (*model.GuestProfile, error)
+	SearchGuestProfiles(ctx context.Context, search model.GuestProfileSearch, currency string) ([]model.GuestProfile, error)
+	// Блокировка профилей до конца транзакции, возвращает найденные
+	LockGuestProfiles(ctx context.Context, ids []uuid.UUID) ([]uuid.UUID, error)
+	// Замена имени и предпочтений
+	UpdateGuestProfile(ctx context.Context, profile *model.GuestProfile) error
+	// Перенос контактов и броней профилей sourceIDs в targetID с удалением sourceIDs
+	MergeGuestProfiles(ctx context.Context, targetID uuid.UUID, sourceIDs []uuid.UUID) error
+	// Привязка к пользователю анонимных броней, групповых броней и погашений промокодов с нормализованным email,
+	// возвращает привязанные брони
+	ClaimGuestBookings(ctx context.Context, userID uuid.UUID, email string) ([]uuid.UUID, error)
+	// Лист ожидания
+	CreateWaitlistEntry(ctx context.Context, entry *model.WaitlistEntry) error
+	GetWaitlistEntry(ctx context.Context, id uuid.UUID) (*model.WaitlistEntry, error)
+	// Записи в порядке поступления
+	ListWaitlistEntries(ctx context.Context, filter model.WaitlistFilter) ([]model.WaitlistEntry, error)
+	// Ожидающие записи на даты, пересекающиеся с периодом, в порядке поступления
+	FindWaitlistCandidates(ctx context.Context, checkIn, checkOut, now time.Time, limit int) ([]uuid.UUID, error)
+	// Блокировка ожидающей записи, nil — запись уже не ожидает или занята другой транзакцией
+	LockWaitingEntry(ctx context.Context, id uuid.UUID) (*model.WaitlistEntry, error)
+	// Обновление статуса и предложенной брони записи
+	UpdateWaitlistEntry(ctx context.Context, entry *model.WaitlistEntry) error
+	// Закрытие предложения по брони bookingID, если бронь была предложена из листа ожидания
+	CloseWaitlistOffer(ctx context.Context, bookingID uuid.UUID, status model.WaitlistStatus) error
+	// Закрытие ожидающих записей с наступившей датой заезда
+	ExpireWaitlistEntries(ctx context.Context, now time.Time) (int, error)
+	// Запись исходящего уведомления в outbox
+	AddNotificationEvent(ctx context.Context, event *model.NotificationEvent) error
+	// Блокировка неотправленных уведомлений в порядке записи, пропускает исчерпавшие maxAttempts попыток
+	LockPendingNotificationEvents(ctx context.Context, maxAttempts, limit int) ([]model.NotificationEvent, error)
+	// Отметка уведомлений отправленными
+	MarkNotificationEventsPublished(ctx context.Context, ids []uuid.UUID, publishedAt time.Time) error
+	// Неудачная попытка отправки уведомления
+	RecordNotificationEventFailure(ctx context.Context, id uuid.UUID, reason string) error
+	// Овербукинг: блокировка продаж типа комнат до конца транзакции
+	LockRoomTypeInventory(ctx context.Context, roomType model.RoomType) error
+	// Продажи типа на каждую из ночей nights: брони комнат roomIDs и брони типа без комнаты,
+	// capacity оставляет брони без комнаты, которым нужна вместимость не меньше заданной
+	CountRoomTypeBookingsByNight(
+		ctx context.Context,
+		roomType model.RoomType,
+		capacity *int32,
+		roomIDs []uuid.UUID,
+		nights []time.Time,
+		excludeBookingID uuid.UUID,
+	) (map[string]model.RoomTypeNight, error)
+	// Типы комнат активных броней без комнаты с заездом раньше checkInBefore и выездом позже now
+	ListUnassignedRoomTypes(ctx context.Context, checkInBefore, now time.Time) ([]model.RoomType, error)
+	// Блокировка таких броней типа в порядке заезда, занятые другими транзакциями пропускаются
+	LockUnassignedBookings(
+		ctx context.Context,
+		roomType model.RoomType,
+		checkInBefore, now time.Time,
+	) ([]model.Booking, error)
+	// Обновление изменяемых полей брони
+	Update(ctx context.Context, booking *model.Booking) error
 	// Добавление статуса в историю
 	AddBookingStatus(ctx context.Context, bookingID uuid.UUID, status *model.BookingStatusHistory) error
+	// Блокировка строки брони до конца транзакции (SELECT ... FOR UPDATE)
+	LockBooking(ctx context.Context, bookingID uuid.UUID) error
 	// Получение брони с текущим статусом
 	GetBookingWithStatus(ctx context.Context, bookingID uuid.UUID) (*model.BookingWithStatus, error)
+	// Постраничная выборка броней с текущим статусом
+	ListBookings(ctx context.Context, filter model.BookingFilter) ([]model.Booking, error)
 	// Получение истории статусов брони
 	GetBookingStatusHistory(ctx context.Context, bookingID uuid.UUID) ([]model.BookingStatusHistory, error)
+	// Блокировка PENDING броней с истекшим удержанием, занятые другими транзакциями пропускаются
+	LockExpiredPendingBookings(ctx context.Context, now time.Time, limit int) ([]uuid.UUID, error)
+	// Блокировка подтвержденных броней без заселения с заездом раньше checkInBefore
+	LockNoShowCandidates(ctx context.Context, checkInBefore time.Time) ([]uuid.UUID, error)
+	// Блокировка заселенных подтвержденных броней с выездом не позже checkOutBefore
+	LockCompletionCandidates(ctx context.Context, checkOutBefore time.Time) ([]uuid.UUID, error)
+	// Занятые на период комнаты без учета брони bookingID, с блокировкой
+	GetBookedRoomIDsExcept(
+		ctx context.Context,
+		bookingID uuid.UUID,
+		roomIDs []uuid.UUID,
+		checkIn, checkOut time.Time,
+	) ([]uuid.UUID, error)
 	GetBookedRoomIDs(ctx context.Context, roomIDs []uuid.UUID, checkIn, checkOut time.Time, forUpdate bool) (
 		[]uuid.UUID,
 		error,
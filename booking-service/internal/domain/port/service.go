@@ -4,8 +4,10 @@ import (
 	"context"
 	"github.com/google/uuid"
 	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+	"github.com/semho/hotel-booking/pkg/money"
 	pb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
 	"github.com/semho/hotel-booking/pkg/proto/room_v1/room"
+	"time"
 )
 
 type BookingUnitOfWork interface {
@@ -14,15 +16,117 @@ type BookingUnitOfWork interface {
 
 type BookingService interface {
 	GetAvailableRooms(ctx context.Context, params model.SearchParams, rooms []model.Room) ([]model.Room, error)
-	// Создание брони со статусом
-	CreateBooking(ctx context.Context, booking *model.Booking, roomType room.RoomType, roomCapacity int32) error
+	// Создание брони со статусом. При заданном idempotencyKey повторный запрос с тем же ключом
+	// возвращает исходную бронь, а запрос с другим содержимым — конфликт
+	CreateBooking(
+		ctx context.Context,
+		booking *model.Booking,
+		roomType room.RoomType,
+		roomCapacity int32,
+		idempotencyKey *model.IdempotencyKey,
+	) error
+
+	// Групповая бронь нескольких комнат на одни даты: все комнаты бронируются атомарно
+	CreateReservation(
+		ctx context.Context,
+		reservation *model.Reservation,
+		checkIn, checkOut time.Time,
+		rooms []model.ReservationRoom,
+	) error
+	// Групповая бронь с бронями комнат
+	GetReservation(ctx context.Context, reservationID uuid.UUID) (*model.Reservation, error)
 
-	// Обновление статуса брони
+	// Обновление статуса брони с проверкой допустимости перехода
 	UpdateBookingStatus(
 		ctx context.Context,
 		bookingID uuid.UUID,
 		status pb.BookingStatus,
 		reason string,
 		changedBy string,
-	) error
+	) (*model.Booking, error)
+
+	// Пересчет суммы в валюту currency по текущему курсу, валюта расчетов брони при этом не меняется
+	ConvertPrice(ctx context.Context, amount money.Money, currency string) (money.Money, error)
+
+	// Получение брони с текущим статусом
+	GetBooking(ctx context.Context, bookingID uuid.UUID) (*model.Booking, error)
+	// Постраничный список броней, возвращает курсор следующей страницы или nil
+	ListBookings(ctx context.Context, filter model.BookingFilter) ([]model.Booking, *model.BookingCursor, error)
+	// История статусов брони
+	GetBookingHistory(ctx context.Context, bookingID uuid.UUID) ([]model.BookingStatusHistory, error)
+	// Изменение дат, типа комнаты или данных гостя с повторной проверкой доступности и пересчетом стоимости
+	ModifyBooking(
+		ctx context.Context,
+		bookingID uuid.UUID,
+		changes model.BookingChanges,
+		changedBy string,
+	) (*model.Booking, error)
+	// Расчет штрафа и возврата при отмене брони по политике типа комнаты, без изменения брони
+	GetCancellationQuote(ctx context.Context, bookingID uuid.UUID) (*model.CancellationQuote, error)
+	// Отмена брони с применением политики отмены, штраф и возврат сохраняются в брони
+	CancelBooking(
+		ctx context.Context,
+		bookingID uuid.UUID,
+		reason string,
+		changedBy string,
+	) (*model.Booking, *model.CancellationQuote, error)
+	// Заселение гостя: фиксирует время заезда и комнату, roomID позволяет переселить гостя в другую свободную комнату
+	CheckIn(ctx context.Context, bookingID uuid.UUID, roomID *uuid.UUID, changedBy string) (*model.Booking, error)
+	// Выезд гостя: фиксирует время выезда, при раннем выезде сокращает проживание и пересчитывает стоимость
+	CheckOut(ctx context.Context, bookingID uuid.UUID, changedBy string) (*model.Booking, error)
+	// Отмена PENDING броней с истекшим сроком удержания, возвращает количество отмененных
+	ExpirePendingBookings(ctx context.Context, limit int) (int, error)
+	// Отправка до limit уведомлений из outbox, возвращает количество отправленных
+	PublishNotificationEvents(ctx context.Context, limit int) (int, error)
+	// Перевод прошедших броней в NO_SHOW и COMPLETED, возвращает выполненные (или планируемые в dry-run) переходы
+	RunNightlyTransitions(ctx context.Context, run model.NightlyRun) ([]model.StatusTransition, error)
+
+	// Управление промокодами
+	CreatePromoCode(ctx context.Context, promo *model.PromoCode) error
+	GetPromoCode(ctx context.Context, id uuid.UUID) (*model.PromoCode, error)
+	ListPromoCodes(ctx context.Context, activeOnly bool) ([]model.PromoCode, error)
+	// Полная замена условий промокода, сам код не меняется
+	UpdatePromoCode(ctx context.Context, promo *model.PromoCode) error
+	DeletePromoCode(ctx context.Context, id uuid.UUID) error
+
+	// Управление ограничениями продаж
+	CreateStayRestriction(ctx context.Context, restriction *model.StayRestriction) error
+	GetStayRestriction(ctx context.Context, id uuid.UUID) (*model.StayRestriction, error)
+	ListStayRestrictions(ctx context.Context, filter model.StayRestrictionFilter) ([]model.StayRestriction, error)
+	// Полная замена условий ограничения
+	UpdateStayRestriction(ctx context.Context, restriction *model.StayRestriction) error
+	DeleteStayRestriction(ctx context.Context, id uuid.UUID) error
+
+	// Профиль гостя со статистикой проживаний
+	GetGuestProfile(ctx context.Context, id uuid.UUID) (*model.GuestProfile, error)
+	// Поиск профилей по части имени, email или телефона
+	SearchGuestProfiles(ctx context.Context, search model.GuestProfileSearch) ([]model.GuestProfile, error)
+	// Замена имени и предпочтений гостя
+	UpdateGuestProfile(ctx context.Context, profile *model.GuestProfile) (*model.GuestProfile, error)
+	// Объединение дублей: контакты, брони и незаполненные предпочтения переходят в профиль targetID
+	MergeGuestProfiles(ctx context.Context, targetID uuid.UUID, sourceIDs []uuid.UUID) (*model.GuestProfile, error)
+	// Привязка анонимных броней с подтвержденным email к пользователю
+	ClaimGuestBookings(ctx context.Context, userID uuid.UUID, email string) ([]uuid.UUID, error)
+
+	// Запись в лист ожидания; если подходящая комната уже свободна, гостю сразу предлагается бронь
+	JoinWaitlist(ctx context.Context, entry *model.WaitlistEntry) error
+	GetWaitlistEntry(ctx context.Context, id uuid.UUID) (*model.WaitlistEntry, error)
+	ListWaitlistEntries(ctx context.Context, filter model.WaitlistFilter) ([]model.WaitlistEntry, error)
+	// Выход из листа ожидания, доступен только для ожидающей записи
+	LeaveWaitlist(ctx context.Context, id uuid.UUID) (*model.WaitlistEntry, error)
+
+	// Назначение комнат броням без комнаты с заездом в ближайшие дни, now — момент прогона
+	AssignRooms(ctx context.Context, now time.Time) (*model.RoomAssignmentRun, error)
+	// Ручное назначение комнаты брони стойкой регистрации, в том числе замена уже назначенной
+	AssignRoom(ctx context.Context, bookingID, roomID uuid.UUID, changedBy string) (*model.Booking, error)
+
+	// Ночи периода [from, to), на которые броней типа продано больше, чем есть комнат
+	GetOversoldNights(ctx context.Context, from, to time.Time) ([]model.OversoldNight, error)
+	// Состояние комнат или типов комнат на каждую ночь периода
+	GetAvailabilityCalendar(ctx context.Context, params model.AvailabilityCalendarParams) ([]model.CalendarRow, error)
+}
+
+// Ручной запуск ночных переходов статусов на момент now
+type NightlyRunner interface {
+	RunOnce(ctx context.Context, now time.Time, dryRun bool) ([]model.StatusTransition, error)
 }
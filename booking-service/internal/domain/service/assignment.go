@@ -0,0 +1,378 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+	"github.com/semho/hotel-booking/pkg/errors"
+	"github.com/semho/hotel-booking/pkg/logger"
+	pb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
+)
+
+const (
+	// За сколько дней до заезда назначается комната, если не задано в конфиге
+	defaultAssignmentDaysBefore = 2
+
+	// Промежутки между бронями до стольких ночей почти не продаются
+	orphanGapNights = 2
+	// Стоимость промежутков при выборе комнаты: вплотную к соседней брони, короткий непродаваемый,
+	// длинный или без соседней брони
+	adjacentGapCost = 0
+	orphanGapCost   = 10
+	openGapCost     = 1
+
+	roomAssignedReason = "room assigned"
+)
+
+// AssignRooms назначает комнаты броням без комнаты с заездом в ближайшие assignmentDaysBefore дней.
+// Каждый тип распределяется в своей транзакции, уже назначенные комнаты не меняются
+func (s *bookingService) AssignRooms(ctx context.Context, now time.Time) (*model.RoomAssignmentRun, error) {
+	checkInBefore := now.AddDate(0, 0, s.assignmentDaysBefore)
+
+	roomTypes, err := s.bookingRepo.ListUnassignedRoomTypes(ctx, checkInBefore, now)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &model.RoomAssignmentRun{}
+	for _, roomType := range roomTypes {
+		var typeRun *model.RoomAssignmentRun
+		err = s.uow.WithinTransaction(
+			ctx, func(txCtx context.Context) error {
+				typeRun, err = s.assignRoomType(txCtx, roomType, checkInBefore, now)
+				return err
+			},
+		)
+		if err != nil {
+			return run, fmt.Errorf("failed to assign %s rooms: %w", roomType, err)
+		}
+
+		run.Assigned += typeRun.Assigned
+		run.Unplaced = append(run.Unplaced, typeRun.Unplaced...)
+	}
+
+	return run, nil
+}
+
+// assignRoomType распределяет брони типа без комнаты по комнатам типа, кроме находящихся в ремонте
+func (s *bookingService) assignRoomType(
+	txCtx context.Context,
+	roomType model.RoomType,
+	checkInBefore, now time.Time,
+) (*model.RoomAssignmentRun, error) {
+	// Пока идет распределение, продажи и ручные назначения комнат типа ждут
+	if err := s.bookingRepo.LockRoomTypeInventory(txCtx, roomType); err != nil {
+		return nil, err
+	}
+
+	pending, err := s.bookingRepo.LockUnassignedBookings(txCtx, roomType, checkInBefore, now)
+	if err != nil {
+		return nil, err
+	}
+	run := &model.RoomAssignmentRun{}
+	if len(pending) == 0 {
+		return run, nil
+	}
+
+	rooms, err := s.getSellableRooms(txCtx, model.SearchRoomsParams{Type: &roomType})
+	if err != nil {
+		return nil, err
+	}
+
+	// Соседние брони за границами периода тоже нужны, чтобы оценить промежутки до и после
+	from, to := pending[0].CheckIn, pending[0].CheckOut
+	for _, booking := range pending {
+		if booking.CheckIn.Before(from) {
+			from = booking.CheckIn
+		}
+		if booking.CheckOut.After(to) {
+			to = booking.CheckOut
+		}
+	}
+	margin := (orphanGapNights + 1) * 24 * time.Hour
+	occupied, err := s.bookingRepo.GetBookingsForPeriod(txCtx, from.Add(-margin), to.Add(margin))
+	if err != nil {
+		return nil, err
+	}
+
+	schedules, err := newRoomSchedules(rooms, occupied)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range pending {
+		booking := &pending[i]
+		schedule := bestRoomSchedule(schedules, booking)
+		if schedule == nil {
+			run.Unplaced = append(run.Unplaced, booking.ID)
+			logger.Log.Warn(
+				"no single room is free for the whole stay",
+				"booking_id", booking.ID,
+				"room_type", roomType,
+				"check_in", booking.CheckIn,
+			)
+			continue
+		}
+
+		if booking.RoomID, err = uuid.Parse(schedule.room.ID); err != nil {
+			return nil, err
+		}
+		if err = s.bookingRepo.Update(txCtx, booking); err != nil {
+			return nil, err
+		}
+
+		// Статус не меняется, запись в истории фиксирует назначение комнаты
+		statusHistory := &model.BookingStatusHistory{
+			BookingID: booking.ID,
+			Status:    booking.CurrentStatus.Status,
+			Reason:    fmt.Sprintf("%s: %s", roomAssignedReason, schedule.room.Number),
+			ChangedBy: systemActor,
+		}
+		if err = s.bookingRepo.AddBookingStatus(txCtx, booking.ID, statusHistory); err != nil {
+			return nil, fmt.Errorf("failed to record room assignment: %w", err)
+		}
+
+		schedule.add(booking.CheckIn, booking.CheckOut)
+		run.Assigned++
+	}
+
+	return run, nil
+}
+
+func (s *bookingService) AssignRoom(
+	ctx context.Context,
+	bookingID, roomID uuid.UUID,
+	changedBy string,
+) (*model.Booking, error) {
+	if changedBy == "" {
+		return nil, errors.WithMessage(errors.ErrInvalidInput, "changed_by is required")
+	}
+
+	var booking *model.Booking
+	err := s.uow.WithinTransaction(
+		ctx, func(txCtx context.Context) error {
+			if err := s.bookingRepo.LockBooking(txCtx, bookingID); err != nil {
+				return err
+			}
+
+			current, err := s.GetBooking(txCtx, bookingID)
+			if err != nil {
+				return err
+			}
+
+			status := current.CurrentStatus.Status
+			if status != pb.BookingStatus_BOOKING_STATUS_PENDING && status != pb.BookingStatus_BOOKING_STATUS_CONFIRMED {
+				return errors.WithMessage(
+					errors.ErrConflict,
+					fmt.Sprintf("room cannot be assigned to booking in status %s", status),
+				)
+			}
+			if current.CheckedInAt != nil {
+				return errors.WithMessage(errors.ErrConflict, "guest has already checked in")
+			}
+			if current.RoomID == roomID {
+				return errors.WithMessage(errors.ErrInvalidInput, "room is already assigned to the booking")
+			}
+
+			assignedRoom, err := s.roomClient.GetRoomInfo(txCtx, roomID)
+			if err != nil {
+				return err
+			}
+			if assignedRoom == nil {
+				return errors.WithMessage(errors.ErrNotFound, "room not found")
+			}
+			if isRoomOutOfOrder(assignedRoom.Status) {
+				return errors.WithMessage(errors.ErrConflict, "room is out of order")
+			}
+
+			// Комната не должна быть нужна броням своего типа, ожидающим назначения
+			err = s.checkRoomTypeAvailability(
+				txCtx,
+				assignedRoom.Type,
+				int32(assignedRoom.Capacity),
+				current.CheckIn,
+				current.CheckOut,
+				current.ID,
+			)
+			if err != nil {
+				return err
+			}
+
+			bookedRoomIDs, err := s.bookingRepo.GetBookedRoomIDsExcept(
+				txCtx,
+				current.ID,
+				[]uuid.UUID{roomID},
+				current.CheckIn,
+				current.CheckOut,
+			)
+			if err != nil {
+				return err
+			}
+			if len(bookedRoomIDs) > 0 {
+				return errors.WithMessage(errors.ErrConflict, "selected room is booked for these dates")
+			}
+
+			var previousRoom *model.Room
+			if current.IsRoomAssigned() {
+				if previousRoom, err = s.roomClient.GetRoomInfo(txCtx, current.RoomID); err != nil {
+					return err
+				}
+			}
+
+			current.RoomID = roomID
+			if err = s.bookingRepo.Update(txCtx, current); err != nil {
+				return err
+			}
+
+			statusHistory := &model.BookingStatusHistory{
+				BookingID: bookingID,
+				Status:    status,
+				Reason: fmt.Sprintf(
+					"%s manually: %s -> %s",
+					roomAssignedReason,
+					roomLabel(previousRoom),
+					assignedRoom.Number,
+				),
+				ChangedBy: changedBy,
+			}
+			if err = s.bookingRepo.AddBookingStatus(txCtx, bookingID, statusHistory); err != nil {
+				return fmt.Errorf("failed to record room assignment: %w", err)
+			}
+
+			current.CurrentStatus = statusHistory
+			booking = current
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return booking, nil
+}
+
+// Период проживания в комнате
+type roomStay struct {
+	checkIn  time.Time
+	checkOut time.Time
+}
+
+// Занятость комнаты, проживания отсортированы по дате заезда
+type roomSchedule struct {
+	room  *model.Room
+	stays []roomStay
+}
+
+// newRoomSchedules раскладывает активные брони по комнатам; брони других комнат не учитываются
+func newRoomSchedules(rooms []model.Room, bookings []model.Booking) ([]*roomSchedule, error) {
+	schedules := make([]*roomSchedule, len(rooms))
+	byID := make(map[uuid.UUID]*roomSchedule, len(rooms))
+	for i := range rooms {
+		id, err := uuid.Parse(rooms[i].ID)
+		if err != nil {
+			return nil, err
+		}
+		schedules[i] = &roomSchedule{room: &rooms[i]}
+		byID[id] = schedules[i]
+	}
+
+	for _, booking := range bookings {
+		if schedule, ok := byID[booking.RoomID]; ok {
+			schedule.add(booking.CheckIn, booking.CheckOut)
+		}
+	}
+	return schedules, nil
+}
+
+func (r *roomSchedule) add(checkIn, checkOut time.Time) {
+	r.stays = append(r.stays, roomStay{checkIn: checkIn, checkOut: checkOut})
+	sort.Slice(
+		r.stays, func(i, j int) bool {
+			return r.stays[i].checkIn.Before(r.stays[j].checkIn)
+		},
+	)
+}
+
+// placementCost оценивает размещение проживания в комнате по промежуткам, которые останутся до и после него.
+// ok равен false, если комната занята на часть периода
+func (r *roomSchedule) placementCost(checkIn, checkOut time.Time) (cost int, gapBefore int, ok bool) {
+	var previous, next *roomStay
+	for i := range r.stays {
+		stay := &r.stays[i]
+		if stay.checkIn.Before(checkOut) && stay.checkOut.After(checkIn) {
+			return 0, 0, false
+		}
+		if !stay.checkOut.After(checkIn) {
+			previous = stay
+		}
+		if next == nil && !stay.checkIn.Before(checkOut) {
+			next = stay
+		}
+	}
+
+	gapBefore = math.MaxInt
+	cost = openGapCost
+	if previous != nil {
+		gapBefore = nightsBetween(previous.checkOut, checkIn)
+		cost = gapCost(gapBefore)
+	}
+	if next != nil {
+		cost += gapCost(nightsBetween(checkOut, next.checkIn))
+	} else {
+		cost += openGapCost
+	}
+	return cost, gapBefore, true
+}
+
+func gapCost(nights int) int {
+	switch {
+	case nights <= 0:
+		return adjacentGapCost
+	case nights <= orphanGapNights:
+		return orphanGapCost
+	default:
+		return openGapCost
+	}
+}
+
+func nightsBetween(from, to time.Time) int {
+	return int(math.Round(to.Sub(from).Hours() / 24))
+}
+
+// bestRoomSchedule выбирает комнату, свободную на весь период брони (без переселений), так, чтобы не оставлять
+// непродаваемых коротких промежутков: сначала по стоимости промежутков, затем по точности вместимости
+// и плотности примыкания к предыдущей брони. nil — такой комнаты нет
+func bestRoomSchedule(schedules []*roomSchedule, booking *model.Booking) *roomSchedule {
+	var (
+		best                                     *roomSchedule
+		bestCost, bestCapacityExcess, bestBefore int
+	)
+	for _, schedule := range schedules {
+		capacityExcess := 0
+		if booking.Capacity != nil {
+			capacityExcess = schedule.room.Capacity - int(*booking.Capacity)
+			if capacityExcess < 0 {
+				continue
+			}
+		}
+
+		cost, gapBefore, ok := schedule.placementCost(booking.CheckIn, booking.CheckOut)
+		if !ok {
+			continue
+		}
+
+		better := best == nil ||
+			cost < bestCost ||
+			cost == bestCost && capacityExcess < bestCapacityExcess ||
+			cost == bestCost && capacityExcess == bestCapacityExcess && gapBefore < bestBefore
+		if better {
+			best, bestCost, bestCapacityExcess, bestBefore = schedule, cost, capacityExcess, gapBefore
+		}
+	}
+	return best
+}
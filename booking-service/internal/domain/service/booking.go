@@ -2,10 +2,10 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/google/uuid"
 	"github.com/semho/hotel-booking/pkg/proto/room_v1/room"
-	"github.com/shopspring/decimal"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"math"
 	"strings"
@@ -14,24 +14,114 @@ import (
 	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
 	"github.com/semho/hotel-booking/booking-service/internal/domain/port"
 	"github.com/semho/hotel-booking/pkg/errors"
+	"github.com/semho/hotel-booking/pkg/logger"
+	"github.com/semho/hotel-booking/pkg/money"
 	pb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
 )
 
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+
+	// Срок удержания PENDING брони, если не задан в конфиге
+	defaultHoldTTL = 15 * time.Minute
+	// Валюта расчетов, если не задана в конфиге
+	defaultCurrency = "RUB"
+	// Срок хранения ключа идемпотентности, если не задан в конфиге
+	defaultIdempotencyTTL = 24 * time.Hour
+
+	systemActor       = "system"
+	holdExpiredReason = "hold expired"
+	noShowReason      = "guest did not check in"
+	completedReason   = "stay completed"
+	cancelledReason   = "booking cancelled"
+)
+
+// Настройки сервиса бронирования из конфига
+type Settings struct {
+	// Срок удержания PENDING брони
+	HoldTTL time.Duration
+	// Политики отмены по типам комнат
+	CancellationPolicies model.CancellationPolicies
+	// Тарифные планы по типам комнат
+	RatePlans model.RatePlans
+	// Валюта расчетов отеля (ISO 4217)
+	Currency string
+	// Сколько хранится ключ идемпотентности создания брони
+	IdempotencyTTL time.Duration
+	// Курсы валют для цен комнат в другой валюте и пересчета стоимости; nil — пересчет недоступен
+	ExchangeRates port.ExchangeRateProvider
+	// Срок подтверждения брони, предложенной гостю из листа ожидания
+	WaitlistHoldTTL time.Duration
+	// Допустимые продажи сверх числа комнат по типам
+	Overbooking model.OverbookingLimits
+	// За сколько дней до заезда броням назначаются комнаты
+	AssignmentDaysBefore int
+	// Часовой пояс отеля и стандартное время заезда и выезда
+	Calendar model.HotelCalendar
+	// Доставка уведомлений из outbox; nil — уведомления копятся в outbox неотправленными
+	Notifications port.NotificationSender
+}
+
 type bookingService struct {
-	uow         port.BookingUnitOfWork
-	bookingRepo port.BookingRepository
-	roomClient  port.RoomClient
+	uow                  port.BookingUnitOfWork
+	bookingRepo          port.BookingRepository
+	roomClient           port.RoomClient
+	holdTTL              time.Duration
+	cancellationPolicies model.CancellationPolicies
+	ratePlans            model.RatePlans
+	currency             string
+	idempotencyTTL       time.Duration
+	exchangeRates        port.ExchangeRateProvider
+	waitlistHoldTTL      time.Duration
+	overbooking          model.OverbookingLimits
+	assignmentDaysBefore int
+	calendar             model.HotelCalendar
+	notifications        port.NotificationSender
 }
 
 func NewBookingService(
 	bookingRepo port.BookingRepository,
 	uow port.BookingUnitOfWork,
 	roomClient port.RoomClient,
+	settings Settings,
 ) port.BookingService {
+	holdTTL := settings.HoldTTL
+	if holdTTL <= 0 {
+		holdTTL = defaultHoldTTL
+	}
+	currency := settings.Currency
+	if currency == "" {
+		currency = defaultCurrency
+	}
+	idempotencyTTL := settings.IdempotencyTTL
+	if idempotencyTTL <= 0 {
+		idempotencyTTL = defaultIdempotencyTTL
+	}
+	waitlistHoldTTL := settings.WaitlistHoldTTL
+	if waitlistHoldTTL <= 0 {
+		waitlistHoldTTL = defaultWaitlistHoldTTL
+	}
+	assignmentDaysBefore := settings.AssignmentDaysBefore
+	if assignmentDaysBefore <= 0 {
+		assignmentDaysBefore = defaultAssignmentDaysBefore
+	}
+
 	return &bookingService{
-		uow:         uow,
-		bookingRepo: bookingRepo,
-		roomClient:  roomClient,
+		uow:                  uow,
+		bookingRepo:          bookingRepo,
+		roomClient:           roomClient,
+		holdTTL:              holdTTL,
+		cancellationPolicies: settings.CancellationPolicies,
+		ratePlans:            settings.RatePlans,
+		currency:             currency,
+		idempotencyTTL:       idempotencyTTL,
+		exchangeRates:        settings.ExchangeRates,
+		waitlistHoldTTL:      waitlistHoldTTL,
+		overbooking:          settings.Overbooking,
+		assignmentDaysBefore: assignmentDaysBefore,
+		calendar:             settings.Calendar,
+		notifications:        settings.Notifications,
 	}
 }
 
@@ -57,6 +147,8 @@ func (s *bookingService) GetAvailableRooms(
 	params model.SearchParams,
 	rooms []model.Room,
 ) ([]model.Room, error) {
+	params.CheckIn, params.CheckOut = s.calendar.Stay(params.CheckIn, params.CheckOut)
+
 	// Получаем бронирования на период
 	bookings, err := s.bookingRepo.GetBookingsForPeriod(
 		ctx,
@@ -67,7 +159,35 @@ func (s *bookingService) GetAvailableRooms(
 		return nil, err
 	}
 
-	return s.filterAvailableRooms(rooms, bookings), nil
+	available, err := s.limitToUnsoldRooms(ctx, s.filterAvailableRooms(rooms, bookings), params)
+	if err != nil {
+		return nil, err
+	}
+	available, err = s.filterRestrictedRooms(ctx, available, params.CheckIn, params.CheckOut)
+	if err != nil {
+		return nil, err
+	}
+	if params.Currency == nil {
+		return available, nil
+	}
+
+	for i, room := range available {
+		currency := room.Currency
+		if currency == "" {
+			currency = s.currency
+		}
+		price, err := money.Parse(room.Price, currency)
+		if err != nil {
+			return nil, err
+		}
+		converted, err := s.ConvertPrice(ctx, price, *params.Currency)
+		if err != nil {
+			return nil, err
+		}
+		available[i].Price = converted.String()
+		available[i].Currency = converted.Currency
+	}
+	return available, nil
 }
 
 func (s *bookingService) filterAvailableRooms(rooms []model.Room, bookings []model.Booking) []model.Room {
@@ -86,160 +206,1121 @@ func (s *bookingService) filterAvailableRooms(rooms []model.Room, bookings []mod
 	return availableRooms
 }
 
-func (s *bookingService) calculateTotalPrice(room *model.Room, checkIn, checkOut time.Time) (float64, error) {
-	price, err := decimal.NewFromString(room.Price)
+func (s *bookingService) CreateBooking(
+	ctx context.Context,
+	booking *model.Booking,
+	roomType room.RoomType,
+	roomCapacity int32,
+	idempotencyKey *model.IdempotencyKey,
+) error {
+	booking.CheckIn, booking.CheckOut = s.calendar.Stay(booking.CheckIn, booking.CheckOut)
+
+	return s.uow.WithinTransaction(
+		ctx, func(txCtx context.Context) error {
+			if idempotencyKey == nil {
+				return s.createBookingInTx(txCtx, booking, roomType, roomCapacity, s.holdTTL)
+			}
+
+			// Ключ занимается в той же транзакции, что и бронь: если создание брони не удалось,
+			// ключ освобождается вместе с откатом и запрос можно повторить
+			record, claimed, err := s.bookingRepo.ClaimIdempotencyKey(
+				txCtx,
+				*idempotencyKey,
+				time.Now().Add(-s.idempotencyTTL),
+			)
+			if err != nil {
+				return err
+			}
+			if !claimed {
+				return replayIdempotentBooking(record, idempotencyKey.RequestHash, booking)
+			}
+
+			if err := s.createBookingInTx(txCtx, booking, roomType, roomCapacity, s.holdTTL); err != nil {
+				return err
+			}
+
+			response, err := json.Marshal(booking)
+			if err != nil {
+				return fmt.Errorf("failed to marshal idempotent response: %w", err)
+			}
+			return s.bookingRepo.SaveIdempotencyResponse(txCtx, *idempotencyKey, booking.ID, response)
+		},
+	)
+}
+
+// replayIdempotentBooking восстанавливает бронь из ответа на исходный запрос с тем же ключом
+func replayIdempotentBooking(record *model.IdempotencyRecord, requestHash string, booking *model.Booking) error {
+	if record.RequestHash != requestHash {
+		return errors.WithMessage(
+			errors.ErrConflict,
+			"idempotency key has already been used with a different request",
+		)
+	}
+	if len(record.Response) == 0 {
+		return errors.WithMessage(errors.ErrConflict, "request with this idempotency key is still in progress")
+	}
+
+	if err := json.Unmarshal(record.Response, booking); err != nil {
+		return fmt.Errorf("failed to unmarshal idempotent response: %w", err)
+	}
+
+	logger.Log.Info("idempotent booking request replayed", "key", record.Key, "booking_id", booking.ID)
+	return nil
+}
+
+// createBookingInTx выбирает комнату и создает бронь со статусом PENDING, удерживаемую holdTTL,
+// в уже открытой транзакции
+func (s *bookingService) createBookingInTx(
+	txCtx context.Context,
+	booking *model.Booking,
+	roomType room.RoomType,
+	roomCapacity int32,
+	holdTTL time.Duration,
+) error {
+	// Валидация входных данных
+	if err := s.validateBooking(
+		booking.GuestName,
+		booking.GuestEmail,
+		timestamppb.New(booking.CheckIn),
+		timestamppb.New(booking.CheckOut),
+	); err != nil {
+		return err
+	}
+
+	// 1. Выбираем комнату, если гость ее указал, иначе бронируем тип комнаты: конкретная комната
+	// назначается распределением перед заездом
+	var (
+		selectedRoom *model.Room
+		deferred     bool
+		err          error
+	)
+	if booking.RoomID != uuid.Nil {
+		selectedRoom, err = s.selectRequestedRoom(txCtx, booking, roomType, roomCapacity)
+		if err == nil {
+			err = s.checkRoomTypeAvailability(
+				txCtx,
+				selectedRoom.Type,
+				int32(selectedRoom.Capacity),
+				booking.CheckIn,
+				booking.CheckOut,
+				uuid.Nil,
+			)
+		}
+	} else {
+		selectedRoom, err = s.reserveRoomType(txCtx, booking, roomType, roomCapacity, uuid.Nil)
+		deferred = true
+	}
 	if err != nil {
-		return 0, fmt.Errorf("failed to parse room price: %w", err)
+		return err
+	}
+	if err = s.checkStayRestrictions(txCtx, selectedRoom.Type, booking.CheckIn, booking.CheckOut); err != nil {
+		return err
+	}
+
+	// 2. Рассчитываем стоимость по тарифному плану
+	breakdown, totalPrice, err := s.priceStay(txCtx, selectedRoom, booking.CheckIn, booking.CheckOut, uuid.Nil)
+	if err != nil {
+		return err
+	}
+
+	// 3. Заполняем оставшиеся поля бронирования
+	booking.RoomID = uuid.Nil
+	if !deferred {
+		booking.RoomID, err = uuid.Parse(selectedRoom.ID)
+		if err != nil {
+			return err
+		}
 	}
+	bookedType := selectedRoom.Type
+	booking.RoomType = &bookedType
+	if roomCapacity > 0 {
+		booking.Capacity = &roomCapacity
+	}
+	booking.PriceBreakdown = breakdown
+	booking.TotalPrice = totalPrice
+	holdExpiresAt := time.Now().Add(holdTTL)
+	booking.HoldExpiresAt = &holdExpiresAt
 
-	// Вычисляем количество ночей проживания (округляем вверх для неполных суток)
-	nights := decimal.NewFromInt(int64(math.Ceil(checkOut.Sub(checkIn).Hours() / 24)))
-	// Рассчитываем полную стоимость проживания (базовая цена * количество ночей)
-	totalPrice := price.Mul(nights)
+	// Скидка по промокоду попадает в разбивку стоимости
+	var promo *model.PromoCode
+	if booking.PromoCode != nil {
+		if promo, err = s.applyPromoCode(txCtx, booking, selectedRoom.Type); err != nil {
+			return err
+		}
+	}
 
-	res, ok := totalPrice.Float64()
-	if !ok {
-		return 0, fmt.Errorf("failed to convert total price to float64")
+	// 4. Создаем бронь, связанную с профилем гостя
+	if err = s.resolveGuestProfile(txCtx, booking); err != nil {
+		return err
+	}
+	if err = s.bookingRepo.Create(txCtx, booking); err != nil {
+		return err
 	}
-	return res, nil
+	if promo != nil {
+		if err = s.redeemPromoCode(txCtx, booking, promo); err != nil {
+			return err
+		}
+	}
+
+	// 5. Создаем начальный статус PENDING
+	statusHistory := &model.BookingStatusHistory{
+		BookingID: booking.ID,
+		Status:    pb.BookingStatus_BOOKING_STATUS_PENDING,
+		ChangedBy: systemActor,
+		Reason:    "Initial booking creation",
+	}
+
+	booking.CurrentStatus = statusHistory
+
+	return s.bookingRepo.AddBookingStatus(txCtx, booking.ID, statusHistory)
 }
 
-func (s *bookingService) CreateBooking(
-	ctx context.Context,
+// selectRequestedRoom проверяет, что выбранная гостем комната подходит и свободна на даты брони
+func (s *bookingService) selectRequestedRoom(
+	txCtx context.Context,
 	booking *model.Booking,
 	roomType room.RoomType,
 	roomCapacity int32,
-) error {
-	return s.uow.WithinTransaction(
+) (*model.Room, error) {
+	// 1. Получаем комнату из room-service
+	selectedRoom, err := s.roomClient.GetRoomInfo(txCtx, booking.RoomID)
+	if err != nil {
+		return nil, err
+	}
+	if selectedRoom == nil {
+		return nil, errors.WithMessage(errors.ErrNotFound, "room not found")
+	}
+
+	// 2. Комната должна соответствовать переданным критериям и быть готова к заселению
+	if roomType != room.RoomType_ROOM_TYPE_UNSPECIFIED && selectedRoom.Type != roomType {
+		return nil, errors.WithMessage(errors.ErrInvalidInput, "selected room does not match requested type")
+	}
+	if roomCapacity > 0 && int32(selectedRoom.Capacity) != roomCapacity {
+		return nil, errors.WithMessage(errors.ErrInvalidInput, "selected room does not match requested capacity")
+	}
+	if selectedRoom.Status != room.RoomStatus_ROOM_STATUS_AVAILABLE {
+		return nil, errors.WithMessage(errors.ErrConflict, "selected room is not available for booking")
+	}
+
+	// 3. Проверяем пересечение с активными бронями
+	bookedRoomIDs, err := s.bookingRepo.GetBookedRoomIDs(
+		txCtx,
+		[]uuid.UUID{booking.RoomID},
+		booking.CheckIn,
+		booking.CheckOut,
+		true, // с блокировкой
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bookedRoomIDs) > 0 {
+		return nil, errors.WithMessage(errors.ErrConflict, "selected room has just been booked for these dates")
+	}
+
+	return selectedRoom, nil
+}
+
+// selectFirstAvailableRoom выбирает первую свободную комнату в порядке, отданном room-service
+func (s *bookingService) selectFirstAvailableRoom(
+	txCtx context.Context,
+	booking *model.Booking,
+	roomType room.RoomType,
+	roomCapacity int32,
+) (*model.Room, error) {
+	// 1. Получаем список подходящих комнат из room-service (фильтры применяем только если заданы)
+	params := model.SearchRoomsParams{}
+	if roomCapacity > 0 {
+		params.Capacity = &roomCapacity
+	}
+	if roomType != room.RoomType_ROOM_TYPE_UNSPECIFIED {
+		params.Type = &roomType
+	}
+	rooms, err := s.roomClient.GetAvailableRooms(txCtx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Формируем список room_id
+	roomIDs := make([]uuid.UUID, len(rooms))
+	for i, r := range rooms {
+		roomIDs[i], err = uuid.Parse(r.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 3. Получаем список занятых комнат
+	bookedRoomIDs, err := s.bookingRepo.GetBookedRoomIDs(
+		txCtx,
+		roomIDs,
+		booking.CheckIn,
+		booking.CheckOut,
+		true, // с блокировкой
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	booked := make(map[uuid.UUID]struct{}, len(bookedRoomIDs))
+	for _, id := range bookedRoomIDs {
+		booked[id] = struct{}{}
+	}
+
+	// 4. Выбираем первую свободную комнату
+	for i, id := range roomIDs {
+		if _, ok := booked[id]; !ok {
+			return &rooms[i], nil
+		}
+	}
+
+	return nil, errors.WithMessage(errors.ErrConflict, "no rooms available")
+}
+
+func (s *bookingService) UpdateBookingStatus(
+	ctx context.Context,
+	bookingID uuid.UUID,
+	status pb.BookingStatus,
+	reason string,
+	changedBy string,
+) (*model.Booking, error) {
+	if err := s.validateStatus(status); err != nil {
+		return nil, err
+	}
+
+	if changedBy == "" {
+		return nil, errors.WithMessage(errors.ErrInvalidInput, "changed_by is required")
+	}
+
+	var booking *model.Booking
+	err := s.uow.WithinTransaction(
 		ctx, func(txCtx context.Context) error {
-			// Валидация входных данных
-			if err := s.validateBooking(
-				booking.GuestName,
-				booking.GuestEmail,
-				timestamppb.New(booking.CheckIn),
-				timestamppb.New(booking.CheckOut),
-			); err != nil {
+			// Блокируем бронь, чтобы параллельные изменения статуса выполнялись последовательно
+			if err := s.bookingRepo.LockBooking(txCtx, bookingID); err != nil {
 				return err
 			}
 
-			// 1. Получаем список подходящих комнат из room-service
-			params := model.SearchRoomsParams{
-				Capacity: &roomCapacity,
-				Type:     &roomType,
+			current, err := s.GetBooking(txCtx, bookingID)
+			if err != nil {
+				return err
+			}
+
+			if !model.CanTransition(current.CurrentStatus.Status, status) {
+				return errors.WithMessage(
+					errors.ErrConflict,
+					fmt.Sprintf(
+						"status transition %s -> %s is not allowed",
+						current.CurrentStatus.Status,
+						status,
+					),
+				)
 			}
-			rooms, err := s.roomClient.GetAvailableRooms(ctx, params)
+
+			// Подтвердить бронь с истекшим удержанием нельзя, даже если воркер еще не успел ее отменить
+			if current.CurrentStatus.Status == pb.BookingStatus_BOOKING_STATUS_PENDING &&
+				status == pb.BookingStatus_BOOKING_STATUS_CONFIRMED &&
+				current.HoldExpiresAt != nil && !time.Now().Before(*current.HoldExpiresAt) {
+				return errors.WithMessage(errors.ErrConflict, "booking hold has expired")
+			}
+
+			// Создаем новую запись в истории статусов
+			statusHistory := &model.BookingStatusHistory{
+				BookingID: bookingID,
+				Status:    status,
+				Reason:    reason,
+				ChangedBy: changedBy,
+			}
+
+			if err = s.bookingRepo.AddBookingStatus(txCtx, bookingID, statusHistory); err != nil {
+				return fmt.Errorf("failed to update booking status: %w", err)
+			}
+			if status == pb.BookingStatus_BOOKING_STATUS_CANCELLED {
+				err = s.releaseCancelledBooking(txCtx, bookingID, pb.WaitlistStatus_WAITLIST_STATUS_CANCELLED)
+				if err != nil {
+					return err
+				}
+			}
+
+			current.CurrentStatus = statusHistory
+			booking = current
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if status == pb.BookingStatus_BOOKING_STATUS_CANCELLED {
+		s.offerFreedRoom(ctx, booking.CheckIn, booking.CheckOut)
+	}
+
+	return booking, nil
+}
+
+func (s *bookingService) GetBooking(ctx context.Context, bookingID uuid.UUID) (*model.Booking, error) {
+	bookingWithStatus, err := s.bookingRepo.GetBookingWithStatus(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	booking := bookingWithStatus.Booking
+	booking.CurrentStatus = &bookingWithStatus.CurrentStatus
+
+	return &booking, nil
+}
+
+func (s *bookingService) ListBookings(ctx context.Context, filter model.BookingFilter) (
+	[]model.Booking,
+	*model.BookingCursor,
+	error,
+) {
+	if filter.From != nil && filter.To != nil && !filter.To.After(*filter.From) {
+		return nil, nil, errors.WithMessage(errors.ErrInvalidInput, "period end must be after period start")
+	}
+
+	if filter.Limit <= 0 {
+		filter.Limit = defaultPageSize
+	}
+	if filter.Limit > maxPageSize {
+		filter.Limit = maxPageSize
+	}
+
+	// Запрашиваем на одну запись больше, чтобы понять, есть ли следующая страница
+	pageSize := filter.Limit
+	filter.Limit++
+
+	bookings, err := s.bookingRepo.ListBookings(ctx, filter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(bookings) <= pageSize {
+		return bookings, nil, nil
+	}
+
+	bookings = bookings[:pageSize]
+	last := bookings[pageSize-1]
+
+	return bookings, &model.BookingCursor{CreatedAt: last.CreatedAt, ID: last.ID}, nil
+}
+
+func (s *bookingService) GetBookingHistory(ctx context.Context, bookingID uuid.UUID) (
+	[]model.BookingStatusHistory,
+	error,
+) {
+	// Проверяем существование брони, чтобы отличать пустую историю от несуществующей брони
+	if _, err := s.bookingRepo.GetBookingWithStatus(ctx, bookingID); err != nil {
+		return nil, err
+	}
+
+	return s.bookingRepo.GetBookingStatusHistory(ctx, bookingID)
+}
+
+func (s *bookingService) ModifyBooking(
+	ctx context.Context,
+	bookingID uuid.UUID,
+	changes model.BookingChanges,
+	changedBy string,
+) (*model.Booking, error) {
+	if changedBy == "" {
+		return nil, errors.WithMessage(errors.ErrInvalidInput, "changed_by is required")
+	}
+
+	var booking *model.Booking
+	err := s.uow.WithinTransaction(
+		ctx, func(txCtx context.Context) error {
+			if err := s.bookingRepo.LockBooking(txCtx, bookingID); err != nil {
+				return err
+			}
+
+			current, err := s.GetBooking(txCtx, bookingID)
 			if err != nil {
 				return err
 			}
 
-			// 2. Формируем список room_id
-			roomIDs := make([]uuid.UUID, len(rooms), len(rooms))
-			var roomUUID uuid.UUID
-			for i, room := range rooms {
-				roomUUID, err = uuid.Parse(room.ID)
+			switch current.CurrentStatus.Status {
+			case pb.BookingStatus_BOOKING_STATUS_PENDING, pb.BookingStatus_BOOKING_STATUS_CONFIRMED:
+			default:
+				return errors.WithMessage(
+					errors.ErrConflict,
+					fmt.Sprintf("booking in status %s cannot be modified", current.CurrentStatus.Status),
+				)
+			}
+
+			// Применяем изменения к копии, чтобы описать их в истории
+			modified := *current
+			if changes.GuestName != nil {
+				modified.GuestName = *changes.GuestName
+			}
+			if changes.GuestEmail != nil {
+				modified.GuestEmail = *changes.GuestEmail
+			}
+			if changes.GuestPhone != nil {
+				modified.GuestPhone = *changes.GuestPhone
+			}
+			if changes.CheckIn != nil {
+				modified.CheckIn = s.calendar.CheckInAt(*changes.CheckIn)
+			}
+			if changes.CheckOut != nil {
+				modified.CheckOut = s.calendar.CheckOutAt(*changes.CheckOut)
+			}
+
+			if err = s.validateBooking(
+				modified.GuestName,
+				modified.GuestEmail,
+				timestamppb.New(modified.CheckIn),
+				timestamppb.New(modified.CheckOut),
+			); err != nil {
+				return err
+			}
+
+			datesChanged := !modified.CheckIn.Equal(current.CheckIn) || !modified.CheckOut.Equal(current.CheckOut)
+			if current.CheckedInAt != nil && (datesChanged || changes.RoomType != nil) {
+				return errors.WithMessage(
+					errors.ErrConflict,
+					"guest has already checked in, only guest details can be changed",
+				)
+			}
+
+			var details []string
+			if datesChanged {
+				details = append(
+					details, fmt.Sprintf(
+						"dates %s..%s -> %s..%s",
+						s.calendar.DateOf(current.CheckIn).Format(time.DateOnly),
+						s.calendar.DateOf(current.CheckOut).Format(time.DateOnly),
+						s.calendar.DateOf(modified.CheckIn).Format(time.DateOnly),
+						s.calendar.DateOf(modified.CheckOut).Format(time.DateOnly),
+					),
+				)
+			}
+
+			// Повторная проверка доступности и пересчет стоимости нужны только при смене дат или типа комнаты
+			if datesChanged || changes.RoomType != nil {
+				// Комната могла еще не быть назначена
+				var currentRoom *model.Room
+				if current.IsRoomAssigned() {
+					currentRoom, err = s.roomClient.GetRoomInfo(txCtx, current.RoomID)
+					if err != nil {
+						return err
+					}
+					if currentRoom == nil {
+						return errors.WithMessage(errors.ErrNotFound, "room not found")
+					}
+				}
+
+				selectedRoom, deferred, err := s.selectModifiedRoom(txCtx, &modified, currentRoom, changes.RoomType)
+				if err != nil {
+					return err
+				}
+				// Новые даты и тип комнаты подчиняются тем же ограничениям продаж, что и новая бронь
+				err = s.checkStayRestrictions(txCtx, selectedRoom.Type, modified.CheckIn, modified.CheckOut)
 				if err != nil {
 					return err
 				}
-				roomIDs[i] = roomUUID
+
+				modified.RoomID = uuid.Nil
+				if !deferred {
+					modified.RoomID, err = uuid.Parse(selectedRoom.ID)
+					if err != nil {
+						return err
+					}
+				}
+				modifiedType := selectedRoom.Type
+				modified.RoomType = &modifiedType
+				if modified.RoomID != current.RoomID {
+					newRoom := selectedRoom
+					if deferred {
+						newRoom = nil
+					}
+					details = append(details, fmt.Sprintf("room %s -> %s", roomLabel(currentRoom), roomLabel(newRoom)))
+				}
+
+				modified.PriceBreakdown, modified.TotalPrice, err = s.priceStay(
+					txCtx,
+					selectedRoom,
+					modified.CheckIn,
+					modified.CheckOut,
+					modified.ID,
+				)
+				if err != nil {
+					return err
+				}
+
+				if modified.PromoCode != nil {
+					applied, err := s.reapplyPromoCode(txCtx, &modified, selectedRoom.Type)
+					if err != nil {
+						return err
+					}
+					if !applied {
+						details = append(details, "promo code removed")
+					}
+				}
+			}
+
+			if modified.GuestName != current.GuestName ||
+				modified.GuestEmail != current.GuestEmail ||
+				modified.GuestPhone != current.GuestPhone {
+				details = append(details, "guest details")
 			}
+			// Другие контакты могут принадлежать другому гостю
+			if modified.GuestEmail != current.GuestEmail || modified.GuestPhone != current.GuestPhone {
+				if err = s.resolveGuestProfile(txCtx, &modified); err != nil {
+					return err
+				}
+			}
+
+			if len(details) == 0 {
+				return errors.WithMessage(errors.ErrInvalidInput, "no changes requested")
+			}
+
+			if err = s.bookingRepo.Update(txCtx, &modified); err != nil {
+				return err
+			}
+
+			// Статус не меняется, запись в истории описывает изменение брони
+			statusHistory := &model.BookingStatusHistory{
+				BookingID: bookingID,
+				Status:    current.CurrentStatus.Status,
+				Reason:    "booking modified: " + strings.Join(details, ", "),
+				ChangedBy: changedBy,
+			}
+			if err = s.bookingRepo.AddBookingStatus(txCtx, bookingID, statusHistory); err != nil {
+				return fmt.Errorf("failed to record booking modification: %w", err)
+			}
+
+			modified.CurrentStatus = statusHistory
+			booking = &modified
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return booking, nil
+}
 
-			// 3. Получаем список броней
-			bookedRoomIDs, err := s.bookingRepo.GetBookedRoomIDs(
+// selectModifiedRoom оставляет текущую комнату, если она подходит и свободна на новые даты, иначе бронирует
+// нужный тип без комнаты (deferred): возвращенная комната служит только для расчета стоимости.
+// currentRoom равен nil у брони, комната которой еще не назначена
+func (s *bookingService) selectModifiedRoom(
+	txCtx context.Context,
+	booking *model.Booking,
+	currentRoom *model.Room,
+	roomType *model.RoomType,
+) (*model.Room, bool, error) {
+	var targetType model.RoomType
+	switch {
+	case roomType != nil:
+		targetType = *roomType
+	case currentRoom != nil:
+		targetType = currentRoom.Type
+	case booking.RoomType != nil:
+		targetType = *booking.RoomType
+	}
+
+	if currentRoom != nil && targetType == currentRoom.Type {
+		bookedRoomIDs, err := s.bookingRepo.GetBookedRoomIDsExcept(
+			txCtx,
+			booking.ID,
+			[]uuid.UUID{booking.RoomID},
+			booking.CheckIn,
+			booking.CheckOut,
+		)
+		if err != nil {
+			return nil, false, err
+		}
+		if len(bookedRoomIDs) == 0 {
+			err = s.checkRoomTypeAvailability(
 				txCtx,
-				roomIDs,
+				targetType,
+				int32(currentRoom.Capacity),
 				booking.CheckIn,
 				booking.CheckOut,
-				true, // с блокировкой
+				booking.ID,
 			)
+			if err == nil {
+				return currentRoom, false, nil
+			}
+			if !errors.IsConflict(err) {
+				return nil, false, err
+			}
+		}
+	}
+
+	var capacity int32
+	if booking.Capacity != nil {
+		capacity = *booking.Capacity
+	}
+	pricedRoom, err := s.reserveRoomType(txCtx, booking, targetType, capacity, booking.ID)
+	if errors.IsConflict(err) {
+		return nil, false, errors.WithMessage(errors.ErrConflict, "no rooms available for the requested changes")
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return pricedRoom, true, nil
+}
+
+func (s *bookingService) GetCancellationQuote(ctx context.Context, bookingID uuid.UUID) (
+	*model.CancellationQuote,
+	error,
+) {
+	booking, err := s.GetBooking(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.quoteCancellation(ctx, booking, time.Now())
+}
+
+func (s *bookingService) CancelBooking(
+	ctx context.Context,
+	bookingID uuid.UUID,
+	reason string,
+	changedBy string,
+) (*model.Booking, *model.CancellationQuote, error) {
+	if changedBy == "" {
+		return nil, nil, errors.WithMessage(errors.ErrInvalidInput, "changed_by is required")
+	}
+	if reason == "" {
+		reason = cancelledReason
+	}
+
+	var (
+		booking *model.Booking
+		quote   *model.CancellationQuote
+	)
+	err := s.uow.WithinTransaction(
+		ctx, func(txCtx context.Context) error {
+			if err := s.bookingRepo.LockBooking(txCtx, bookingID); err != nil {
+				return err
+			}
+
+			current, err := s.GetBooking(txCtx, bookingID)
 			if err != nil {
 				return err
 			}
 
-			// 4. Создаем множество доступных room_id
-			availableRoomIds := make(map[uuid.UUID]struct{}, len(roomIDs))
-			for _, id := range roomIDs {
-				availableRoomIds[id] = struct{}{}
+			now := time.Now()
+			quote, err = s.quoteCancellation(txCtx, current, now)
+			if err != nil {
+				return err
 			}
 
-			// 5. Удаляем забронированные комнаты из доступных
-			for _, bookedRoomID := range bookedRoomIDs {
-				delete(availableRoomIds, bookedRoomID)
+			current.CancellationPenalty = &quote.Penalty
+			current.RefundAmount = &quote.Refund
+			current.CancelledAt = &now
+
+			if err = s.bookingRepo.Update(txCtx, current); err != nil {
+				return err
 			}
 
-			if len(availableRoomIds) == 0 {
-				return fmt.Errorf("no rooms available")
+			statusHistory := &model.BookingStatusHistory{
+				BookingID: bookingID,
+				Status:    pb.BookingStatus_BOOKING_STATUS_CANCELLED,
+				Reason:    reason,
+				ChangedBy: changedBy,
 			}
+			if err = s.bookingRepo.AddBookingStatus(txCtx, bookingID, statusHistory); err != nil {
+				return fmt.Errorf("failed to cancel booking: %w", err)
+			}
+			err = s.releaseCancelledBooking(txCtx, bookingID, pb.WaitlistStatus_WAITLIST_STATUS_CANCELLED)
+			if err != nil {
+				return err
+			}
+
+			current.CurrentStatus = statusHistory
+			booking = current
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s.offerFreedRoom(ctx, booking.CheckIn, booking.CheckOut)
+
+	return booking, quote, nil
+}
+
+// quoteCancellation применяет политику отмены типа комнаты к брони на момент now
+func (s *bookingService) quoteCancellation(
+	ctx context.Context,
+	booking *model.Booking,
+	now time.Time,
+) (*model.CancellationQuote, error) {
+	if !model.CanTransition(booking.CurrentStatus.Status, pb.BookingStatus_BOOKING_STATUS_CANCELLED) {
+		return nil, errors.WithMessage(
+			errors.ErrConflict,
+			fmt.Sprintf("booking in status %s cannot be cancelled", booking.CurrentStatus.Status),
+		)
+	}
+	if booking.CheckedInAt != nil {
+		return nil, errors.WithMessage(errors.ErrConflict, "guest has already checked in, use check-out instead")
+	}
+
+	roomType, err := s.bookedRoomType(ctx, booking)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := s.cancellationPolicies.ForRoomType(roomType)
+	daysBefore := int(math.Floor(booking.CheckIn.Sub(now).Hours() / 24))
+
+	quote := &model.CancellationQuote{
+		TotalPrice:        booking.TotalPrice,
+		DaysBeforeCheckIn: daysBefore,
+		NonRefundable:     policy.NonRefundable,
+	}
+	if !policy.NonRefundable {
+		freeUntil := booking.CheckIn.AddDate(0, 0, -policy.FreeUntilDays)
+		quote.FreeCancellationUntil = &freeUntil
+	}
+
+	// Неподтвержденная бронь не оплачена, поэтому ее отмена всегда бесплатна
+	if booking.CurrentStatus.Status != pb.BookingStatus_BOOKING_STATUS_PENDING {
+		quote.PenaltyPercent = policy.PenaltyPercent(daysBefore)
+	}
+
+	quote.Penalty = booking.TotalPrice.Percent(quote.PenaltyPercent)
+	quote.Refund, err = booking.TotalPrice.Sub(quote.Penalty)
+	if err != nil {
+		return nil, err
+	}
+
+	return quote, nil
+}
+
+func (s *bookingService) CheckIn(
+	ctx context.Context,
+	bookingID uuid.UUID,
+	roomID *uuid.UUID,
+	changedBy string,
+) (*model.Booking, error) {
+	if changedBy == "" {
+		return nil, errors.WithMessage(errors.ErrInvalidInput, "changed_by is required")
+	}
 
-			// 6. Выбираем первую свободную комнату
-			var selectedRoomId uuid.UUID
-			for roomId := range availableRoomIds {
-				selectedRoomId = roomId
-				break
+	var booking *model.Booking
+	err := s.uow.WithinTransaction(
+		ctx, func(txCtx context.Context) error {
+			if err := s.bookingRepo.LockBooking(txCtx, bookingID); err != nil {
+				return err
 			}
 
-			// 7. Получаем детали комнаты для расчета цены
-			selectedRoom, err := s.roomClient.GetRoomInfo(ctx, selectedRoomId)
+			current, err := s.GetBooking(txCtx, bookingID)
 			if err != nil {
 				return err
 			}
 
-			// 8. Рассчитываем полную стоимость
-			totalPrice, err := s.calculateTotalPrice(selectedRoom, booking.CheckIn, booking.CheckOut)
+			if current.CurrentStatus.Status != pb.BookingStatus_BOOKING_STATUS_CONFIRMED {
+				return errors.WithMessage(errors.ErrConflict, "only confirmed bookings can be checked in")
+			}
+			if current.CheckedInAt != nil {
+				return errors.WithMessage(errors.ErrConflict, "guest has already checked in")
+			}
+
+			now := time.Now()
+			if !now.Before(current.CheckOut) {
+				return errors.WithMessage(errors.ErrConflict, "booking stay period has already ended")
+			}
+
+			// Комната, в которую заселяется гость: забронированная или выбранная на стойке регистрации
+			targetRoomID := current.RoomID
+			if roomID != nil {
+				targetRoomID = *roomID
+			}
+			if targetRoomID == uuid.Nil {
+				// Брони на тип комнаты, не получившей комнату при распределении, комната закрепляется при заселении
+				targetRoomID, err = s.assignRoomForCheckIn(txCtx, current)
+				if err != nil {
+					return err
+				}
+			}
+
+			checkInRoom, err := s.roomClient.GetRoomInfo(txCtx, targetRoomID)
 			if err != nil {
 				return err
 			}
+			if checkInRoom == nil {
+				return errors.WithMessage(errors.ErrNotFound, "room not found")
+			}
+			if checkInRoom.Status != room.RoomStatus_ROOM_STATUS_AVAILABLE {
+				return errors.WithMessage(errors.ErrConflict, "room is not ready for check-in")
+			}
 
-			// 9. Заполняем оставшиеся поля бронирования
-			booking.RoomID = selectedRoomId
-			booking.TotalPrice = totalPrice
+			// При переселении новая комната должна быть свободна на оставшийся период
+			if targetRoomID != current.RoomID {
+				bookedRoomIDs, err := s.bookingRepo.GetBookedRoomIDs(
+					txCtx,
+					[]uuid.UUID{targetRoomID},
+					current.CheckIn,
+					current.CheckOut,
+					true, // с блокировкой
+				)
+				if err != nil {
+					return err
+				}
+				if len(bookedRoomIDs) > 0 {
+					return errors.WithMessage(errors.ErrConflict, "selected room is booked for these dates")
+				}
+			}
+
+			current.RoomID = targetRoomID
+			current.RoomNumber = checkInRoom.Number
+			current.CheckedInAt = &now
 
-			// 10. Создаем бронь
-			if err = s.bookingRepo.Create(txCtx, booking); err != nil {
+			if err = s.bookingRepo.Update(txCtx, current); err != nil {
 				return err
 			}
 
-			// 11. Создаем начальный статус PENDING
+			// Статус не меняется, запись в истории фиксирует факт заселения
 			statusHistory := &model.BookingStatusHistory{
-				BookingID: booking.ID,
-				Status:    pb.BookingStatus_BOOKING_STATUS_PENDING,
-				ChangedBy: "system",
-				Reason:    "Initial booking creation",
+				BookingID: bookingID,
+				Status:    pb.BookingStatus_BOOKING_STATUS_CONFIRMED,
+				Reason:    fmt.Sprintf("guest checked in to room %s", checkInRoom.Number),
+				ChangedBy: changedBy,
+			}
+			if err = s.bookingRepo.AddBookingStatus(txCtx, bookingID, statusHistory); err != nil {
+				return fmt.Errorf("failed to record check-in: %w", err)
+			}
+
+			current.CurrentStatus = statusHistory
+			booking = current
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return booking, nil
+}
+
+func (s *bookingService) CheckOut(ctx context.Context, bookingID uuid.UUID, changedBy string) (*model.Booking, error) {
+	if changedBy == "" {
+		return nil, errors.WithMessage(errors.ErrInvalidInput, "changed_by is required")
+	}
+
+	var booking *model.Booking
+	err := s.uow.WithinTransaction(
+		ctx, func(txCtx context.Context) error {
+			if err := s.bookingRepo.LockBooking(txCtx, bookingID); err != nil {
+				return err
 			}
 
-			booking.CurrentStatus = statusHistory
+			current, err := s.GetBooking(txCtx, bookingID)
+			if err != nil {
+				return err
+			}
 
-			return s.bookingRepo.AddBookingStatus(txCtx, booking.ID, statusHistory)
+			if current.CurrentStatus.Status != pb.BookingStatus_BOOKING_STATUS_CONFIRMED || current.CheckedInAt == nil {
+				return errors.WithMessage(errors.ErrConflict, "guest has not checked in")
+			}
+
+			now := time.Now()
+			current.CheckedOutAt = &now
+			reason := "guest checked out"
+
+			// Ранний выезд: сокращаем проживание, освобождая комнату. Оплачиваются начавшиеся ночи
+			// по ценам, зафиксированным при бронировании
+			if now.Before(current.CheckOut) && now.After(current.CheckIn) {
+				stayed := len(s.calendar.Nights(current.CheckIn, now))
+				switch {
+				case len(current.PriceBreakdown) == 0:
+					// Брони, созданные до появления тарифных планов, пересчитываются по текущему тарифу
+					stayRoom, err := s.roomClient.GetRoomInfo(txCtx, current.RoomID)
+					if err != nil {
+						return err
+					}
+					if stayRoom == nil {
+						return errors.WithMessage(errors.ErrNotFound, "room not found")
+					}
+					current.PriceBreakdown, current.TotalPrice, err = s.priceStay(
+						txCtx,
+						stayRoom,
+						current.CheckIn,
+						now,
+						current.ID,
+					)
+					if err != nil {
+						return err
+					}
+				case stayed < len(current.PriceBreakdown):
+					current.PriceBreakdown = current.PriceBreakdown[:stayed]
+					current.TotalPrice, err = current.PriceBreakdown.Total(current.TotalPrice.Currency)
+					if err != nil {
+						return err
+					}
+				}
 
+				current.CheckOut = now
+				reason = "guest checked out early"
+			}
+
+			if err = s.bookingRepo.Update(txCtx, current); err != nil {
+				return err
+			}
+
+			statusHistory := &model.BookingStatusHistory{
+				BookingID: bookingID,
+				Status:    pb.BookingStatus_BOOKING_STATUS_COMPLETED,
+				Reason:    reason,
+				ChangedBy: changedBy,
+			}
+			if err = s.bookingRepo.AddBookingStatus(txCtx, bookingID, statusHistory); err != nil {
+				return fmt.Errorf("failed to record check-out: %w", err)
+			}
+
+			current.CurrentStatus = statusHistory
+			booking = current
+
+			return nil
 		},
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Выезд уже зафиксирован, поэтому ошибка room-service не отменяет его, а только логируется
+	if err = s.roomClient.UpdateRoomStatus(ctx, booking.RoomID, room.RoomStatus_ROOM_STATUS_MAINTENANCE); err != nil {
+		logger.Log.Error(
+			"failed to send room to housekeeping after check-out",
+			"booking id", bookingID,
+			"room id", booking.RoomID,
+			"error", err,
+		)
+	}
+
+	return booking, nil
 }
 
-func (s *bookingService) UpdateBookingStatus(
-	ctx context.Context,
-	bookingID uuid.UUID,
-	status pb.BookingStatus,
-	reason string,
-	changedBy string,
-) error {
-	// Проверяем существование брони
-	_, err := s.bookingRepo.GetBookingWithStatus(ctx, bookingID)
+func (s *bookingService) ExpirePendingBookings(ctx context.Context, limit int) (int, error) {
+	// Брони, чьи комнаты освободились и могут быть предложены листу ожидания
+	var expired []*model.Booking
+	err := s.uow.WithinTransaction(
+		ctx, func(txCtx context.Context) error {
+			now := time.Now()
+			// Строки остаются заблокированными до конца транзакции, параллельное подтверждение будет ждать
+			bookingIDs, err := s.bookingRepo.LockExpiredPendingBookings(txCtx, now, limit)
+			if err != nil {
+				return err
+			}
+
+			for _, bookingID := range bookingIDs {
+				statusHistory := &model.BookingStatusHistory{
+					BookingID: bookingID,
+					Status:    pb.BookingStatus_BOOKING_STATUS_CANCELLED,
+					Reason:    holdExpiredReason,
+					ChangedBy: systemActor,
+				}
+				if err = s.bookingRepo.AddBookingStatus(txCtx, bookingID, statusHistory); err != nil {
+					return fmt.Errorf("failed to expire booking %s: %w", bookingID, err)
+				}
+				// Гость из листа ожидания не подтвердил предложенную бронь вовремя
+				err = s.releaseCancelledBooking(txCtx, bookingID, pb.WaitlistStatus_WAITLIST_STATUS_EXPIRED)
+				if err != nil {
+					return err
+				}
+
+				booking, err := s.GetBooking(txCtx, bookingID)
+				if err != nil {
+					return err
+				}
+				expired = append(expired, booking)
+			}
+
+			// Ожидающие записи на уже наступившие даты больше не могут получить предложение
+			closed, err := s.bookingRepo.ExpireWaitlistEntries(txCtx, now)
+			if err != nil {
+				return err
+			}
+			if closed > 0 {
+				logger.Log.Info("expired waitlist entries", "count", closed)
+			}
+			return nil
+		},
+	)
 	if err != nil {
-		return fmt.Errorf("failed to get booking: %w", err)
+		return 0, err
 	}
 
-	// Создаем новую запись в истории статусов
-	statusHistory := &model.BookingStatusHistory{
-		BookingID: bookingID,
-		Status:    status,
-		Reason:    reason,
-		ChangedBy: changedBy,
+	for _, booking := range expired {
+		s.offerFreedRoom(ctx, booking.CheckIn, booking.CheckOut)
 	}
 
-	if err = s.bookingRepo.AddBookingStatus(ctx, bookingID, statusHistory); err != nil {
-		return fmt.Errorf("failed to update booking status: %w", err)
+	return len(expired), nil
+}
+
+func (s *bookingService) RunNightlyTransitions(ctx context.Context, run model.NightlyRun) (
+	[]model.StatusTransition,
+	error,
+) {
+	var transitions []model.StatusTransition
+	err := s.uow.WithinTransaction(
+		ctx, func(txCtx context.Context) error {
+			noShowIDs, err := s.bookingRepo.LockNoShowCandidates(txCtx, run.NoShowCheckInBefore)
+			if err != nil {
+				return err
+			}
+			completedIDs, err := s.bookingRepo.LockCompletionCandidates(txCtx, run.CompleteCheckOutBefore)
+			if err != nil {
+				return err
+			}
+
+			transitions = make([]model.StatusTransition, 0, len(noShowIDs)+len(completedIDs))
+			for _, id := range noShowIDs {
+				transitions = append(
+					transitions, model.StatusTransition{
+						BookingID: id,
+						From:      pb.BookingStatus_BOOKING_STATUS_CONFIRMED,
+						To:        pb.BookingStatus_BOOKING_STATUS_NO_SHOW,
+						Reason:    noShowReason,
+					},
+				)
+			}
+			for _, id := range completedIDs {
+				transitions = append(
+					transitions, model.StatusTransition{
+						BookingID: id,
+						From:      pb.BookingStatus_BOOKING_STATUS_CONFIRMED,
+						To:        pb.BookingStatus_BOOKING_STATUS_COMPLETED,
+						Reason:    completedReason,
+					},
+				)
+			}
+
+			// В режиме dry-run только возвращаем список, транзакция закроется без изменений
+			if run.DryRun {
+				return nil
+			}
+
+			for _, t := range transitions {
+				statusHistory := &model.BookingStatusHistory{
+					BookingID: t.BookingID,
+					Status:    t.To,
+					Reason:    t.Reason,
+					ChangedBy: systemActor,
+				}
+				if err = s.bookingRepo.AddBookingStatus(txCtx, t.BookingID, statusHistory); err != nil {
+					return fmt.Errorf("failed to update booking %s status: %w", t.BookingID, err)
+				}
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	return transitions, nil
 }
 
 func (s *bookingService) validateBooking(username, email string, checkIn, checkOut *timestamppb.Timestamp) error {
@@ -277,10 +1358,10 @@ func (s *bookingService) validateBooking(username, email string, checkIn, checkO
 	checkInTime := checkIn.AsTime()
 	checkOutTime := checkOut.AsTime()
 
-	if checkOutTime.Before(checkInTime) {
+	if !checkOutTime.After(checkInTime) {
 		return errors.WithMessage(
 			errors.ErrInvalidInput,
-			"check-out date cannot be before check-in date",
+			"check-out date must be after check-in date",
 		)
 	}
 
@@ -0,0 +1,81 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+	"github.com/semho/hotel-booking/booking-service/internal/infrastructure/repository/postgres/pgtest"
+	"github.com/semho/hotel-booking/pkg/errors"
+	"github.com/semho/hotel-booking/pkg/proto/room_v1/room"
+)
+
+// Параллельные брони одной комнаты на одни даты через сервис: проходит ровно одна, остальные получают ErrConflict.
+// Свободных комнат типа больше одной, поэтому отказ дает занятость самой комнаты, а не лимит типа
+func TestCreateBookingRejectsOverlappingBookingsOfRoom(t *testing.T) {
+	db := pgtest.Open(t)
+
+	requested := testRoom("301", 2, room.RoomStatus_ROOM_STATUS_AVAILABLE)
+	rooms := &roomClientStub{
+		rooms: []model.Room{
+			requested,
+			testRoom("302", 2, room.RoomStatus_ROOM_STATUS_AVAILABLE),
+			testRoom("303", 2, room.RoomStatus_ROOM_STATUS_AVAILABLE),
+		},
+	}
+	bookingService := newTestBookingService(db, rooms)
+
+	const parallel = 10
+	checkIn := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 1, 0)
+	checkOut := checkIn.AddDate(0, 0, 2)
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		created   int
+		conflicts int
+		start     = make(chan struct{})
+	)
+	for i := 0; i < parallel; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+
+			err := bookingService.CreateBooking(
+				context.Background(),
+				&model.Booking{
+					RoomID:     uuid.MustParse(requested.ID),
+					GuestName:  fmt.Sprintf("Overlap Check %d", i),
+					GuestEmail: fmt.Sprintf("overlap-check-%d@example.com", i),
+					CheckIn:    checkIn,
+					CheckOut:   checkOut,
+				},
+				room.RoomType_ROOM_TYPE_DELUXE,
+				0,
+				nil,
+			)
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err == nil:
+				created++
+			case errors.IsConflict(err):
+				conflicts++
+			default:
+				t.Errorf("unexpected error: %v", err)
+			}
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+
+	if created != 1 || conflicts != parallel-1 {
+		t.Fatalf("expected 1 booking and %d conflicts, got %d bookings and %d conflicts", parallel-1, created, conflicts)
+	}
+}
@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+	"github.com/semho/hotel-booking/pkg/errors"
+	pb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
+	"github.com/semho/hotel-booking/pkg/proto/room_v1/room"
+)
+
+// Период календаря доступности по умолчанию и наибольший
+const (
+	defaultCalendarDays = 30
+	maxCalendarDays     = 92
+)
+
+func (s *bookingService) GetAvailabilityCalendar(
+	ctx context.Context,
+	params model.AvailabilityCalendarParams,
+) ([]model.CalendarRow, error) {
+	from, to := s.reportPeriod(params.From, params.To, defaultCalendarDays)
+	if !to.After(from) {
+		return nil, errors.WithMessage(errors.ErrInvalidInput, "to must be after from")
+	}
+	if to.After(from.AddDate(0, 0, maxCalendarDays)) {
+		return nil, errors.WithMessage(
+			errors.ErrInvalidInput,
+			fmt.Sprintf("calendar period must not exceed %d days", maxCalendarDays),
+		)
+	}
+
+	rooms, err := s.roomClient.GetAllRooms(ctx, model.SearchRoomsParams{Type: params.RoomType})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(
+		rooms, func(i, j int) bool {
+			if rooms[i].Type != rooms[j].Type {
+				return rooms[i].Type < rooms[j].Type
+			}
+			return rooms[i].Number < rooms[j].Number
+		},
+	)
+
+	bookings, err := s.bookingRepo.GetBookingsForPeriod(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	nights := s.calendar.Nights(from, to)
+	roomRows, err := s.roomCalendarRows(rooms, bookings, nights, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if params.GroupBy != pb.CalendarGrouping_CALENDAR_GROUPING_ROOM_TYPE {
+		return roomRows, nil
+	}
+
+	return s.roomTypeCalendarRows(roomRows, bookings, nights), nil
+}
+
+// roomCalendarRows строит строки комнат. Статус комнаты известен только на текущий момент,
+// поэтому ремонт и вывод из эксплуатации отмечаются на ночах, которые еще не закончились
+func (s *bookingService) roomCalendarRows(
+	rooms []model.Room,
+	bookings []model.Booking,
+	nights []time.Time,
+	now time.Time,
+) ([]model.CalendarRow, error) {
+	byRoom := make(map[uuid.UUID][]model.Booking)
+	for _, booking := range bookings {
+		if booking.IsRoomAssigned() {
+			byRoom[booking.RoomID] = append(byRoom[booking.RoomID], booking)
+		}
+	}
+
+	rows := make([]model.CalendarRow, len(rooms))
+	for i := range rooms {
+		roomID, err := uuid.Parse(rooms[i].ID)
+		if err != nil {
+			return nil, err
+		}
+
+		rows[i] = model.CalendarRow{
+			Room:     &rooms[i],
+			RoomType: rooms[i].Type,
+			Nights:   make([]model.CalendarNight, len(nights)),
+		}
+		for n, night := range nights {
+			calendarNight := model.CalendarNight{Night: night}
+			if booking := s.bookingOnNight(byRoom[roomID], night); booking != nil {
+				bookingID := booking.ID
+				calendarNight.BookingID = &bookingID
+				calendarNight.Status = pb.AvailabilityStatus_AVAILABILITY_STATUS_BOOKED
+			} else {
+				calendarNight.Status = roomNightStatus(rooms[i].Status, night, now)
+			}
+			countNightStatus(&calendarNight, calendarNight.Status)
+			rows[i].Nights[n] = calendarNight
+		}
+	}
+
+	return rows, nil
+}
+
+// roomTypeCalendarRows сводит строки комнат по типам. Брони типа без назначенной комнаты
+// занимают свободные комнаты типа
+func (s *bookingService) roomTypeCalendarRows(
+	roomRows []model.CalendarRow,
+	bookings []model.Booking,
+	nights []time.Time,
+) []model.CalendarRow {
+	var rows []model.CalendarRow
+	byType := make(map[model.RoomType]int)
+	for _, roomRow := range roomRows {
+		i, ok := byType[roomRow.RoomType]
+		if !ok {
+			i = len(rows)
+			byType[roomRow.RoomType] = i
+			rows = append(
+				rows, model.CalendarRow{
+					RoomType: roomRow.RoomType,
+					Nights:   make([]model.CalendarNight, len(nights)),
+				},
+			)
+			for n, night := range nights {
+				rows[i].Nights[n].Night = night
+			}
+		}
+
+		for n, roomNight := range roomRow.Nights {
+			countNightStatus(&rows[i].Nights[n], roomNight.Status)
+		}
+	}
+
+	for _, booking := range bookings {
+		if booking.IsRoomAssigned() || booking.RoomType == nil {
+			continue
+		}
+		i, ok := byType[*booking.RoomType]
+		if !ok {
+			continue
+		}
+		for n, night := range nights {
+			if !s.calendar.Occupies(booking.CheckIn, booking.CheckOut, night) {
+				continue
+			}
+			typeNight := &rows[i].Nights[n]
+			typeNight.Booked++
+			typeNight.Free = max(typeNight.Free-1, 0)
+		}
+	}
+
+	for i := range rows {
+		for n := range rows[i].Nights {
+			rows[i].Nights[n].Status = roomTypeNightStatus(rows[i].Nights[n])
+		}
+	}
+	return rows
+}
+
+// bookingOnNight возвращает бронь, проживание по которой занимает ночь night
+func (s *bookingService) bookingOnNight(bookings []model.Booking, night time.Time) *model.Booking {
+	for i := range bookings {
+		if s.calendar.Occupies(bookings[i].CheckIn, bookings[i].CheckOut, night) {
+			return &bookings[i]
+		}
+	}
+	return nil
+}
+
+func roomNightStatus(status model.RoomStatus, night, now time.Time) model.AvailabilityStatus {
+	if !night.AddDate(0, 0, 1).After(now) {
+		return pb.AvailabilityStatus_AVAILABILITY_STATUS_FREE
+	}
+
+	switch status {
+	case room.RoomStatus_ROOM_STATUS_REPAIR:
+		return pb.AvailabilityStatus_AVAILABILITY_STATUS_BLOCKED
+	case room.RoomStatus_ROOM_STATUS_OUT_OF_SERVICE:
+		return pb.AvailabilityStatus_AVAILABILITY_STATUS_OUT_OF_SERVICE
+	default:
+		// Уборка не мешает продаже будущих ночей
+		return pb.AvailabilityStatus_AVAILABILITY_STATUS_FREE
+	}
+}
+
+func countNightStatus(night *model.CalendarNight, status model.AvailabilityStatus) {
+	switch status {
+	case pb.AvailabilityStatus_AVAILABILITY_STATUS_FREE:
+		night.Free++
+	case pb.AvailabilityStatus_AVAILABILITY_STATUS_BOOKED:
+		night.Booked++
+	case pb.AvailabilityStatus_AVAILABILITY_STATUS_BLOCKED:
+		night.Blocked++
+	case pb.AvailabilityStatus_AVAILABILITY_STATUS_OUT_OF_SERVICE:
+		night.OutOfService++
+	}
+}
+
+// roomTypeNightStatus — тип свободен, пока есть хотя бы одна свободная комната
+func roomTypeNightStatus(night model.CalendarNight) model.AvailabilityStatus {
+	switch {
+	case night.Free > 0:
+		return pb.AvailabilityStatus_AVAILABILITY_STATUS_FREE
+	case night.Booked > 0:
+		return pb.AvailabilityStatus_AVAILABILITY_STATUS_BOOKED
+	case night.Blocked > 0:
+		return pb.AvailabilityStatus_AVAILABILITY_STATUS_BLOCKED
+	default:
+		return pb.AvailabilityStatus_AVAILABILITY_STATUS_OUT_OF_SERVICE
+	}
+}
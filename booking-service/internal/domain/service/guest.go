@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+	"github.com/semho/hotel-booking/pkg/errors"
+)
+
+const (
+	defaultGuestSearchLimit = 20
+	maxGuestSearchLimit     = 100
+)
+
+func (s *bookingService) GetGuestProfile(ctx context.Context, id uuid.UUID) (*model.GuestProfile, error) {
+	return s.bookingRepo.GetGuestProfile(ctx, id, s.currency)
+}
+
+func (s *bookingService) SearchGuestProfiles(
+	ctx context.Context,
+	search model.GuestProfileSearch,
+) ([]model.GuestProfile, error) {
+	if search.Limit <= 0 {
+		search.Limit = defaultGuestSearchLimit
+	}
+	if search.Limit > maxGuestSearchLimit {
+		search.Limit = maxGuestSearchLimit
+	}
+	return s.bookingRepo.SearchGuestProfiles(ctx, search, s.currency)
+}
+
+func (s *bookingService) UpdateGuestProfile(
+	ctx context.Context,
+	profile *model.GuestProfile,
+) (*model.GuestProfile, error) {
+	profile.Name = strings.TrimSpace(profile.Name)
+	if profile.Name == "" {
+		return nil, errors.WithMessage(errors.ErrInvalidInput, "guest name is required")
+	}
+
+	if err := s.bookingRepo.UpdateGuestProfile(ctx, profile); err != nil {
+		return nil, err
+	}
+	return s.bookingRepo.GetGuestProfile(ctx, profile.ID, s.currency)
+}
+
+func (s *bookingService) MergeGuestProfiles(
+	ctx context.Context,
+	targetID uuid.UUID,
+	sourceIDs []uuid.UUID,
+) (*model.GuestProfile, error) {
+	if len(sourceIDs) == 0 {
+		return nil, errors.WithMessage(errors.ErrInvalidInput, "at least one source profile is required")
+	}
+	sources := make([]uuid.UUID, 0, len(sourceIDs))
+	seen := map[uuid.UUID]struct{}{targetID: {}}
+	for _, id := range sourceIDs {
+		if id == targetID {
+			return nil, errors.WithMessage(errors.ErrInvalidInput, "guest profile cannot be merged into itself")
+		}
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			sources = append(sources, id)
+		}
+	}
+
+	err := s.uow.WithinTransaction(
+		ctx, func(txCtx context.Context) error {
+			locked, err := s.bookingRepo.LockGuestProfiles(txCtx, append([]uuid.UUID{targetID}, sources...))
+			if err != nil {
+				return err
+			}
+			if len(locked) != len(sources)+1 {
+				return errors.WithMessage(errors.ErrNotFound, "guest profile not found")
+			}
+
+			target, err := s.bookingRepo.GetGuestProfile(txCtx, targetID, s.currency)
+			if err != nil {
+				return err
+			}
+			for _, id := range sources {
+				source, err := s.bookingRepo.GetGuestProfile(txCtx, id, s.currency)
+				if err != nil {
+					return err
+				}
+				target.GuestPreferences.Merge(source.GuestPreferences)
+			}
+
+			if err = s.bookingRepo.UpdateGuestProfile(txCtx, target); err != nil {
+				return err
+			}
+			return s.bookingRepo.MergeGuestProfiles(txCtx, targetID, sources)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.bookingRepo.GetGuestProfile(ctx, targetID, s.currency)
+}
+
+// resolveGuestProfile связывает бронь с профилем гостя по email, а если такого нет — по телефону.
+// Для нового гостя создается профиль, новые контакты известного гостя добавляются в его профиль
+func (s *bookingService) resolveGuestProfile(txCtx context.Context, booking *model.Booking) error {
+	contacts := model.GuestContacts(booking.GuestEmail, booking.GuestPhone)
+	if len(contacts) == 0 {
+		booking.GuestProfileID = nil
+		return nil
+	}
+
+	if err := s.bookingRepo.LockGuestContact(txCtx, contacts[0]); err != nil {
+		return err
+	}
+
+	profileID, err := s.bookingRepo.FindGuestProfileID(txCtx, contacts)
+	if err != nil {
+		return err
+	}
+	if profileID == nil {
+		profile := &model.GuestProfile{Name: booking.GuestName}
+		if err = s.bookingRepo.CreateGuestProfile(txCtx, profile); err != nil {
+			return err
+		}
+		profileID = &profile.ID
+	}
+
+	if err = s.bookingRepo.AddGuestContacts(txCtx, *profileID, contacts); err != nil {
+		return err
+	}
+	booking.GuestProfileID = profileID
+	return nil
+}
+
+// ClaimGuestBookings привязывает к пользователю прошлые и будущие анонимные брони гостя с email.
+// Вызывается только с email, который пользователь подтвердил: брони других пользователей не затрагиваются
+func (s *bookingService) ClaimGuestBookings(
+	ctx context.Context,
+	userID uuid.UUID,
+	email string,
+) ([]uuid.UUID, error) {
+	if userID == uuid.Nil {
+		return nil, errors.WithMessage(errors.ErrInvalidInput, "user id is required")
+	}
+	email = model.NormalizeEmail(email)
+	if email == "" {
+		return nil, errors.WithMessage(errors.ErrInvalidInput, "email is required")
+	}
+
+	var claimed []uuid.UUID
+	err := s.uow.WithinTransaction(
+		ctx, func(txCtx context.Context) error {
+			var err error
+			claimed, err = s.bookingRepo.ClaimGuestBookings(txCtx, userID, email)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return claimed, nil
+}
@@ -0,0 +1,116 @@
+package service_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+	"github.com/semho/hotel-booking/booking-service/internal/domain/port"
+	"github.com/semho/hotel-booking/booking-service/internal/domain/service"
+	"github.com/semho/hotel-booking/booking-service/internal/infrastructure/repository/postgres"
+	"github.com/semho/hotel-booking/booking-service/internal/infrastructure/unitofwork"
+	"github.com/semho/hotel-booking/pkg/logger"
+	"github.com/semho/hotel-booking/pkg/proto/room_v1/room"
+)
+
+func TestMain(m *testing.M) {
+	logger.Log = slog.New(slog.NewTextHandler(io.Discard, nil))
+	os.Exit(m.Run())
+}
+
+// newTestBookingService собирает сервис на тестовой базе с комнатами rooms вместо room service
+func newTestBookingService(db *sqlx.DB, rooms *roomClientStub) port.BookingService {
+	return service.NewBookingService(
+		postgres.NewBookingRepository(db),
+		unitofwork.NewBookingUnitOfWork(db),
+		rooms,
+		service.Settings{Currency: "RUB", Calendar: model.HotelCalendar{Location: time.UTC}},
+	)
+}
+
+// testRoom — комната типа DELUXE с ценой 100 в статусе status
+func testRoom(number string, capacity int, status model.RoomStatus) model.Room {
+	return model.Room{
+		ID:       uuid.NewString(),
+		Number:   number,
+		Type:     room.RoomType_ROOM_TYPE_DELUXE,
+		Price:    "100.00",
+		Capacity: capacity,
+		Status:   status,
+	}
+}
+
+// roomClientStub отдает фиксированный список комнат вместо room service
+type roomClientStub struct {
+	rooms []model.Room
+}
+
+func (c *roomClientStub) GetAvailableRooms(_ context.Context, params model.SearchRoomsParams) ([]model.Room, error) {
+	status := room.RoomStatus_ROOM_STATUS_AVAILABLE
+	if params.Status != nil {
+		status = *params.Status
+	}
+
+	var rooms []model.Room
+	for _, r := range c.match(params) {
+		if r.Status == status {
+			rooms = append(rooms, r)
+		}
+	}
+	return rooms, nil
+}
+
+func (c *roomClientStub) GetAllRooms(_ context.Context, params model.SearchRoomsParams) ([]model.Room, error) {
+	return c.match(params), nil
+}
+
+func (c *roomClientStub) GetRoomsCount(ctx context.Context, params model.SearchRoomsParams) (int32, error) {
+	rooms, err := c.GetAvailableRooms(ctx, params)
+	return int32(len(rooms)), err
+}
+
+func (c *roomClientStub) GetRoomInfo(_ context.Context, roomID uuid.UUID) (*model.Room, error) {
+	for i := range c.rooms {
+		if c.rooms[i].ID == roomID.String() {
+			return &c.rooms[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *roomClientStub) GetFirstAvailableRoom(ctx context.Context, params model.SearchRoomsParams) (*model.Room, error) {
+	rooms, err := c.GetAvailableRooms(ctx, params)
+	if err != nil || len(rooms) == 0 {
+		return nil, err
+	}
+	return &rooms[0], nil
+}
+
+func (c *roomClientStub) UpdateRoomStatus(_ context.Context, roomID uuid.UUID, status model.RoomStatus) error {
+	for i := range c.rooms {
+		if c.rooms[i].ID == roomID.String() {
+			c.rooms[i].Status = status
+		}
+	}
+	return nil
+}
+
+func (c *roomClientStub) match(params model.SearchRoomsParams) []model.Room {
+	var rooms []model.Room
+	for _, r := range c.rooms {
+		if params.Type != nil && r.Type != *params.Type {
+			continue
+		}
+		if params.Capacity != nil && int32(r.Capacity) != *params.Capacity {
+			continue
+		}
+		rooms = append(rooms, r)
+	}
+	return rooms
+}
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/semho/hotel-booking/pkg/errors"
+	"github.com/semho/hotel-booking/pkg/logger"
+)
+
+// Сколько раз повторяется отправка уведомления, прежде чем оно остается в outbox для разбора
+const maxNotificationAttempts = 10
+
+// PublishNotificationEvents отправляет уведомления из outbox. Уведомления остаются заблокированными,
+// пока идет отправка, поэтому реплики не отправляют их дважды. Доставка «хотя бы один раз»:
+// если отметка не сохранилась после отправки, уведомление уйдет повторно
+func (s *bookingService) PublishNotificationEvents(ctx context.Context, limit int) (int, error) {
+	if s.notifications == nil {
+		return 0, errors.WithMessage(errors.ErrInternal, "notification sender is not configured")
+	}
+
+	var published []uuid.UUID
+	err := s.uow.WithinTransaction(
+		ctx, func(txCtx context.Context) error {
+			published = nil
+			events, err := s.bookingRepo.LockPendingNotificationEvents(txCtx, maxNotificationAttempts, limit)
+			if err != nil {
+				return err
+			}
+
+			for _, event := range events {
+				if err = s.notifications.Send(txCtx, event); err != nil {
+					logger.Log.Error(
+						"failed to send notification",
+						"event_id", event.ID,
+						"event_type", event.Type,
+						"attempt", event.Attempts+1,
+						"error", err,
+					)
+					if err = s.bookingRepo.RecordNotificationEventFailure(txCtx, event.ID, err.Error()); err != nil {
+						return err
+					}
+					continue
+				}
+				published = append(published, event.ID)
+			}
+
+			return s.bookingRepo.MarkNotificationEventsPublished(txCtx, published, time.Now())
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(published), nil
+}
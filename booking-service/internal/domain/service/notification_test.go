@@ -0,0 +1,97 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+	"github.com/semho/hotel-booking/booking-service/internal/domain/service"
+	"github.com/semho/hotel-booking/booking-service/internal/infrastructure/repository/postgres"
+	"github.com/semho/hotel-booking/booking-service/internal/infrastructure/repository/postgres/pgtest"
+	"github.com/semho/hotel-booking/booking-service/internal/infrastructure/unitofwork"
+)
+
+const failingNotification = "test.failing"
+
+// notificationSenderStub запоминает отправленные уведомления и не принимает уведомления типа failingNotification
+type notificationSenderStub struct {
+	mu   sync.Mutex
+	sent []uuid.UUID
+}
+
+func (s *notificationSenderStub) Send(_ context.Context, event model.NotificationEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sent = append(s.sent, event.ID)
+	if event.Type == failingNotification {
+		return fmt.Errorf("recipient rejected %s", event.ID)
+	}
+	return nil
+}
+
+func (s *notificationSenderStub) reset() []uuid.UUID {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sent := s.sent
+	s.sent = nil
+	return sent
+}
+
+// Уведомления из outbox отправляются один раз, неудачная отправка повторяется при следующем запуске
+func TestPublishNotificationEvents(t *testing.T) {
+	db := pgtest.Open(t)
+	repo := postgres.NewBookingRepository(db)
+	sender := &notificationSenderStub{}
+	bookingService := service.NewBookingService(
+		repo,
+		unitofwork.NewBookingUnitOfWork(db),
+		&roomClientStub{},
+		service.Settings{
+			Currency:      "RUB",
+			Calendar:      model.HotelCalendar{Location: time.UTC},
+			Notifications: sender,
+		},
+	)
+
+	ctx := context.Background()
+	var events []model.NotificationEvent
+	eventTypes := []string{model.NotificationWaitlistOffer, failingNotification, model.NotificationWaitlistOffer}
+	for _, eventType := range eventTypes {
+		event := model.NotificationEvent{Type: eventType, Payload: json.RawMessage(`{}`)}
+		if err := repo.AddNotificationEvent(ctx, &event); err != nil {
+			t.Fatalf("add notification event: %v", err)
+		}
+		events = append(events, event)
+	}
+
+	published, err := bookingService.PublishNotificationEvents(ctx, 10)
+	if err != nil {
+		t.Fatalf("publish notifications: %v", err)
+	}
+	if published != 2 {
+		t.Fatalf("expected 2 published notifications, got %d", published)
+	}
+	if sent := sender.reset(); len(sent) != len(events) {
+		t.Fatalf("expected %d send attempts, got %d", len(events), len(sent))
+	}
+
+	// Отправленные уведомления не повторяются, неудачное отправляется снова
+	published, err = bookingService.PublishNotificationEvents(ctx, 10)
+	if err != nil {
+		t.Fatalf("publish notifications again: %v", err)
+	}
+	sent := sender.reset()
+	if published != 0 || len(sent) != 1 || sent[0] != events[1].ID {
+		t.Fatalf(
+			"expected only failed notification %s to be retried, got %v (published %d)",
+			events[1].ID, sent, published,
+		)
+	}
+}
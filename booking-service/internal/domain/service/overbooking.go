@@ -0,0 +1,467 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+	"github.com/semho/hotel-booking/pkg/errors"
+	"github.com/semho/hotel-booking/pkg/proto/room_v1/room"
+)
+
+// Период отчета о проданных сверх числа комнат ночах по умолчанию и наибольший
+const (
+	defaultOversoldReportDays = 90
+	maxOversoldReportDays     = 366
+)
+
+// Обозначение отсутствующей комнаты в истории изменений брони
+const unassignedRoomLabel = "unassigned"
+
+// reserveRoomType оформляет бронь на тип комнаты без закрепления конкретной комнаты: комната назначается
+// распределением перед заездом. Выбирается первый из типов подходящих комнат, продажи которого на все ночи
+// не достигли потолка. Возвращает комнату типа, по цене которой рассчитывается стоимость
+func (s *bookingService) reserveRoomType(
+	txCtx context.Context,
+	booking *model.Booking,
+	roomType model.RoomType,
+	roomCapacity int32,
+	excludeBookingID uuid.UUID,
+) (*model.Room, error) {
+	// Распределение может отдать брони комнату большей вместимости, поэтому подходят все такие комнаты.
+	// Цена считается по самой близкой к запрошенной вместимости
+	params := model.SearchRoomsParams{}
+	if roomType != room.RoomType_ROOM_TYPE_UNSPECIFIED {
+		params.Type = &roomType
+	}
+	rooms, err := s.getSellableRooms(txCtx, params)
+	if err != nil {
+		return nil, err
+	}
+	candidates := roomsWithCapacity(rooms, roomCapacity)
+	if roomCapacity > 0 {
+		sort.SliceStable(
+			candidates, func(i, j int) bool {
+				return candidates[i].Capacity < candidates[j].Capacity
+			},
+		)
+	}
+
+	soldOut := errors.WithMessage(errors.ErrConflict, "no rooms available")
+	checked := make(map[model.RoomType]struct{})
+	for i := range candidates {
+		candidateType := candidates[i].Type
+		if _, ok := checked[candidateType]; ok {
+			continue
+		}
+		checked[candidateType] = struct{}{}
+
+		err = s.checkRoomTypeAvailability(
+			txCtx,
+			candidateType,
+			roomCapacity,
+			booking.CheckIn,
+			booking.CheckOut,
+			excludeBookingID,
+		)
+		if errors.IsConflict(err) {
+			soldOut = err
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &candidates[i], nil
+	}
+
+	return nil, soldOut
+}
+
+// checkRoomTypeAvailability проверяет, что еще одна бронь типа укладывается в потолок продаж типа, а при
+// заданной вместимости — и в потолок комнат, которые ее вмещают (см. capacityThresholds). Проверки одного
+// типа выполняются последовательно до конца транзакции: брони без комнаты не попадают под exclusion constraint
+func (s *bookingService) checkRoomTypeAvailability(
+	txCtx context.Context,
+	roomType model.RoomType,
+	capacity int32,
+	checkIn, checkOut time.Time,
+	excludeBookingID uuid.UUID,
+) error {
+	if err := s.bookingRepo.LockRoomTypeInventory(txCtx, roomType); err != nil {
+		return err
+	}
+
+	rooms, err := s.roomClient.GetAllRooms(txCtx, model.SearchRoomsParams{Type: &roomType})
+	if err != nil {
+		return err
+	}
+	if err = s.checkRoomTypeCeiling(txCtx, roomType, nil, rooms, checkIn, checkOut, excludeBookingID); err != nil {
+		return err
+	}
+	for _, threshold := range capacityThresholds(rooms, capacity) {
+		err = s.checkRoomTypeCeiling(
+			txCtx,
+			roomType,
+			&threshold,
+			roomsWithCapacity(rooms, threshold),
+			checkIn,
+			checkOut,
+			excludeBookingID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// roomsWithCapacity оставляет комнаты, которые вмещают не меньше capacity гостей: так комнаты подбирает
+// распределение. capacity 0 — все комнаты
+func roomsWithCapacity(rooms []model.Room, capacity int32) []model.Room {
+	if capacity <= 0 {
+		return rooms
+	}
+
+	var result []model.Room
+	for _, r := range rooms {
+		if int32(r.Capacity) >= capacity {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
+// capacityThresholds возвращает вместимости, для которых нужно проверить продажи брони вместимости capacity.
+// Бронь вместимости c может занять любую комнату вместимостью от c, поэтому брони без комнаты хватит комнат,
+// только если для каждого порога t броней, которым нужна вместимость от t, не больше, чем комнат вместимостью
+// от t. Бронь вместимости capacity меняет спрос на порогах до capacity, а набор комнат меняется только
+// на порогах сразу над вместимостью какой-либо комнаты
+func capacityThresholds(rooms []model.Room, capacity int32) []int32 {
+	if capacity <= 0 {
+		return nil
+	}
+
+	seen := map[int32]struct{}{capacity: {}}
+	thresholds := []int32{capacity}
+	for _, r := range rooms {
+		threshold := int32(r.Capacity) + 1
+		if _, ok := seen[threshold]; ok || threshold > capacity {
+			continue
+		}
+		seen[threshold] = struct{}{}
+		thresholds = append(thresholds, threshold)
+	}
+	sort.Slice(thresholds, func(i, j int) bool { return thresholds[i] < thresholds[j] })
+	return thresholds
+}
+
+// checkRoomTypeCeiling возвращает конфликт, если с еще одной бронью продажи комнат rooms на какую-либо ночь
+// периода превысят потолок с учетом допустимого овербукинга типа. Потолок ночи считается от комнат,
+// которые можно продать на эту ночь
+func (s *bookingService) checkRoomTypeCeiling(
+	txCtx context.Context,
+	roomType model.RoomType,
+	capacity *int32,
+	rooms []model.Room,
+	checkIn, checkOut time.Time,
+	excludeBookingID uuid.UUID,
+) error {
+	nights, err := s.countRoomTypeNights(txCtx, roomType, capacity, rooms, checkIn, checkOut, excludeBookingID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, night := range s.calendar.Nights(checkIn, checkOut) {
+		date := model.NightDate(night)
+		inventory := sellableRoomsOnNight(rooms, night, now)
+		ceiling := s.overbooking.Ceiling(roomType, inventory)
+		if nights[date].Booked+1 > ceiling {
+			if ceiling > inventory {
+				return errors.WithMessage(
+					errors.ErrConflict,
+					fmt.Sprintf("overbooking limit for %s is reached on %s", roomType, date),
+				)
+			}
+			return errors.WithMessage(
+				errors.ErrConflict,
+				fmt.Sprintf("no %s rooms available on %s", roomType, date),
+			)
+		}
+	}
+	return nil
+}
+
+// isRoomOutOfOrder сообщает, что комнату нельзя продать: она в ремонте или выведена из эксплуатации.
+// Уборка после выезда продаже не мешает
+func isRoomOutOfOrder(status model.RoomStatus) bool {
+	return status == room.RoomStatus_ROOM_STATUS_REPAIR || status == room.RoomStatus_ROOM_STATUS_OUT_OF_SERVICE
+}
+
+// getSellableRooms возвращает комнаты, которые можно продать, независимо от уборки и заселения:
+// все комнаты подходящих параметров, кроме находящихся в ремонте или выведенных из эксплуатации
+func (s *bookingService) getSellableRooms(ctx context.Context, params model.SearchRoomsParams) ([]model.Room, error) {
+	rooms, err := s.roomClient.GetAllRooms(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	sellable := make([]model.Room, 0, len(rooms))
+	for _, r := range rooms {
+		if !isRoomOutOfOrder(r.Status) {
+			sellable = append(sellable, r)
+		}
+	}
+	return sellable, nil
+}
+
+// sellableRoomsOnNight считает комнаты, которые можно продать на ночь night. Room service знает только текущий
+// статус комнаты, поэтому ремонт и вывод из эксплуатации закрывают ночи, которые еще не закончились
+func sellableRoomsOnNight(rooms []model.Room, night, now time.Time) int {
+	if !night.AddDate(0, 0, 1).After(now) {
+		return len(rooms)
+	}
+
+	sellable := 0
+	for _, r := range rooms {
+		if !isRoomOutOfOrder(r.Status) {
+			sellable++
+		}
+	}
+	return sellable
+}
+
+// limitToUnsoldRooms оставляет у каждого типа не больше свободных комнат, чем осталось непроданных на все ночи:
+// брони типа без назначенной комнаты уже претендуют на часть свободных комнат
+func (s *bookingService) limitToUnsoldRooms(
+	ctx context.Context,
+	available []model.Room,
+	params model.SearchParams,
+) ([]model.Room, error) {
+	var capacity int32
+	if params.Capacity != nil {
+		capacity = *params.Capacity
+	}
+
+	unsold := make(map[model.RoomType]int)
+	var limited []model.Room
+	for _, r := range available {
+		left, ok := unsold[r.Type]
+		if !ok {
+			var err error
+			if left, err = s.unsoldRooms(ctx, r.Type, capacity, params.CheckIn, params.CheckOut); err != nil {
+				return nil, err
+			}
+		}
+		if left > 0 {
+			limited = append(limited, r)
+			left--
+		}
+		unsold[r.Type] = left
+	}
+	return limited, nil
+}
+
+// unsoldRooms возвращает, сколько комнат типа, вмещающих capacity гостей, если она задана, не продано
+// ни на одну ночь периода
+func (s *bookingService) unsoldRooms(
+	ctx context.Context,
+	roomType model.RoomType,
+	capacity int32,
+	checkIn, checkOut time.Time,
+) (int, error) {
+	rooms, err := s.roomClient.GetAllRooms(ctx, model.SearchRoomsParams{Type: &roomType})
+	if err != nil {
+		return 0, err
+	}
+	unsold, err := s.unsoldInPool(ctx, roomType, nil, rooms, checkIn, checkOut)
+	if err != nil {
+		return 0, err
+	}
+	for _, threshold := range capacityThresholds(rooms, capacity) {
+		unsoldCapacity, err := s.unsoldInPool(
+			ctx,
+			roomType,
+			&threshold,
+			roomsWithCapacity(rooms, threshold),
+			checkIn,
+			checkOut,
+		)
+		if err != nil {
+			return 0, err
+		}
+		unsold = min(unsold, unsoldCapacity)
+	}
+	return unsold, nil
+}
+
+func (s *bookingService) unsoldInPool(
+	ctx context.Context,
+	roomType model.RoomType,
+	capacity *int32,
+	rooms []model.Room,
+	checkIn, checkOut time.Time,
+) (int, error) {
+	nights, err := s.countRoomTypeNights(ctx, roomType, capacity, rooms, checkIn, checkOut, uuid.Nil)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	unsold := len(rooms)
+	for _, night := range s.calendar.Nights(checkIn, checkOut) {
+		unsold = min(unsold, sellableRoomsOnNight(rooms, night, now)-nights[model.NightDate(night)].Booked)
+	}
+	return max(unsold, 0), nil
+}
+
+func (s *bookingService) countRoomTypeNights(
+	ctx context.Context,
+	roomType model.RoomType,
+	capacity *int32,
+	rooms []model.Room,
+	from, to time.Time,
+	excludeBookingID uuid.UUID,
+) (map[string]model.RoomTypeNight, error) {
+	roomIDs := make([]uuid.UUID, len(rooms))
+	for i, r := range rooms {
+		id, err := uuid.Parse(r.ID)
+		if err != nil {
+			return nil, err
+		}
+		roomIDs[i] = id
+	}
+
+	nights := s.calendar.Nights(from, to)
+	return s.bookingRepo.CountRoomTypeBookingsByNight(ctx, roomType, capacity, roomIDs, nights, excludeBookingID)
+}
+
+// assignRoomForCheckIn закрепляет за бронью, оставшейся без комнаты после распределения, первую свободную
+// комнату забронированного типа
+func (s *bookingService) assignRoomForCheckIn(txCtx context.Context, booking *model.Booking) (uuid.UUID, error) {
+	if booking.RoomType == nil {
+		return uuid.Nil, errors.WithMessage(errors.ErrConflict, "booking has no room type, choose a room for check-in")
+	}
+
+	// Распределение комнат типа не должно параллельно отдать ту же комнату другой брони
+	if err := s.bookingRepo.LockRoomTypeInventory(txCtx, *booking.RoomType); err != nil {
+		return uuid.Nil, err
+	}
+
+	var capacity int32
+	if booking.Capacity != nil {
+		capacity = *booking.Capacity
+	}
+	selectedRoom, err := s.selectFirstAvailableRoom(txCtx, booking, *booking.RoomType, capacity)
+	if errors.IsConflict(err) {
+		return uuid.Nil, errors.WithMessage(
+			errors.ErrConflict,
+			"no free room of the booked type, choose a room for check-in",
+		)
+	}
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return uuid.Parse(selectedRoom.ID)
+}
+
+// bookedRoomType возвращает тип комнаты брони. Брони, созданные до хранения типа, всегда имеют комнату
+func (s *bookingService) bookedRoomType(ctx context.Context, booking *model.Booking) (model.RoomType, error) {
+	if booking.RoomType != nil {
+		return *booking.RoomType, nil
+	}
+
+	bookedRoom, err := s.roomClient.GetRoomInfo(ctx, booking.RoomID)
+	if err != nil {
+		return room.RoomType_ROOM_TYPE_UNSPECIFIED, err
+	}
+	if bookedRoom == nil {
+		return room.RoomType_ROOM_TYPE_UNSPECIFIED, errors.WithMessage(errors.ErrNotFound, "room not found")
+	}
+	return bookedRoom.Type, nil
+}
+
+// roomLabel возвращает номер комнаты для истории изменений, nil — бронь без комнаты
+func roomLabel(r *model.Room) string {
+	if r == nil {
+		return unassignedRoomLabel
+	}
+	return r.Number
+}
+
+func (s *bookingService) GetOversoldNights(ctx context.Context, from, to time.Time) ([]model.OversoldNight, error) {
+	from, to = s.reportPeriod(from, to, defaultOversoldReportDays)
+	if !to.After(from) {
+		return nil, errors.WithMessage(errors.ErrInvalidInput, "to must be after from")
+	}
+	if to.After(from.AddDate(0, 0, maxOversoldReportDays)) {
+		return nil, errors.WithMessage(
+			errors.ErrInvalidInput,
+			fmt.Sprintf("report period must not exceed %d days", maxOversoldReportDays),
+		)
+	}
+
+	roomTypes := make([]model.RoomType, 0, len(room.RoomType_name))
+	for value := range room.RoomType_name {
+		if roomType := model.RoomType(value); roomType != room.RoomType_ROOM_TYPE_UNSPECIFIED {
+			roomTypes = append(roomTypes, roomType)
+		}
+	}
+	sort.Slice(roomTypes, func(i, j int) bool { return roomTypes[i] < roomTypes[j] })
+
+	now := time.Now()
+	var oversold []model.OversoldNight
+	for _, roomType := range roomTypes {
+		rooms, err := s.roomClient.GetAllRooms(ctx, model.SearchRoomsParams{Type: &roomType})
+		if err != nil {
+			return nil, err
+		}
+
+		nights, err := s.countRoomTypeNights(ctx, roomType, nil, rooms, from, to, uuid.Nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, night := range s.calendar.Nights(from, to) {
+			sold := nights[model.NightDate(night)]
+			inventory := sellableRoomsOnNight(rooms, night, now)
+			if sold.Booked <= inventory {
+				continue
+			}
+			oversold = append(
+				oversold, model.OversoldNight{
+					Night:         night,
+					RoomType:      roomType,
+					Inventory:     inventory,
+					Ceiling:       s.overbooking.Ceiling(roomType, inventory),
+					RoomTypeNight: sold,
+				},
+			)
+		}
+	}
+
+	sort.SliceStable(
+		oversold, func(i, j int) bool {
+			return oversold[i].Night.Before(oversold[j].Night)
+		},
+	)
+	return oversold, nil
+}
+
+// reportPeriod переводит даты периода ночей [from, to) в полночи по времени отеля. Нулевой from — текущая дата
+// отеля, нулевой to — defaultDays ночей от from
+func (s *bookingService) reportPeriod(from, to time.Time, defaultDays int) (time.Time, time.Time) {
+	if from.IsZero() {
+		from = s.calendar.DateOf(time.Now())
+	} else {
+		from = s.calendar.FromDate(from)
+	}
+	if to.IsZero() {
+		return from, from.AddDate(0, 0, defaultDays)
+	}
+	return from, s.calendar.FromDate(to)
+}
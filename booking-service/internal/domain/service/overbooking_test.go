@@ -0,0 +1,141 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+	"github.com/semho/hotel-booking/booking-service/internal/infrastructure/repository/postgres/pgtest"
+	"github.com/semho/hotel-booking/pkg/errors"
+	"github.com/semho/hotel-booking/pkg/proto/room_v1/room"
+)
+
+// Параллельные брони типа без комнаты: продается не больше броней, чем комнат типа
+func TestCreateBookingDoesNotExceedRoomTypeInventory(t *testing.T) {
+	db := pgtest.Open(t)
+
+	// Комната на уборке продается, комната, выведенная из эксплуатации, — нет
+	rooms := &roomClientStub{
+		rooms: []model.Room{
+			testRoom("101", 2, room.RoomStatus_ROOM_STATUS_AVAILABLE),
+			testRoom("102", 2, room.RoomStatus_ROOM_STATUS_MAINTENANCE),
+			testRoom("103", 2, room.RoomStatus_ROOM_STATUS_OUT_OF_SERVICE),
+		},
+	}
+	bookingService := newTestBookingService(db, rooms)
+
+	const (
+		inventory = 2
+		parallel  = 10
+	)
+	checkIn := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 1, 0)
+	checkOut := checkIn.AddDate(0, 0, 3)
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		created   int
+		conflicts int
+		start     = make(chan struct{})
+	)
+	for i := 0; i < parallel; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+
+			err := bookingService.CreateBooking(
+				context.Background(),
+				&model.Booking{
+					GuestName:  fmt.Sprintf("Inventory Check %d", i),
+					GuestEmail: fmt.Sprintf("inventory-check-%d@example.com", i),
+					CheckIn:    checkIn,
+					CheckOut:   checkOut,
+				},
+				room.RoomType_ROOM_TYPE_DELUXE,
+				0,
+				nil,
+			)
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err == nil:
+				created++
+			case errors.IsConflict(err):
+				conflicts++
+			default:
+				t.Errorf("unexpected error: %v", err)
+			}
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+
+	if created != inventory || conflicts != parallel-inventory {
+		t.Fatalf(
+			"expected %d bookings and %d conflicts, got %d bookings and %d conflicts",
+			inventory, parallel-inventory, created, conflicts,
+		)
+	}
+}
+
+// Бронь без комнаты распределяется в комнату большей вместимости: следующая бронь типа,
+// которой хватило бы освободившейся по вместимости комнаты, все равно получает отказ
+func TestCreateBookingCountsDeferredBookingsInLargerRooms(t *testing.T) {
+	db := pgtest.Open(t)
+
+	small := testRoom("201", 2, room.RoomStatus_ROOM_STATUS_AVAILABLE)
+	large := testRoom("202", 4, room.RoomStatus_ROOM_STATUS_AVAILABLE)
+	bookingService := newTestBookingService(db, &roomClientStub{rooms: []model.Room{small, large}})
+
+	ctx := context.Background()
+	checkIn := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 1)
+	checkOut := checkIn.AddDate(0, 0, 2)
+	newBooking := func(guest string) *model.Booking {
+		return &model.Booking{
+			GuestName:  guest,
+			GuestEmail: guest + "@example.com",
+			CheckIn:    checkIn,
+			CheckOut:   checkOut,
+		}
+	}
+
+	deferred := newBooking("deferred")
+	if err := bookingService.CreateBooking(ctx, deferred, room.RoomType_ROOM_TYPE_DELUXE, 2, nil); err != nil {
+		t.Fatalf("create deferred booking: %v", err)
+	}
+	explicit := newBooking("explicit")
+	explicit.RoomID = uuid.MustParse(small.ID)
+	if err := bookingService.CreateBooking(ctx, explicit, room.RoomType_ROOM_TYPE_DELUXE, 0, nil); err != nil {
+		t.Fatalf("create booking of room %s: %v", small.Number, err)
+	}
+
+	if _, err := bookingService.AssignRooms(ctx, time.Now()); err != nil {
+		t.Fatalf("assign rooms: %v", err)
+	}
+	assigned, err := bookingService.GetBooking(ctx, deferred.ID)
+	if err != nil {
+		t.Fatalf("get deferred booking: %v", err)
+	}
+	if assigned.RoomID.String() != large.ID {
+		t.Fatalf("expected deferred booking in room %s, got %s", large.ID, assigned.RoomID)
+	}
+
+	for _, capacity := range []int32{0, 2, 4} {
+		err := bookingService.CreateBooking(
+			ctx,
+			newBooking(fmt.Sprintf("capacity-%d", capacity)),
+			room.RoomType_ROOM_TYPE_DELUXE,
+			capacity,
+			nil,
+		)
+		if !errors.IsConflict(err) {
+			t.Errorf("capacity %d: expected conflict, got %v", capacity, err)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+	"github.com/semho/hotel-booking/pkg/errors"
+	"github.com/semho/hotel-booking/pkg/money"
+	"github.com/shopspring/decimal"
+)
+
+// priceStay рассчитывает стоимость проживания в комнате по тарифному плану ее типа: цены ночей и итог.
+// excludeBookingID — бронь, которая не учитывается в загрузке отеля (при изменении брони — она сама)
+func (s *bookingService) priceStay(
+	ctx context.Context,
+	room *model.Room,
+	checkIn, checkOut time.Time,
+	excludeBookingID uuid.UUID,
+) (model.NightPrices, money.Money, error) {
+	basePrice, err := decimal.NewFromString(room.Price)
+	if err != nil {
+		return nil, money.Money{}, fmt.Errorf("failed to parse room price: %w", err)
+	}
+
+	// Тарифы и стоимость брони считаются в валюте расчетов отеля, цена комнаты в другой валюте пересчитывается по курсу
+	if room.Currency != "" && room.Currency != s.currency {
+		rate, err := s.exchangeRate(ctx, room.Currency, s.currency)
+		if err != nil {
+			return nil, money.Money{}, err
+		}
+		basePrice = basePrice.Mul(rate)
+	}
+
+	plan := s.ratePlans.ForRoomType(room.Type)
+	nights := s.calendar.Nights(checkIn, checkOut)
+
+	var occupancy map[string]int
+	if plan.UsesOccupancy() && len(nights) > 0 {
+		occupancy, err = s.occupancyByNight(ctx, nights, excludeBookingID)
+		if err != nil {
+			return nil, money.Money{}, err
+		}
+	}
+
+	breakdown := plan.PriceNights(basePrice, s.currency, nights, occupancy)
+	total, err := breakdown.Total(s.currency)
+	if err != nil {
+		return nil, money.Money{}, err
+	}
+	return breakdown, total, nil
+}
+
+// Загрузка отеля в процентах на каждую ночь по активным броням. Считается от всех комнат, которые можно продать:
+// комнаты на уборке или с гостями входят в номерной фонд, комнаты в ремонте — нет
+func (s *bookingService) occupancyByNight(
+	ctx context.Context,
+	nights []time.Time,
+	excludeBookingID uuid.UUID,
+) (map[string]int, error) {
+	rooms, err := s.getSellableRooms(ctx, model.SearchRoomsParams{})
+	if err != nil {
+		return nil, err
+	}
+	totalRooms := len(rooms)
+	if totalRooms == 0 {
+		return nil, nil
+	}
+
+	counts, err := s.bookingRepo.CountActiveBookingsByNight(ctx, nights, excludeBookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	occupancy := make(map[string]int, len(counts))
+	for night, count := range counts {
+		occupancy[night] = count * 100 / totalRooms
+	}
+	return occupancy, nil
+}
+
+// ConvertPrice пересчитывает сумму в валюту currency по текущему курсу
+func (s *bookingService) ConvertPrice(ctx context.Context, amount money.Money, currency string) (money.Money, error) {
+	if err := money.ValidateCurrency(currency); err != nil {
+		return money.Money{}, err
+	}
+	currency = strings.ToUpper(currency)
+	if amount.Currency == currency {
+		return amount, nil
+	}
+
+	rate, err := s.exchangeRate(ctx, amount.Currency, currency)
+	if err != nil {
+		return money.Money{}, err
+	}
+	return amount.Convert(rate, currency), nil
+}
+
+func (s *bookingService) exchangeRate(ctx context.Context, from, to string) (decimal.Decimal, error) {
+	if s.exchangeRates == nil {
+		return decimal.Zero, errors.WithMessage(
+			errors.ErrInvalidInput,
+			fmt.Sprintf("exchange rates are not configured, cannot convert %s to %s", from, to),
+		)
+	}
+	return s.exchangeRates.Rate(ctx, from, to)
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+	"github.com/semho/hotel-booking/pkg/errors"
+)
+
+func (s *bookingService) CreatePromoCode(ctx context.Context, promo *model.PromoCode) error {
+	promo.Code = model.NormalizePromoCode(promo.Code)
+	promo.Active = true
+	if err := promo.Validate(s.currency); err != nil {
+		return err
+	}
+	return s.bookingRepo.CreatePromoCode(ctx, promo)
+}
+
+func (s *bookingService) GetPromoCode(ctx context.Context, id uuid.UUID) (*model.PromoCode, error) {
+	return s.bookingRepo.GetPromoCode(ctx, id)
+}
+
+func (s *bookingService) ListPromoCodes(ctx context.Context, activeOnly bool) ([]model.PromoCode, error) {
+	return s.bookingRepo.ListPromoCodes(ctx, activeOnly)
+}
+
+func (s *bookingService) UpdatePromoCode(ctx context.Context, promo *model.PromoCode) error {
+	current, err := s.bookingRepo.GetPromoCode(ctx, promo.ID)
+	if err != nil {
+		return err
+	}
+
+	promo.Code = current.Code
+	promo.CreatedAt = current.CreatedAt
+	if err = promo.Validate(s.currency); err != nil {
+		return err
+	}
+	return s.bookingRepo.UpdatePromoCode(ctx, promo)
+}
+
+func (s *bookingService) DeletePromoCode(ctx context.Context, id uuid.UUID) error {
+	return s.bookingRepo.DeletePromoCode(ctx, id)
+}
+
+// applyPromoCode проверяет промокод брони и добавляет скидку в ее стоимость.
+// Код блокируется до конца транзакции, поэтому лимиты погашений проверяются последовательно;
+// само погашение записывается после создания брони через redeemPromoCode
+func (s *bookingService) applyPromoCode(
+	txCtx context.Context,
+	booking *model.Booking,
+	roomType model.RoomType,
+) (*model.PromoCode, error) {
+	promo, err := s.bookingRepo.GetPromoCodeByCode(txCtx, model.NormalizePromoCode(*booking.PromoCode), true)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.WithMessage(errors.ErrInvalidInput, "unknown promo code")
+		}
+		return nil, err
+	}
+
+	if err = promo.CheckRedeemable(roomType, time.Now()); err != nil {
+		return nil, err
+	}
+
+	if promo.MaxRedemptionsPerUser != nil {
+		redeemed, err := s.bookingRepo.CountPromoRedemptions(
+			txCtx,
+			promo.ID,
+			booking.UserID,
+			strings.ToLower(booking.GuestEmail),
+		)
+		if err != nil {
+			return nil, err
+		}
+		if redeemed >= *promo.MaxRedemptionsPerUser {
+			return nil, errors.WithMessage(errors.ErrConflict, "promo code redemption limit per guest reached")
+		}
+	}
+
+	if err = s.discountBooking(booking, promo); err != nil {
+		return nil, err
+	}
+	return promo, nil
+}
+
+// redeemPromoCode записывает погашение кода созданной бронью
+func (s *bookingService) redeemPromoCode(txCtx context.Context, booking *model.Booking, promo *model.PromoCode) error {
+	return s.bookingRepo.AddPromoRedemption(
+		txCtx, model.PromoRedemption{
+			PromoCodeID: promo.ID,
+			BookingID:   booking.ID,
+			UserID:      booking.UserID,
+			GuestEmail:  strings.ToLower(booking.GuestEmail),
+		},
+	)
+}
+
+// reapplyPromoCode повторно применяет скидку уже погашенного кода после пересчета стоимости брони.
+// Период действия и лимиты не проверяются — код был погашен при создании брони. Если код не действует
+// на новый тип комнаты, погашение освобождается; возвращает false, если скидка снята
+func (s *bookingService) reapplyPromoCode(
+	txCtx context.Context,
+	booking *model.Booking,
+	roomType model.RoomType,
+) (bool, error) {
+	promo, err := s.bookingRepo.GetPromoCodeByCode(txCtx, *booking.PromoCode, false)
+	if err != nil && !errors.IsNotFound(err) {
+		return false, err
+	}
+
+	if promo == nil || !promo.AppliesTo(roomType) {
+		booking.PromoCode = nil
+		return false, s.bookingRepo.ReleasePromoRedemption(txCtx, booking.ID)
+	}
+
+	return true, s.discountBooking(booking, promo)
+}
+
+func (s *bookingService) discountBooking(booking *model.Booking, promo *model.PromoCode) error {
+	booking.PromoCode = &promo.Code
+	booking.PriceBreakdown = promo.Apply(booking.PriceBreakdown)
+
+	total, err := booking.PriceBreakdown.Total(booking.TotalPrice.Currency)
+	if err != nil {
+		return err
+	}
+	booking.TotalPrice = total
+	return nil
+}
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+	"github.com/semho/hotel-booking/pkg/errors"
+	"github.com/semho/hotel-booking/pkg/logger"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	maxReservationRooms = 20
+
+	// Без похожих символов (0/O, 1/I), чтобы код было удобно диктовать
+	confirmationCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	confirmationCodeLength   = 8
+	// Сколько раз код подтверждения генерируется заново, если сгенерированный уже занят
+	confirmationCodeAttempts = 5
+)
+
+// CreateReservation бронирует все запрошенные комнаты в одной транзакции:
+// если хотя бы одна комната недоступна, не создается ни одна бронь
+func (s *bookingService) CreateReservation(
+	ctx context.Context,
+	reservation *model.Reservation,
+	checkIn, checkOut time.Time,
+	rooms []model.ReservationRoom,
+) error {
+	if len(rooms) == 0 {
+		return errors.WithMessage(errors.ErrInvalidInput, "at least one room is required")
+	}
+	checkIn, checkOut = s.calendar.Stay(checkIn, checkOut)
+	if len(rooms) > maxReservationRooms {
+		return errors.WithMessage(
+			errors.ErrInvalidInput,
+			fmt.Sprintf("reservation cannot contain more than %d rooms", maxReservationRooms),
+		)
+	}
+
+	if err := s.validateBooking(
+		reservation.ContactName,
+		reservation.ContactEmail,
+		timestamppb.New(checkIn),
+		timestamppb.New(checkOut),
+	); err != nil {
+		return err
+	}
+
+	requestedRoomIDs := make(map[uuid.UUID]struct{}, len(rooms))
+	for _, r := range rooms {
+		if r.RoomID == nil {
+			continue
+		}
+		if _, ok := requestedRoomIDs[*r.RoomID]; ok {
+			return errors.WithMessage(errors.ErrInvalidInput, "the same room is requested more than once")
+		}
+		requestedRoomIDs[*r.RoomID] = struct{}{}
+	}
+
+	return s.uow.WithinTransaction(
+		ctx, func(txCtx context.Context) error {
+			if err := s.createReservationWithCode(txCtx, reservation); err != nil {
+				return err
+			}
+
+			// Брони создаются последовательно в одной транзакции, поэтому уже выбранные комнаты
+			// считаются занятыми при выборе следующих
+			reservation.Bookings = make([]model.Booking, 0, len(rooms))
+			for i, r := range rooms {
+				guestName := r.GuestName
+				if guestName == "" {
+					guestName = reservation.ContactName
+				}
+
+				booking := &model.Booking{
+					UserID:        reservation.UserID,
+					GuestName:     guestName,
+					GuestEmail:    reservation.ContactEmail,
+					GuestPhone:    reservation.ContactPhone,
+					CheckIn:       checkIn,
+					CheckOut:      checkOut,
+					ReservationID: &reservation.ID,
+				}
+				if r.RoomID != nil {
+					booking.RoomID = *r.RoomID
+				}
+
+				if err := s.createBookingInTx(txCtx, booking, r.Type, r.Capacity, s.holdTTL); err != nil {
+					return fmt.Errorf("room %d: %w", i+1, err)
+				}
+
+				reservation.Bookings = append(reservation.Bookings, *booking)
+			}
+
+			return nil
+		},
+	)
+}
+
+func (s *bookingService) GetReservation(ctx context.Context, reservationID uuid.UUID) (*model.Reservation, error) {
+	return s.bookingRepo.GetReservation(ctx, reservationID)
+}
+
+// createReservationWithCode создает групповую бронь, генерируя новый код подтверждения, пока не найдется свободный
+func (s *bookingService) createReservationWithCode(ctx context.Context, reservation *model.Reservation) error {
+	for attempt := 1; attempt <= confirmationCodeAttempts; attempt++ {
+		code, err := generateConfirmationCode()
+		if err != nil {
+			return err
+		}
+		reservation.ConfirmationCode = code
+
+		created, err := s.bookingRepo.CreateReservation(ctx, reservation)
+		if err != nil {
+			return err
+		}
+		if created {
+			return nil
+		}
+		logger.Log.Warn("confirmation code is already in use, generating another", "attempt", attempt)
+	}
+
+	return errors.WithMessage(
+		errors.ErrInternal,
+		fmt.Sprintf("failed to generate a unique confirmation code in %d attempts", confirmationCodeAttempts),
+	)
+}
+
+func generateConfirmationCode() (string, error) {
+	code := make([]byte, confirmationCodeLength)
+	alphabetSize := big.NewInt(int64(len(confirmationCodeAlphabet)))
+	for i := range code {
+		n, err := rand.Int(rand.Reader, alphabetSize)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate confirmation code: %w", err)
+		}
+		code[i] = confirmationCodeAlphabet[n.Int64()]
+	}
+	return string(code), nil
+}
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+)
+
+func (s *bookingService) CreateStayRestriction(ctx context.Context, restriction *model.StayRestriction) error {
+	if err := restriction.Validate(); err != nil {
+		return err
+	}
+	return s.bookingRepo.CreateStayRestriction(ctx, restriction)
+}
+
+func (s *bookingService) GetStayRestriction(ctx context.Context, id uuid.UUID) (*model.StayRestriction, error) {
+	return s.bookingRepo.GetStayRestriction(ctx, id)
+}
+
+func (s *bookingService) ListStayRestrictions(
+	ctx context.Context,
+	filter model.StayRestrictionFilter,
+) ([]model.StayRestriction, error) {
+	return s.bookingRepo.ListStayRestrictions(ctx, filter)
+}
+
+func (s *bookingService) UpdateStayRestriction(ctx context.Context, restriction *model.StayRestriction) error {
+	if err := restriction.Validate(); err != nil {
+		return err
+	}
+	return s.bookingRepo.UpdateStayRestriction(ctx, restriction)
+}
+
+func (s *bookingService) DeleteStayRestriction(ctx context.Context, id uuid.UUID) error {
+	return s.bookingRepo.DeleteStayRestriction(ctx, id)
+}
+
+// stayRestrictions возвращает правила, которые могут затронуть проживание [checkIn, checkOut),
+// и само проживание в датах отеля на момент бронирования now. roomType nil — правила всех типов
+func (s *bookingService) stayRestrictions(
+	ctx context.Context,
+	roomType *model.RoomType,
+	checkIn, checkOut, now time.Time,
+) (model.StayRestrictions, model.RestrictedStay, error) {
+	stay := model.RestrictedStay{
+		Arrival:     s.calendar.DateOf(checkIn),
+		Departure:   s.calendar.DateOf(checkOut),
+		Nights:      s.calendar.Nights(checkIn, checkOut),
+		AdvanceDays: nightsBetween(s.calendar.DateOf(now), s.calendar.DateOf(checkIn)),
+	}
+
+	restrictions, err := s.bookingRepo.ListStayRestrictions(
+		ctx, model.StayRestrictionFilter{
+			From:     &stay.Arrival,
+			To:       &stay.Departure,
+			RoomType: roomType,
+		},
+	)
+	if err != nil {
+		return nil, stay, err
+	}
+	return restrictions, stay, nil
+}
+
+// checkStayRestrictions проверяет, что проживание в комнате типа roomType не нарушает ограничений продаж
+func (s *bookingService) checkStayRestrictions(
+	ctx context.Context,
+	roomType model.RoomType,
+	checkIn, checkOut time.Time,
+) error {
+	restrictions, stay, err := s.stayRestrictions(ctx, &roomType, checkIn, checkOut, time.Now())
+	if err != nil {
+		return err
+	}
+	return restrictions.Check(roomType, stay)
+}
+
+// filterRestrictedRooms убирает из поиска комнаты типов, для которых проживание нарушает ограничения
+func (s *bookingService) filterRestrictedRooms(
+	ctx context.Context,
+	rooms []model.Room,
+	checkIn, checkOut time.Time,
+) ([]model.Room, error) {
+	if len(rooms) == 0 {
+		return rooms, nil
+	}
+
+	restrictions, stay, err := s.stayRestrictions(ctx, nil, checkIn, checkOut, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if len(restrictions) == 0 {
+		return rooms, nil
+	}
+
+	allowed := make(map[model.RoomType]bool)
+	var result []model.Room
+	for _, room := range rooms {
+		ok, checked := allowed[room.Type]
+		if !checked {
+			ok = restrictions.Check(room.Type, stay) == nil
+			allowed[room.Type] = ok
+		}
+		if ok {
+			result = append(result, room)
+		}
+	}
+	return result, nil
+}
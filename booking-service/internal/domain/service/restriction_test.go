@@ -0,0 +1,92 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+	"github.com/semho/hotel-booking/booking-service/internal/infrastructure/repository/postgres/pgtest"
+	"github.com/semho/hotel-booking/pkg/errors"
+	"github.com/semho/hotel-booking/pkg/proto/room_v1/room"
+)
+
+// Изменение дат брони проверяется ограничениями продаж так же, как новая бронь:
+// нарушающее правило изменение отклоняется, а бронь остается прежней
+func TestModifyBookingChecksStayRestrictions(t *testing.T) {
+	db := pgtest.Open(t)
+	bookingService := newTestBookingService(
+		db,
+		&roomClientStub{rooms: []model.Room{testRoom("401", 2, room.RoomStatus_ROOM_STATUS_AVAILABLE)}},
+	)
+
+	ctx := context.Background()
+	checkIn := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 1, 0)
+	checkOut := checkIn.AddDate(0, 0, 3)
+	closedArrival := checkIn.AddDate(0, 0, 5)
+
+	minStay := 3
+	deluxe := room.RoomType_ROOM_TYPE_DELUXE
+	restrictions := []model.StayRestriction{
+		{Name: "min stay", DateFrom: checkIn, DateTo: checkIn.AddDate(0, 0, 10), RoomType: &deluxe, MinStay: &minStay},
+		{Name: "closed to arrival", DateFrom: closedArrival, DateTo: closedArrival, ClosedToArrival: true},
+	}
+	for i := range restrictions {
+		if err := bookingService.CreateStayRestriction(ctx, &restrictions[i]); err != nil {
+			t.Fatalf("create restriction %q: %v", restrictions[i].Name, err)
+		}
+	}
+
+	booking := &model.Booking{
+		GuestName:  "Restriction Check",
+		GuestEmail: "restriction-check@example.com",
+		CheckIn:    checkIn,
+		CheckOut:   checkOut,
+	}
+	if err := bookingService.CreateBooking(ctx, booking, deluxe, 0, nil); err != nil {
+		t.Fatalf("create booking: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		changes model.BookingChanges
+	}{
+		{name: "shorter than min stay", changes: model.BookingChanges{CheckOut: timePtr(checkIn.AddDate(0, 0, 2))}},
+		{
+			name: "arrival on closed date",
+			changes: model.BookingChanges{
+				CheckIn:  timePtr(closedArrival),
+				CheckOut: timePtr(closedArrival.AddDate(0, 0, 3)),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := bookingService.ModifyBooking(ctx, booking.ID, tt.changes, "admin")
+			if !errors.IsInvalidInput(err) {
+				t.Fatalf("expected restriction error, got %v", err)
+			}
+
+			current, err := bookingService.GetBooking(ctx, booking.ID)
+			if err != nil {
+				t.Fatalf("get booking: %v", err)
+			}
+			if !current.CheckIn.Equal(booking.CheckIn) || !current.CheckOut.Equal(booking.CheckOut) {
+				t.Fatalf(
+					"rejected change modified booking dates: %s..%s, want %s..%s",
+					current.CheckIn, current.CheckOut, booking.CheckIn, booking.CheckOut,
+				)
+			}
+		})
+	}
+
+	// Изменение, не нарушающее правил, проходит
+	changes := model.BookingChanges{CheckOut: timePtr(checkIn.AddDate(0, 0, 4))}
+	if _, err := bookingService.ModifyBooking(ctx, booking.ID, changes, "admin"); err != nil {
+		t.Fatalf("modify booking within restrictions: %v", err)
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+	"github.com/semho/hotel-booking/pkg/errors"
+	"github.com/semho/hotel-booking/pkg/logger"
+	pb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
+	"github.com/semho/hotel-booking/pkg/proto/room_v1/room"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Срок подтверждения брони, предложенной из листа ожидания, если не задан в конфиге
+	defaultWaitlistHoldTTL = 2 * time.Hour
+	// Сколько ожидающих записей проверяется при освобождении комнаты
+	waitlistCandidatesLimit = 50
+)
+
+func (s *bookingService) JoinWaitlist(ctx context.Context, entry *model.WaitlistEntry) error {
+	if entry.UserID == uuid.Nil {
+		return errors.WithMessage(errors.ErrInvalidInput, "user_id is required")
+	}
+	entry.CheckIn, entry.CheckOut = s.calendar.Stay(entry.CheckIn, entry.CheckOut)
+	if err := s.validateBooking(
+		entry.GuestName,
+		entry.GuestEmail,
+		timestamppb.New(entry.CheckIn),
+		timestamppb.New(entry.CheckOut),
+	); err != nil {
+		return err
+	}
+	if !entry.CheckIn.After(time.Now()) {
+		return errors.WithMessage(errors.ErrInvalidInput, "check-in date must be in the future")
+	}
+	if entry.Capacity != nil && *entry.Capacity <= 0 {
+		return errors.WithMessage(errors.ErrInvalidInput, "capacity must be positive")
+	}
+
+	entry.Status = pb.WaitlistStatus_WAITLIST_STATUS_WAITING
+	if err := s.bookingRepo.CreateWaitlistEntry(ctx, entry); err != nil {
+		return err
+	}
+
+	// Комната могла освободиться раньше, чем гость встал в очередь: без этого запись ждала бы следующей отмены
+	s.offerFreedRoom(ctx, entry.CheckIn, entry.CheckOut)
+
+	current, err := s.bookingRepo.GetWaitlistEntry(ctx, entry.ID)
+	if err != nil {
+		return err
+	}
+	*entry = *current
+	return nil
+}
+
+func (s *bookingService) GetWaitlistEntry(ctx context.Context, id uuid.UUID) (*model.WaitlistEntry, error) {
+	return s.bookingRepo.GetWaitlistEntry(ctx, id)
+}
+
+func (s *bookingService) ListWaitlistEntries(
+	ctx context.Context,
+	filter model.WaitlistFilter,
+) ([]model.WaitlistEntry, error) {
+	return s.bookingRepo.ListWaitlistEntries(ctx, filter)
+}
+
+func (s *bookingService) LeaveWaitlist(ctx context.Context, id uuid.UUID) (*model.WaitlistEntry, error) {
+	var entry *model.WaitlistEntry
+	err := s.uow.WithinTransaction(
+		ctx, func(txCtx context.Context) error {
+			locked, err := s.bookingRepo.LockWaitingEntry(txCtx, id)
+			if err != nil {
+				return err
+			}
+			if locked == nil {
+				// Запись не найдена, уже не ожидает или прямо сейчас получает предложение
+				if _, err = s.bookingRepo.GetWaitlistEntry(txCtx, id); err != nil {
+					return err
+				}
+				return errors.WithMessage(
+					errors.ErrConflict,
+					"waitlist entry is not waiting, cancel the offered booking instead",
+				)
+			}
+
+			locked.Status = pb.WaitlistStatus_WAITLIST_STATUS_CANCELLED
+			if err = s.bookingRepo.UpdateWaitlistEntry(txCtx, locked); err != nil {
+				return err
+			}
+			entry = locked
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// offerFreedRoom предлагает комнату, освободившуюся на период [checkIn, checkOut), первой подходящей
+// записи листа ожидания. Вызывается после фиксации отмены, поэтому ошибки только логируются
+func (s *bookingService) offerFreedRoom(ctx context.Context, checkIn, checkOut time.Time) {
+	entryIDs, err := s.bookingRepo.FindWaitlistCandidates(ctx, checkIn, checkOut, time.Now(), waitlistCandidatesLimit)
+	if err != nil {
+		logger.Log.Error("failed to find waitlist candidates", "error", err)
+		return
+	}
+
+	for _, entryID := range entryIDs {
+		offered, err := s.offerWaitlistEntry(ctx, entryID)
+		if err != nil {
+			// Для этой записи нет подходящей свободной комнаты — она остается в очереди
+			if errors.IsConflict(err) || errors.IsInvalidInput(err) {
+				continue
+			}
+			logger.Log.Error("failed to offer room to waitlist entry", "entry_id", entryID, "error", err)
+			return
+		}
+		if offered {
+			return
+		}
+	}
+}
+
+// offerWaitlistEntry создает для записи PENDING бронь с увеличенным сроком удержания и уведомление гостю.
+// Каждая запись обрабатывается в своей транзакции: неудача одной не мешает предложить комнату следующей
+func (s *bookingService) offerWaitlistEntry(ctx context.Context, entryID uuid.UUID) (bool, error) {
+	var offered bool
+	err := s.uow.WithinTransaction(
+		ctx, func(txCtx context.Context) error {
+			entry, err := s.bookingRepo.LockWaitingEntry(txCtx, entryID)
+			if err != nil || entry == nil {
+				return err
+			}
+
+			roomType := room.RoomType_ROOM_TYPE_UNSPECIFIED
+			if entry.RoomType != nil {
+				roomType = *entry.RoomType
+			}
+			var capacity int32
+			if entry.Capacity != nil {
+				capacity = *entry.Capacity
+			}
+
+			booking := entry.NewBooking()
+			if err = s.createBookingInTx(txCtx, booking, roomType, capacity, s.waitlistHoldTTL); err != nil {
+				return err
+			}
+
+			entry.Status = pb.WaitlistStatus_WAITLIST_STATUS_OFFERED
+			entry.BookingID = &booking.ID
+			entry.OfferExpiresAt = booking.HoldExpiresAt
+			if err = s.bookingRepo.UpdateWaitlistEntry(txCtx, entry); err != nil {
+				return err
+			}
+
+			payload, err := json.Marshal(
+				model.WaitlistOfferPayload{
+					EntryID:        entry.ID,
+					BookingID:      booking.ID,
+					UserID:         entry.UserID,
+					GuestName:      entry.GuestName,
+					GuestEmail:     entry.GuestEmail,
+					CheckIn:        booking.CheckIn,
+					CheckOut:       booking.CheckOut,
+					TotalPrice:     booking.TotalPrice,
+					OfferExpiresAt: *booking.HoldExpiresAt,
+				},
+			)
+			if err != nil {
+				return fmt.Errorf("failed to marshal waitlist offer: %w", err)
+			}
+			err = s.bookingRepo.AddNotificationEvent(
+				txCtx, &model.NotificationEvent{
+					Type:    model.NotificationWaitlistOffer,
+					Payload: payload,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			logger.Log.Info(
+				"room offered to waitlist entry",
+				"entry_id", entry.ID,
+				"booking_id", booking.ID,
+				"offer_expires_at", booking.HoldExpiresAt,
+			)
+			offered = true
+			return nil
+		},
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return offered, nil
+}
+
+// releaseCancelledBooking освобождает ресурсы отмененной брони в транзакции отмены: погашение промокода
+// и предложение листа ожидания, если бронь была создана из него (offerStatus — итоговый статус записи)
+func (s *bookingService) releaseCancelledBooking(
+	txCtx context.Context,
+	bookingID uuid.UUID,
+	offerStatus model.WaitlistStatus,
+) error {
+	// Отмененная бронь не расходует лимит промокода
+	if err := s.bookingRepo.ReleasePromoRedemption(txCtx, bookingID); err != nil {
+		return err
+	}
+	return s.bookingRepo.CloseWaitlistOffer(txCtx, bookingID, offerStatus)
+}
@@ -3,6 +3,10 @@ package room
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
+	"sort"
+
 	"github.com/google/uuid"
 	"github.com/semho/hotel-booking/booking-service/internal/api/grpc/mapper"
 	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
@@ -34,6 +38,36 @@ func (c *roomClient) GetAvailableRooms(ctx context.Context, params model.SearchR
 	return mapper.ProtoToRooms(resp.Rooms), nil
 }
 
+// GetAllRooms собирает комнаты всех статусов: room service отдает комнаты только одного статуса за запрос.
+// Статусы опрашиваются по возрастанию, результат упорядочен по номеру комнаты
+func (c *roomClient) GetAllRooms(ctx context.Context, params model.SearchRoomsParams) ([]model.Room, error) {
+	var rooms []model.Room
+	for _, value := range slices.Sorted(maps.Keys(roompb.RoomStatus_name)) {
+		status := model.RoomStatus(value)
+		if status == roompb.RoomStatus_ROOM_STATUS_UNSPECIFIED {
+			continue
+		}
+
+		params.Status = &status
+		statusRooms, err := c.GetAvailableRooms(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		rooms = append(rooms, statusRooms...)
+	}
+
+	sort.Slice(
+		rooms, func(i, j int) bool {
+			if rooms[i].Number != rooms[j].Number {
+				return rooms[i].Number < rooms[j].Number
+			}
+			return rooms[i].ID < rooms[j].ID
+		},
+	)
+
+	return rooms, nil
+}
+
 func (c *roomClient) GetRoomsCount(ctx context.Context, params model.SearchRoomsParams) (int32, error) {
 	req := mapper.SearchParamsToProto(params)
 	resp, err := c.client.GetRoomsCount(ctx, req)
@@ -71,3 +105,16 @@ func (c *roomClient) GetFirstAvailableRoom(ctx context.Context, params model.Sea
 
 	return mapper.ProtoToRoom(resp.Room), nil
 }
+
+func (c *roomClient) UpdateRoomStatus(ctx context.Context, roomID uuid.UUID, status model.RoomStatus) error {
+	req := &roompb.UpdateRoomStatusRequest{
+		Id:     roomID.String(),
+		Status: status,
+	}
+
+	if _, err := c.client.UpdateRoomStatus(ctx, req); err != nil {
+		return fmt.Errorf("failed to update room status: %w", err)
+	}
+
+	return nil
+}
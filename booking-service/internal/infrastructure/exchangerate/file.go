@@ -0,0 +1,99 @@
+package exchangerate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/semho/hotel-booking/booking-service/internal/domain/port"
+	"github.com/semho/hotel-booking/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// Содержимое файла курсов: сколько единиц каждой валюты стоит одна единица базовой
+type ratesFile struct {
+	Base  string                     `json:"base"`
+	Rates map[string]decimal.Decimal `json:"rates"`
+}
+
+// fileProvider читает курсы из JSON-файла и перечитывает его при изменении,
+// поэтому работает без доступа к внешним сервисам
+type fileProvider struct {
+	path string
+
+	mu      sync.Mutex
+	modTime time.Time
+	rates   map[string]decimal.Decimal
+}
+
+func NewFileProvider(path string) (port.ExchangeRateProvider, error) {
+	p := &fileProvider{path: path}
+	if _, err := p.load(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *fileProvider) Rate(_ context.Context, from, to string) (decimal.Decimal, error) {
+	from, to = strings.ToUpper(from), strings.ToUpper(to)
+	if from == to {
+		return decimal.NewFromInt(1), nil
+	}
+
+	rates, err := p.load()
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	fromRate, ok := rates[from]
+	if !ok {
+		return decimal.Zero, errors.WithMessage(errors.ErrInvalidInput, fmt.Sprintf("no exchange rate for %s", from))
+	}
+	toRate, ok := rates[to]
+	if !ok {
+		return decimal.Zero, errors.WithMessage(errors.ErrInvalidInput, fmt.Sprintf("no exchange rate for %s", to))
+	}
+	return toRate.Div(fromRate), nil
+}
+
+// load возвращает курсы, перечитывая файл, если он изменился с прошлого чтения
+func (p *fileProvider) load() (map[string]decimal.Decimal, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat exchange rates file: %w", err)
+	}
+	if p.rates != nil && info.ModTime().Equal(p.modTime) {
+		return p.rates, nil
+	}
+
+	data, err := os.ReadFile(p.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read exchange rates file: %w", err)
+	}
+	var file ratesFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse exchange rates file: %w", err)
+	}
+	if file.Base == "" {
+		return nil, fmt.Errorf("exchange rates file has no base currency")
+	}
+
+	rates := make(map[string]decimal.Decimal, len(file.Rates)+1)
+	for currency, rate := range file.Rates {
+		if !rate.IsPositive() {
+			return nil, fmt.Errorf("invalid exchange rate for %s: %s", currency, rate)
+		}
+		rates[strings.ToUpper(currency)] = rate
+	}
+	rates[strings.ToUpper(file.Base)] = decimal.NewFromInt(1)
+
+	p.rates, p.modTime = rates, info.ModTime()
+	return rates, nil
+}
@@ -0,0 +1,117 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/semho/hotel-booking/booking-service/internal/config"
+	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+	"github.com/semho/hotel-booking/booking-service/internal/domain/port"
+	"github.com/semho/hotel-booking/pkg/logger"
+)
+
+// Письмо гостю, собранное из уведомления
+type message struct {
+	to      string
+	subject string
+	body    string
+}
+
+// NewEmailSender отправляет уведомления гостям через SMTP. Без SMTP сервера письма только пишутся в лог:
+// так уведомления доставляются и отмечаются отправленными при локальной разработке
+func NewEmailSender(cfg config.EmailConfig) port.NotificationSender {
+	if cfg.SMTPHost == "" {
+		return &logSender{}
+	}
+	return &smtpSender{cfg: cfg}
+}
+
+type smtpSender struct {
+	cfg config.EmailConfig
+}
+
+func (s *smtpSender) Send(_ context.Context, event model.NotificationEvent) error {
+	msg, err := render(event)
+	if err != nil {
+		return err
+	}
+
+	data := strings.Join(
+		[]string{
+			"From: " + s.cfg.From,
+			"To: " + msg.to,
+			"Subject: " + msg.subject,
+			"Content-Type: text/plain; charset=UTF-8",
+			"",
+			msg.body,
+		}, "\r\n",
+	)
+
+	var auth smtp.Auth
+	if s.cfg.SMTPUser != "" {
+		auth = smtp.PlainAuth("", s.cfg.SMTPUser, s.cfg.SMTPPassword, s.cfg.SMTPHost)
+	}
+	addr := net.JoinHostPort(s.cfg.SMTPHost, strconv.Itoa(s.cfg.SMTPPort))
+	if err = smtp.SendMail(addr, auth, s.cfg.From, []string{msg.to}, []byte(data)); err != nil {
+		return fmt.Errorf("failed to send %s notification: %w", event.Type, err)
+	}
+	return nil
+}
+
+type logSender struct{}
+
+func (s *logSender) Send(_ context.Context, event model.NotificationEvent) error {
+	msg, err := render(event)
+	if err != nil {
+		return err
+	}
+	logger.Log.Info(
+		"notification email (smtp is not configured)",
+		"event_id", event.ID,
+		"to", msg.to,
+		"subject", msg.subject,
+		"body", msg.body,
+	)
+	return nil
+}
+
+// render собирает письмо по типу уведомления
+func render(event model.NotificationEvent) (message, error) {
+	switch event.Type {
+	case model.NotificationWaitlistOffer:
+		var payload model.WaitlistOfferPayload
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return message{}, fmt.Errorf("invalid %s payload: %w", event.Type, err)
+		}
+		return message{
+			to:      payload.GuestEmail,
+			subject: "A room is available for your dates",
+			body: strings.Join(
+				[]string{
+					fmt.Sprintf("Hello, %s!", payload.GuestName),
+					"",
+					fmt.Sprintf(
+						"A room has become available for %s - %s, and we are holding it for you.",
+						payload.CheckIn.Format(time.DateOnly),
+						payload.CheckOut.Format(time.DateOnly),
+					),
+					fmt.Sprintf("Total price: %s %s", payload.TotalPrice, payload.TotalPrice.Currency),
+					fmt.Sprintf(
+						"Please confirm booking %s before %s, otherwise the room will be offered to the next guest.",
+						payload.BookingID,
+						payload.OfferExpiresAt.UTC().Format(time.RFC1123),
+					),
+					"",
+				}, "\r\n",
+			),
+		}, nil
+	default:
+		return message{}, fmt.Errorf("unsupported notification type %q", event.Type)
+	}
+}
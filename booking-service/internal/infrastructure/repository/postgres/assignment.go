@@ -0,0 +1,73 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+)
+
+// Активные брони без комнаты с заездом раньше checkInBefore, проживание которых еще не закончилось
+func unassignedBookingsCondition(checkInBefore, now time.Time) squirrel.And {
+	return squirrel.And{
+		squirrel.Eq{roomIdColumn: nil},
+		squirrel.Eq{currentStatusColumn: activeStatuses},
+		squirrel.Lt{checkInColumn: checkInBefore},
+		squirrel.Gt{checkOutColumn: now},
+	}
+}
+
+func (r *bookingRepository) ListUnassignedRoomTypes(
+	ctx context.Context,
+	checkInBefore, now time.Time,
+) ([]model.RoomType, error) {
+	sql, args, err := r.builder.
+		Select(roomTypeColumn).
+		Distinct().
+		From(bookingsTable).
+		Where(unassignedBookingsCondition(checkInBefore, now)).
+		OrderBy(roomTypeColumn).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var roomTypes []model.RoomType
+	if err = r.getExecutor(ctx).SelectContext(ctx, &roomTypes, sql, args...); err != nil {
+		return nil, fmt.Errorf("failed to list unassigned room types: %w", err)
+	}
+
+	return roomTypes, nil
+}
+
+// LockUnassignedBookings блокирует брони типа без комнаты в порядке заезда, более длинные проживания первыми
+func (r *bookingRepository) LockUnassignedBookings(
+	ctx context.Context,
+	roomType model.RoomType,
+	checkInBefore, now time.Time,
+) ([]model.Booking, error) {
+	where := unassignedBookingsCondition(checkInBefore, now)
+	where = append(where, squirrel.Eq{roomTypeColumn: roomType})
+
+	// Текущий статус нужен для записи в историю о назначении комнаты
+	sql, args, err := r.builder.
+		Select(bookingColumns...).
+		Columns(currentStatusColumn+" AS status_status").
+		From(bookingsTable).
+		Where(where).
+		OrderBy(checkInColumn, checkOutColumn+" DESC", createdAtColumn).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var rows []model.BookingRow
+	if err = r.getExecutor(ctx).SelectContext(ctx, &rows, sql, args...); err != nil {
+		return nil, fmt.Errorf("failed to lock unassigned bookings: %w", err)
+	}
+
+	return rowsToBookings(rows), nil
+}
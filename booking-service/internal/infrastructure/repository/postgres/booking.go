@@ -2,13 +2,17 @@ package postgres
 
 import (
 	"context"
+	stdSql "database/sql"
+	stdErrors "errors"
 	"fmt"
+	"github.com/semho/hotel-booking/pkg/errors"
 	pb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
 	"time"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
 	"github.com/semho/hotel-booking/booking-service/internal/domain/port"
 )
@@ -18,16 +22,33 @@ const (
 	statusHistoryTable = "booking_status_history"
 
 	// Columns for bookings
-	idColumn        = "id"
-	roomIdColumn    = "room_id"
-	userIdColumn    = "user_id"
-	guestNameColumn = "guest_name"
-	emailColumn     = "guest_email"
-	phoneColumn     = "guest_phone"
-	checkInColumn   = "check_in"
-	checkOutColumn  = "check_out"
-	priceColumn     = "total_price"
-	createdAtColumn = "created_at"
+	idColumn            = "id"
+	roomIdColumn        = "room_id"
+	userIdColumn        = "user_id"
+	guestNameColumn     = "guest_name"
+	emailColumn         = "guest_email"
+	phoneColumn         = "guest_phone"
+	checkInColumn       = "check_in"
+	checkOutColumn      = "check_out"
+	priceColumn         = "total_price"
+	createdAtColumn     = "created_at"
+	holdColumn          = "hold_expires_at"
+	checkedInColumn     = "checked_in_at"
+	checkedOutColumn    = "checked_out_at"
+	roomNumberColumn    = "room_number"
+	penaltyColumn       = "cancellation_penalty"
+	refundColumn        = "refund_amount"
+	cancelledColumn     = "cancelled_at"
+	reservationIdColumn = "reservation_id"
+	breakdownColumn     = "price_breakdown"
+	promoCodeColumn     = "promo_code"
+	guestProfileColumn  = "guest_profile_id"
+	// Денормализованный текущий статус, совпадает с последней записью истории
+	currentStatusColumn = "current_status"
+
+	// Exclusion constraint, запрещающий пересечение активных броней одной комнаты
+	noOverlapConstraint = "bookings_no_overlap"
+	exclusionViolation  = "23P01"
 
 	// Columns for status history
 	statusIdColumn  = "id"
@@ -38,6 +59,56 @@ const (
 	changedAtColumn = "changed_at"
 )
 
+// Колонки брони, соответствующие model.Booking
+var bookingColumns = []string{
+	idColumn,
+	roomIdColumn,
+	userIdColumn,
+	guestNameColumn,
+	emailColumn,
+	phoneColumn,
+	checkInColumn,
+	checkOutColumn,
+	priceColumn,
+	createdAtColumn,
+	holdColumn,
+	checkedInColumn,
+	checkedOutColumn,
+	roomNumberColumn,
+	penaltyColumn,
+	refundColumn,
+	cancelledColumn,
+	reservationIdColumn,
+	breakdownColumn,
+	promoCodeColumn,
+	roomTypeColumn,
+	capacityColumn,
+	guestProfileColumn,
+}
+
+// Активные брони занимают комнату на период проживания
+var activeStatuses = []model.BookingStatus{
+	pb.BookingStatus_BOOKING_STATUS_PENDING,
+	pb.BookingStatus_BOOKING_STATUS_CONFIRMED,
+}
+
+// Колонки брони с префиксом алиаса таблицы
+func prefixedBookingColumns(alias string) []string {
+	columns := make([]string, len(bookingColumns))
+	for i, column := range bookingColumns {
+		columns[i] = alias + "." + column
+	}
+	return columns
+}
+
+// Бронь без закрепленной комнаты хранится с room_id NULL: uuid.Nil записался бы как нулевой UUID
+func nullableRoomID(roomID uuid.UUID) interface{} {
+	if roomID == uuid.Nil {
+		return nil
+	}
+	return roomID
+}
+
 type bookingRepository struct {
 	db      *sqlx.DB
 	builder squirrel.StatementBuilderType
@@ -59,36 +130,59 @@ func (r *bookingRepository) getExecutor(ctx context.Context) port.SQLExecutor {
 	return r.db
 }
 
-func (r *bookingRepository) GetBookingsForPeriod(ctx context.Context, checkIn, checkOut time.Time) (
-	[]model.Booking,
-	error,
-) {
-	query := r.builder.
-		Select(
-			"b.*",
+// Последняя запись истории статусов брони b (по индексу idx_booking_status_history_latest)
+var latestStatusJoin = fmt.Sprintf(
+	"LATERAL (SELECT * FROM %s WHERE booking_id = b.id "+
+		"ORDER BY changed_at DESC, id DESC LIMIT 1) AS bsh ON TRUE",
+	statusHistoryTable,
+)
+
+// Выборка броней вместе с последней записью истории статусов, сам статус берется из current_status
+func (r *bookingRepository) selectBookingsWithStatus() squirrel.SelectBuilder {
+	return r.builder.
+		Select(prefixedBookingColumns("b")...).
+		Columns(
 			"bsh.id as status_id",
-			"bsh.status as status_status",
+			"b.current_status as status_status",
 			"bsh.reason as status_reason",
 			"bsh.changed_by as status_changed_by",
 			"bsh.changed_at as status_changed_at",
 		).
 		From(fmt.Sprintf("%s AS b", bookingsTable)).
-		LeftJoin(
-			fmt.Sprintf(
-				"(SELECT DISTINCT ON (booking_id) * "+
-					"FROM %s ORDER BY booking_id, changed_at DESC) AS bsh ON bsh.booking_id = b.id",
-				statusHistoryTable,
-			),
-		).
+		LeftJoin(latestStatusJoin)
+}
+
+func rowsToBookings(rows []model.BookingRow) []model.Booking {
+	result := make([]model.Booking, len(rows))
+	for i, row := range rows {
+		result[i] = row.Booking
+		result[i].CurrentStatus = &model.BookingStatusHistory{
+			ID:        row.StatusID,
+			BookingID: row.ID,
+			Status:    row.StatusStatus,
+			Reason:    row.StatusReason,
+			ChangedBy: row.StatusChangedBy,
+			ChangedAt: row.StatusChangedAt,
+		}
+	}
+	return result
+}
+
+func (r *bookingRepository) GetBookingsForPeriod(ctx context.Context, checkIn, checkOut time.Time) (
+	[]model.Booking,
+	error,
+) {
+	// Для проверки доступности детали статуса не нужны, поэтому история не подключается
+	query := r.builder.
+		Select(prefixedBookingColumns("b")...).
+		Columns("b.current_status as status_status").
+		From(fmt.Sprintf("%s AS b", bookingsTable)).
 		Where(
 			squirrel.And{
 				squirrel.Lt{fmt.Sprintf("%s.%s", "b", checkInColumn): checkOut},
 				squirrel.Gt{fmt.Sprintf("%s.%s", "b", checkOutColumn): checkIn},
 				// фильтр по активным статусам
-				squirrel.Or{
-					squirrel.Eq{"bsh.status": pb.BookingStatus_BOOKING_STATUS_PENDING},
-					squirrel.Eq{"bsh.status": pb.BookingStatus_BOOKING_STATUS_CONFIRMED},
-				},
+				squirrel.Eq{"b." + currentStatusColumn: activeStatuses},
 			},
 		).
 		OrderBy(fmt.Sprintf("%s.%s", "b", createdAtColumn))
@@ -103,20 +197,55 @@ func (r *bookingRepository) GetBookingsForPeriod(ctx context.Context, checkIn, c
 		return nil, fmt.Errorf("failed to get bookings: %w", err)
 	}
 
-	result := make([]model.Booking, len(rows))
-	for i, row := range rows {
-		result[i] = row.Booking
-		result[i].CurrentStatus = &model.BookingStatusHistory{
-			ID:        row.StatusID,
-			BookingID: row.ID,
-			Status:    row.StatusStatus,
-			Reason:    row.StatusReason,
-			ChangedBy: row.StatusChangedBy,
-			ChangedAt: row.StatusChangedAt,
-		}
+	return rowsToBookings(rows), nil
+}
+
+func (r *bookingRepository) ListBookings(ctx context.Context, filter model.BookingFilter) ([]model.Booking, error) {
+	conditions := squirrel.And{}
+	if filter.UserID != nil {
+		conditions = append(conditions, squirrel.Eq{"b." + userIdColumn: *filter.UserID})
+	}
+	if filter.RoomID != nil {
+		conditions = append(conditions, squirrel.Eq{"b." + roomIdColumn: *filter.RoomID})
+	}
+	if filter.GuestProfileID != nil {
+		conditions = append(conditions, squirrel.Eq{"b." + guestProfileColumn: *filter.GuestProfileID})
 	}
+	if filter.Status != nil {
+		conditions = append(conditions, squirrel.Eq{"b." + currentStatusColumn: *filter.Status})
+	}
+	if filter.From != nil {
+		conditions = append(conditions, squirrel.Gt{"b." + checkOutColumn: *filter.From})
+	}
+	if filter.To != nil {
+		conditions = append(conditions, squirrel.Lt{"b." + checkInColumn: *filter.To})
+	}
+	if filter.Cursor != nil {
+		conditions = append(
+			conditions,
+			squirrel.Expr("(b.created_at, b.id) < (?, ?)", filter.Cursor.CreatedAt, filter.Cursor.ID),
+		)
+	}
+
+	query := r.selectBookingsWithStatus().
+		Where(conditions).
+		OrderBy("b."+createdAtColumn+" DESC", "b."+idColumn+" DESC")
 
-	return result, nil
+	if filter.Limit > 0 {
+		query = query.Limit(uint64(filter.Limit))
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var rows []model.BookingRow
+	if err := r.getExecutor(ctx).SelectContext(ctx, &rows, sql, args...); err != nil {
+		return nil, fmt.Errorf("failed to list bookings: %w", err)
+	}
+
+	return rowsToBookings(rows), nil
 }
 
 func (r *bookingRepository) Create(ctx context.Context, booking *model.Booking) error {
@@ -131,9 +260,16 @@ func (r *bookingRepository) Create(ctx context.Context, booking *model.Booking)
 			checkInColumn,
 			checkOutColumn,
 			priceColumn,
+			holdColumn,
+			reservationIdColumn,
+			breakdownColumn,
+			promoCodeColumn,
+			roomTypeColumn,
+			capacityColumn,
+			guestProfileColumn,
 		).
 		Values(
-			booking.RoomID,
+			nullableRoomID(booking.RoomID),
 			booking.UserID,
 			booking.GuestName,
 			booking.GuestEmail,
@@ -141,6 +277,13 @@ func (r *bookingRepository) Create(ctx context.Context, booking *model.Booking)
 			booking.CheckIn,
 			booking.CheckOut,
 			booking.TotalPrice,
+			booking.HoldExpiresAt,
+			booking.ReservationID,
+			booking.PriceBreakdown,
+			booking.PromoCode,
+			booking.RoomType,
+			booking.Capacity,
+			booking.GuestProfileID,
 		).
 		Suffix("RETURNING id, created_at")
 
@@ -148,7 +291,18 @@ func (r *bookingRepository) Create(ctx context.Context, booking *model.Booking)
 	if err != nil {
 		return fmt.Errorf("failed to build query: %w", err)
 	}
-	return r.getExecutor(ctx).QueryRowContext(ctx, sql, args...).Scan(&booking.ID, &booking.CreatedAt)
+
+	err = r.getExecutor(ctx).QueryRowContext(ctx, sql, args...).Scan(&booking.ID, &booking.CreatedAt)
+	if err != nil {
+		// Параллельная транзакция успела забронировать эту комнату на пересекающиеся даты
+		var pqErr *pq.Error
+		if stdErrors.As(err, &pqErr) && pqErr.Code == exclusionViolation && pqErr.Constraint == noOverlapConstraint {
+			return errors.WithMessage(errors.ErrConflict, "room is already booked for these dates")
+		}
+		return fmt.Errorf("failed to create booking: %w", err)
+	}
+
+	return nil
 }
 
 func (r *bookingRepository) AddBookingStatus(
@@ -177,28 +331,69 @@ func (r *bookingRepository) AddBookingStatus(
 		return fmt.Errorf("failed to build query: %w", err)
 	}
 
-	return r.getExecutor(ctx).QueryRowContext(ctx, sql, args...).Scan(&status.ID, &status.ChangedAt)
+	if err = r.getExecutor(ctx).QueryRowContext(ctx, sql, args...).Scan(&status.ID, &status.ChangedAt); err != nil {
+		return fmt.Errorf("failed to add booking status: %w", err)
+	}
+
+	// Текущий статус используется в запросах доступности и exclusion constraint'е,
+	// поэтому обновляется вместе с историей (вызывающий код выполняет оба запроса в одной транзакции)
+	sql, args, err = r.builder.
+		Update(bookingsTable).
+		Set(currentStatusColumn, status.Status).
+		Where(squirrel.Eq{idColumn: bookingID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	if _, err = r.getExecutor(ctx).ExecContext(ctx, sql, args...); err != nil {
+		return fmt.Errorf("failed to update booking current status: %w", err)
+	}
+
+	return nil
+}
+
+func (r *bookingRepository) LockBooking(ctx context.Context, bookingID uuid.UUID) error {
+	query := r.builder.
+		Select(idColumn).
+		From(bookingsTable).
+		Where(squirrel.Eq{idColumn: bookingID}).
+		Suffix("FOR UPDATE")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var id uuid.UUID
+	if err = r.getExecutor(ctx).GetContext(ctx, &id, sql, args...); err != nil {
+		if stdErrors.Is(err, stdSql.ErrNoRows) {
+			return errors.WithMessage(errors.ErrNotFound, "booking not found")
+		}
+		return fmt.Errorf("failed to lock booking: %w", err)
+	}
+
+	return nil
 }
 
 func (r *bookingRepository) GetBookingWithStatus(ctx context.Context, bookingID uuid.UUID) (
 	*model.BookingWithStatus,
 	error,
 ) {
+	// Поля статуса выбираются с префиксом "status." для вложенной структуры BookingWithStatus.CurrentStatus
 	query := r.builder.
-		Select(
-			fmt.Sprintf("%s.*", bookingsTable),
-			fmt.Sprintf("%s.status as current_status", statusHistoryTable),
-			fmt.Sprintf("%s.changed_at as status_changed_at", statusHistoryTable),
-		).
-		From(bookingsTable).
-		Join(
-			fmt.Sprintf(
-				"(SELECT DISTINCT ON (booking_id) booking_id, status, changed_at "+
-					"FROM %s ORDER BY booking_id, changed_at DESC) AS %s ON %s.booking_id = %s.id",
-				statusHistoryTable, statusHistoryTable, statusHistoryTable, bookingsTable,
-			),
+		Select(prefixedBookingColumns("b")...).
+		Columns(
+			`bsh.id AS "status.id"`,
+			`bsh.booking_id AS "status.booking_id"`,
+			`b.current_status AS "status.status"`,
+			`bsh.reason AS "status.reason"`,
+			`bsh.changed_by AS "status.changed_by"`,
+			`bsh.changed_at AS "status.changed_at"`,
 		).
-		Where(squirrel.Eq{fmt.Sprintf("%s.id", bookingsTable): bookingID})
+		From(fmt.Sprintf("%s AS b", bookingsTable)).
+		Join(latestStatusJoin).
+		Where(squirrel.Eq{"b." + idColumn: bookingID})
 
 	sql, args, err := query.ToSql()
 	if err != nil {
@@ -207,11 +402,15 @@ func (r *bookingRepository) GetBookingWithStatus(ctx context.Context, bookingID
 
 	var booking model.BookingWithStatus
 	if err := r.getExecutor(ctx).GetContext(ctx, &booking, sql, args...); err != nil {
+		if stdErrors.Is(err, stdSql.ErrNoRows) {
+			return nil, errors.WithMessage(errors.ErrNotFound, "booking not found")
+		}
 		return nil, fmt.Errorf("failed to get booking: %w", err)
 	}
 
 	return &booking, nil
 }
+
 func (r *bookingRepository) GetBookingStatusHistory(
 	ctx context.Context,
 	bookingID uuid.UUID,
@@ -220,7 +419,7 @@ func (r *bookingRepository) GetBookingStatusHistory(
 		Select("*").
 		From(statusHistoryTable).
 		Where(squirrel.Eq{bookingIdColumn: bookingID}).
-		OrderBy(fmt.Sprintf("%s DESC", changedAtColumn))
+		OrderBy(fmt.Sprintf("%s DESC", changedAtColumn), fmt.Sprintf("%s DESC", statusIdColumn))
 
 	sql, args, err := query.ToSql()
 	if err != nil {
@@ -241,29 +440,41 @@ func (r *bookingRepository) GetBookedRoomIDs(
 	checkIn, checkOut time.Time,
 	forUpdate bool,
 ) ([]uuid.UUID, error) {
-	// Подзапрос для получения room_id
-	subQuery := r.builder.
-		Select("b.room_id").
-		From(fmt.Sprintf("%s AS b", bookingsTable)).
-		Where(
-			squirrel.And{
-				squirrel.Lt{"b.check_in": checkOut},
-				squirrel.Gt{"b.check_out": checkIn},
-				squirrel.Eq{"b.room_id": roomIDs},
-				squirrel.Expr(
-					"EXISTS (SELECT 1 FROM booking_status_history WHERE "+
-						"booking_id = b.id AND status IN (?, ?) "+
-						"AND changed_at = (SELECT MAX(changed_at) FROM booking_status_history WHERE booking_id = b.id))",
-					pb.BookingStatus_BOOKING_STATUS_PENDING,
-					pb.BookingStatus_BOOKING_STATUS_CONFIRMED,
-				),
-			},
-		)
+	return r.getBookedRoomIDs(ctx, roomIDs, checkIn, checkOut, uuid.Nil, forUpdate)
+}
+
+// Занятые комнаты без учета самой брони bookingID (для изменения брони), всегда с блокировкой
+func (r *bookingRepository) GetBookedRoomIDsExcept(
+	ctx context.Context,
+	bookingID uuid.UUID,
+	roomIDs []uuid.UUID,
+	checkIn, checkOut time.Time,
+) ([]uuid.UUID, error) {
+	return r.getBookedRoomIDs(ctx, roomIDs, checkIn, checkOut, bookingID, true)
+}
+
+func (r *bookingRepository) getBookedRoomIDs(
+	ctx context.Context,
+	roomIDs []uuid.UUID,
+	checkIn, checkOut time.Time,
+	excludeBookingID uuid.UUID,
+	forUpdate bool,
+) ([]uuid.UUID, error) {
+	// Активные брони комнат, пересекающиеся с периодом (индекс idx_bookings_active_room_dates)
+	conditions := squirrel.And{
+		squirrel.Lt{"b.check_in": checkOut},
+		squirrel.Gt{"b.check_out": checkIn},
+		squirrel.Eq{"b.room_id": roomIDs},
+		squirrel.Eq{"b." + currentStatusColumn: activeStatuses},
+	}
+	if excludeBookingID != uuid.Nil {
+		conditions = append(conditions, squirrel.NotEq{"b." + idColumn: excludeBookingID})
+	}
 
-	// Основной запрос с блокировкой
 	query := r.builder.
-		Select("t.room_id").
-		FromSelect(subQuery, "t")
+		Select("b.room_id").
+		From(fmt.Sprintf("%s AS b", bookingsTable)).
+		Where(conditions)
 
 	if forUpdate {
 		query = query.Suffix("FOR UPDATE")
@@ -281,3 +492,183 @@ func (r *bookingRepository) GetBookedRoomIDs(
 
 	return bookedRoomIDs, nil
 }
+
+// Число активных броней на каждую из ночей nights, ключ — дата ночи (YYYY-MM-DD)
+func (r *bookingRepository) CountActiveBookingsByNight(
+	ctx context.Context,
+	nights []time.Time,
+	excludeBookingID uuid.UUID,
+) (map[string]int, error) {
+	sql := fmt.Sprintf(
+		`SELECT n.idx AS night, COUNT(b.id) AS bookings
+		FROM unnest($1::timestamptz[]) WITH ORDINALITY AS n(midnight, idx)
+		LEFT JOIN %s AS b ON b.check_in < n.midnight AND b.check_out >= n.midnight
+			AND b.%s = ANY($2) AND b.%s <> $3
+		GROUP BY n.idx`,
+		bookingsTable, currentStatusColumn, idColumn,
+	)
+	args := []interface{}{nightMidnights(nights), pq.Array(activeStatusValues()), excludeBookingID}
+
+	var rows []struct {
+		Night    int `db:"night"`
+		Bookings int `db:"bookings"`
+	}
+	if err := r.getExecutor(ctx).SelectContext(ctx, &rows, sql, args...); err != nil {
+		return nil, fmt.Errorf("failed to count bookings by night: %w", err)
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[model.NightDate(nights[row.Night-1])] = row.Bookings
+	}
+	return counts, nil
+}
+
+// nightMidnights возвращает полночи после каждой из ночей: проживание занимает ночь,
+// если гость находится в номере в эту полночь (см. model.HotelCalendar.Occupies)
+func nightMidnights(nights []time.Time) interface{} {
+	midnights := make([]string, len(nights))
+	for i, night := range nights {
+		midnights[i] = night.AddDate(0, 0, 1).Format(time.RFC3339)
+	}
+	return pq.Array(midnights)
+}
+
+func activeStatusValues() []int32 {
+	statuses := make([]int32, len(activeStatuses))
+	for i, status := range activeStatuses {
+		statuses[i] = int32(status)
+	}
+	return statuses
+}
+
+// Обновление изменяемых полей брони (комната, гость, даты, стоимость, заселение, выезд и отмена)
+func (r *bookingRepository) Update(ctx context.Context, booking *model.Booking) error {
+	sql, args, err := r.builder.
+		Update(bookingsTable).
+		Set(roomIdColumn, nullableRoomID(booking.RoomID)).
+		Set(roomTypeColumn, booking.RoomType).
+		Set(capacityColumn, booking.Capacity).
+		Set(guestNameColumn, booking.GuestName).
+		Set(emailColumn, booking.GuestEmail).
+		Set(phoneColumn, booking.GuestPhone).
+		Set(guestProfileColumn, booking.GuestProfileID).
+		Set(checkInColumn, booking.CheckIn).
+		Set(checkOutColumn, booking.CheckOut).
+		Set(priceColumn, booking.TotalPrice).
+		Set(breakdownColumn, booking.PriceBreakdown).
+		Set(promoCodeColumn, booking.PromoCode).
+		Set(checkedInColumn, booking.CheckedInAt).
+		Set(checkedOutColumn, booking.CheckedOutAt).
+		Set(roomNumberColumn, booking.RoomNumber).
+		Set(penaltyColumn, booking.CancellationPenalty).
+		Set(refundColumn, booking.RefundAmount).
+		Set(cancelledColumn, booking.CancelledAt).
+		Where(squirrel.Eq{idColumn: booking.ID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	result, err := r.getExecutor(ctx).ExecContext(ctx, sql, args...)
+	if err != nil {
+		var pqErr *pq.Error
+		if stdErrors.As(err, &pqErr) && pqErr.Code == exclusionViolation && pqErr.Constraint == noOverlapConstraint {
+			return errors.WithMessage(errors.ErrConflict, "room is already booked for these dates")
+		}
+		return fmt.Errorf("failed to update booking: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if rows == 0 {
+		return errors.WithMessage(errors.ErrNotFound, "booking not found")
+	}
+
+	return nil
+}
+
+// Блокировка PENDING броней с истекшим сроком удержания.
+// SKIP LOCKED позволяет нескольким репликам обрабатывать разные брони, не дожидаясь друг друга
+func (r *bookingRepository) LockExpiredPendingBookings(ctx context.Context, now time.Time, limit int) (
+	[]uuid.UUID,
+	error,
+) {
+	query := r.builder.
+		Select(idColumn).
+		From(bookingsTable).
+		Where(
+			squirrel.And{
+				squirrel.Eq{currentStatusColumn: pb.BookingStatus_BOOKING_STATUS_PENDING},
+				squirrel.LtOrEq{holdColumn: now},
+			},
+		).
+		OrderBy(holdColumn).
+		Limit(uint64(limit)).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var ids []uuid.UUID
+	if err = r.getExecutor(ctx).SelectContext(ctx, &ids, sql, args...); err != nil {
+		return nil, fmt.Errorf("failed to lock expired bookings: %w", err)
+	}
+
+	return ids, nil
+}
+
+// Блокировка подтвержденных броней без заселения, у которых дата заезда раньше checkInBefore
+func (r *bookingRepository) LockNoShowCandidates(ctx context.Context, checkInBefore time.Time) (
+	[]uuid.UUID,
+	error,
+) {
+	return r.lockConfirmedBookings(
+		ctx,
+		squirrel.Eq{checkedInColumn: nil},
+		squirrel.Lt{checkInColumn: checkInBefore},
+	)
+}
+
+// Блокировка заселенных подтвержденных броней, у которых дата выезда не позже checkOutBefore
+func (r *bookingRepository) LockCompletionCandidates(ctx context.Context, checkOutBefore time.Time) (
+	[]uuid.UUID,
+	error,
+) {
+	return r.lockConfirmedBookings(
+		ctx,
+		squirrel.NotEq{checkedInColumn: nil},
+		squirrel.LtOrEq{checkOutColumn: checkOutBefore},
+	)
+}
+
+// Блокировка CONFIRMED броней по условиям; брони, занятые другими транзакциями, пропускаются
+func (r *bookingRepository) lockConfirmedBookings(ctx context.Context, conditions ...squirrel.Sqlizer) (
+	[]uuid.UUID,
+	error,
+) {
+	where := squirrel.And{squirrel.Eq{currentStatusColumn: pb.BookingStatus_BOOKING_STATUS_CONFIRMED}}
+	where = append(where, conditions...)
+
+	sql, args, err := r.builder.
+		Select(idColumn).
+		From(bookingsTable).
+		Where(where).
+		OrderBy(checkInColumn, idColumn).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var ids []uuid.UUID
+	if err = r.getExecutor(ctx).SelectContext(ctx, &ids, sql, args...); err != nil {
+		return nil, fmt.Errorf("failed to lock confirmed bookings: %w", err)
+	}
+
+	return ids, nil
+}
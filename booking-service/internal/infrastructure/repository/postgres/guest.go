@@ -0,0 +1,388 @@
+package postgres
+
+import (
+	"context"
+	stdSql "database/sql"
+	stdErrors "errors"
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+	"github.com/semho/hotel-booking/pkg/errors"
+	pb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
+)
+
+const (
+	guestProfilesTable = "guest_profiles"
+	guestContactsTable = "guest_contacts"
+
+	bedTypeColumn        = "bed_type"
+	preferredFloorColumn = "preferred_floor"
+	allergiesColumn      = "allergies"
+	contactTypeColumn    = "type"
+	contactValueColumn   = "value"
+	profileIdColumn      = "profile_id"
+
+	// Пространство ключей advisory-блокировок контактов гостей
+	guestContactLockSpace = "guest_contact"
+)
+
+// Колонки профиля со списками контактов; статистика проживаний добавляется в guestProfileQuery
+var guestProfileColumns = []string{
+	"p." + idColumn,
+	"p." + nameColumn,
+	"p." + bedTypeColumn,
+	"p." + preferredFloorColumn,
+	"p." + allergiesColumn,
+	"p." + createdAtColumn,
+	"p." + updatedAtColumn,
+	guestContactsArray(model.GuestContactEmail) + " AS emails",
+	guestContactsArray(model.GuestContactPhone) + " AS phones",
+}
+
+func guestContactsArray(contactType string) string {
+	return fmt.Sprintf(
+		"ARRAY(SELECT c.%[1]s FROM %[2]s c WHERE c.%[3]s = p.id AND c.%[4]s = '%[5]s' ORDER BY c.%[6]s, c.%[1]s)",
+		contactValueColumn,
+		guestContactsTable,
+		profileIdColumn,
+		contactTypeColumn,
+		contactType,
+		createdAtColumn,
+	)
+}
+
+// Завершенные проживания профиля p; стоимость учитывается только в валюте расчетов отеля
+var guestStaysJoin = fmt.Sprintf(
+	"LATERAL (SELECT COUNT(*) AS stay_count, "+
+		"COALESCE(SUM((b.total_price).amount) FILTER (WHERE (b.total_price).currency = ?), 0) AS lifetime_amount, "+
+		"MAX(b.check_out) AS last_stay_at "+
+		"FROM %s b WHERE b.guest_profile_id = p.id AND b.current_status = ?) AS s ON TRUE",
+	bookingsTable,
+)
+
+// guestProfileQuery выбирает профили вместе со статистикой проживаний в валюте currency
+func (r *bookingRepository) guestProfileQuery(currency string) squirrel.SelectBuilder {
+	return r.builder.
+		Select(guestProfileColumns...).
+		Column("s.stay_count").
+		Column(squirrel.Expr("ROW(s.lifetime_amount, ?)::money_amount AS lifetime_value", currency)).
+		Column("s.last_stay_at").
+		From(guestProfilesTable+" p").
+		LeftJoin(guestStaysJoin, currency, pb.BookingStatus_BOOKING_STATUS_COMPLETED)
+}
+
+// LockGuestContact сериализует до конца транзакции поиск и создание профиля по контакту,
+// чтобы параллельные брони одного нового гостя не создали два профиля
+func (r *bookingRepository) LockGuestContact(ctx context.Context, contact model.GuestContact) error {
+	_, err := r.getExecutor(ctx).ExecContext(
+		ctx,
+		"SELECT pg_advisory_xact_lock(hashtext($1), hashtext($2))",
+		guestContactLockSpace,
+		contact.Type+":"+contact.Value,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to lock guest contact: %w", err)
+	}
+	return nil
+}
+
+// FindGuestProfileID ищет профиль по контактам в порядке их перечисления, nil — профиля нет
+func (r *bookingRepository) FindGuestProfileID(
+	ctx context.Context,
+	contacts []model.GuestContact,
+) (*uuid.UUID, error) {
+	for _, contact := range contacts {
+		sql, args, err := r.builder.
+			Select(profileIdColumn).
+			From(guestContactsTable).
+			Where(squirrel.Eq{contactTypeColumn: contact.Type, contactValueColumn: contact.Value}).
+			ToSql()
+		if err != nil {
+			return nil, fmt.Errorf("failed to build query: %w", err)
+		}
+
+		var profileID uuid.UUID
+		err = r.getExecutor(ctx).GetContext(ctx, &profileID, sql, args...)
+		if stdErrors.Is(err, stdSql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to find guest profile: %w", err)
+		}
+		return &profileID, nil
+	}
+
+	return nil, nil
+}
+
+func (r *bookingRepository) CreateGuestProfile(ctx context.Context, profile *model.GuestProfile) error {
+	sql, args, err := r.builder.
+		Insert(guestProfilesTable).
+		Columns(nameColumn, bedTypeColumn, preferredFloorColumn, allergiesColumn).
+		Values(profile.Name, profile.BedType, profile.PreferredFloor, profile.Allergies).
+		Suffix("RETURNING id, created_at, updated_at").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	err = r.getExecutor(ctx).QueryRowContext(ctx, sql, args...).Scan(&profile.ID, &profile.CreatedAt, &profile.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create guest profile: %w", err)
+	}
+
+	return nil
+}
+
+// AddGuestContacts привязывает к профилю контакты, которые еще не принадлежат другим профилям
+func (r *bookingRepository) AddGuestContacts(
+	ctx context.Context,
+	profileID uuid.UUID,
+	contacts []model.GuestContact,
+) error {
+	if len(contacts) == 0 {
+		return nil
+	}
+
+	query := r.builder.
+		Insert(guestContactsTable).
+		Columns(contactTypeColumn, contactValueColumn, profileIdColumn).
+		Suffix("ON CONFLICT DO NOTHING")
+	for _, contact := range contacts {
+		query = query.Values(contact.Type, contact.Value, profileID)
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+	if _, err = r.getExecutor(ctx).ExecContext(ctx, sql, args...); err != nil {
+		return fmt.Errorf("failed to add guest contacts: %w", err)
+	}
+
+	return nil
+}
+
+func (r *bookingRepository) GetGuestProfile(
+	ctx context.Context,
+	id uuid.UUID,
+	currency string,
+) (*model.GuestProfile, error) {
+	sql, args, err := r.guestProfileQuery(currency).
+		Where(squirrel.Eq{"p." + idColumn: id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var profile model.GuestProfile
+	if err = r.getExecutor(ctx).GetContext(ctx, &profile, sql, args...); err != nil {
+		if stdErrors.Is(err, stdSql.ErrNoRows) {
+			return nil, errors.WithMessage(errors.ErrNotFound, "guest profile not found")
+		}
+		return nil, fmt.Errorf("failed to get guest profile: %w", err)
+	}
+
+	return &profile, nil
+}
+
+// SearchGuestProfiles ищет профили по части имени или контакта; email сравнивается без учета регистра,
+// телефон — по цифрам
+func (r *bookingRepository) SearchGuestProfiles(
+	ctx context.Context,
+	search model.GuestProfileSearch,
+	currency string,
+) ([]model.GuestProfile, error) {
+	query := r.guestProfileQuery(currency).
+		OrderBy("p."+nameColumn, "p."+idColumn)
+
+	if text := strings.TrimSpace(search.Query); text != "" {
+		matches := squirrel.Or{
+			squirrel.ILike{"p." + nameColumn: likePattern(text)},
+			guestContactMatch(model.GuestContactEmail, model.NormalizeEmail(text)),
+		}
+		if digits := strings.TrimPrefix(model.NormalizePhone(text), "+"); digits != "" {
+			matches = append(matches, guestContactMatch(model.GuestContactPhone, digits))
+		}
+		query = query.Where(matches)
+	}
+	if search.Limit > 0 {
+		query = query.Limit(uint64(search.Limit))
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var profiles []model.GuestProfile
+	if err = r.getExecutor(ctx).SelectContext(ctx, &profiles, sql, args...); err != nil {
+		return nil, fmt.Errorf("failed to search guest profiles: %w", err)
+	}
+
+	return profiles, nil
+}
+
+func guestContactMatch(contactType, value string) squirrel.Sqlizer {
+	return squirrel.Expr(
+		fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM %s c WHERE c.%s = p.id AND c.%s = ? AND c.%s LIKE ?)",
+			guestContactsTable,
+			profileIdColumn,
+			contactTypeColumn,
+			contactValueColumn,
+		),
+		contactType,
+		likePattern(value),
+	)
+}
+
+// likePattern ищет подстроку, экранируя спецсимволы LIKE
+func likePattern(value string) string {
+	return "%" + strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value) + "%"
+}
+
+// LockGuestProfiles блокирует строки профилей до конца транзакции, возвращает найденные идентификаторы
+func (r *bookingRepository) LockGuestProfiles(ctx context.Context, ids []uuid.UUID) ([]uuid.UUID, error) {
+	sql, args, err := r.builder.
+		Select(idColumn).
+		From(guestProfilesTable).
+		Where(squirrel.Eq{idColumn: ids}).
+		OrderBy(idColumn).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var locked []uuid.UUID
+	if err = r.getExecutor(ctx).SelectContext(ctx, &locked, sql, args...); err != nil {
+		return nil, fmt.Errorf("failed to lock guest profiles: %w", err)
+	}
+
+	return locked, nil
+}
+
+// UpdateGuestProfile заменяет имя и предпочтения гостя
+func (r *bookingRepository) UpdateGuestProfile(ctx context.Context, profile *model.GuestProfile) error {
+	sql, args, err := r.builder.
+		Update(guestProfilesTable).
+		Set(nameColumn, profile.Name).
+		Set(bedTypeColumn, profile.BedType).
+		Set(preferredFloorColumn, profile.PreferredFloor).
+		Set(allergiesColumn, profile.Allergies).
+		Set(updatedAtColumn, squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{idColumn: profile.ID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	result, err := r.getExecutor(ctx).ExecContext(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update guest profile: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if rows == 0 {
+		return errors.WithMessage(errors.ErrNotFound, "guest profile not found")
+	}
+
+	return nil
+}
+
+// MergeGuestProfiles переносит контакты и брони профилей sourceIDs в targetID и удаляет их
+func (r *bookingRepository) MergeGuestProfiles(ctx context.Context, targetID uuid.UUID, sourceIDs []uuid.UUID) error {
+	queries := []squirrel.Sqlizer{
+		r.builder.
+			Update(guestContactsTable).
+			Set(profileIdColumn, targetID).
+			Where(squirrel.Eq{profileIdColumn: sourceIDs}),
+		r.builder.
+			Update(bookingsTable).
+			Set(guestProfileColumn, targetID).
+			Where(squirrel.Eq{guestProfileColumn: sourceIDs}),
+		r.builder.
+			Delete(guestProfilesTable).
+			Where(squirrel.Eq{idColumn: sourceIDs}),
+	}
+
+	for _, query := range queries {
+		sql, args, err := query.ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build query: %w", err)
+		}
+		if _, err = r.getExecutor(ctx).ExecContext(ctx, sql, args...); err != nil {
+			return fmt.Errorf("failed to merge guest profiles: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ClaimGuestBookings привязывает к пользователю брони без владельца с email гостя email, их погашения
+// промокодов и групповые брони с тем же контактным email. Брони ищутся через профиль с этим email
+func (r *bookingRepository) ClaimGuestBookings(
+	ctx context.Context,
+	userID uuid.UUID,
+	email string,
+) ([]uuid.UUID, error) {
+	sql, args, err := r.builder.
+		Update(bookingsTable).
+		Set(userIdColumn, userID).
+		Where(squirrel.Eq{userIdColumn: nil}).
+		Where(
+			squirrel.Expr(
+				guestProfileColumn+" IN (SELECT "+profileIdColumn+" FROM "+guestContactsTable+
+					" WHERE "+contactTypeColumn+" = ? AND "+contactValueColumn+" = ?)",
+				model.GuestContactEmail, email,
+			),
+		).
+		Where(squirrel.Expr("LOWER(TRIM("+emailColumn+")) = ?", email)).
+		Suffix("RETURNING " + idColumn).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var claimed []uuid.UUID
+	if err = r.getExecutor(ctx).SelectContext(ctx, &claimed, sql, args...); err != nil {
+		return nil, fmt.Errorf("failed to claim guest bookings: %w", err)
+	}
+
+	queries := []squirrel.Sqlizer{
+		r.builder.
+			Update(reservationsTable).
+			Set(userIdColumn, userID).
+			Where(squirrel.Eq{userIdColumn: nil}).
+			Where(squirrel.Expr("LOWER(TRIM("+contactEmailColumn+")) = ?", email)),
+	}
+	if len(claimed) > 0 {
+		// Погашения переходят к пользователю, чтобы учитываться в его лимите промокода
+		queries = append(
+			queries,
+			r.builder.
+				Update(promoRedemptionsTable).
+				Set(userIdColumn, userID).
+				Where(squirrel.Eq{userIdColumn: nil, bookingIdColumn: claimed}),
+		)
+	}
+
+	for _, query := range queries {
+		sql, args, err = query.ToSql()
+		if err != nil {
+			return nil, fmt.Errorf("failed to build query: %w", err)
+		}
+		if _, err = r.getExecutor(ctx).ExecContext(ctx, sql, args...); err != nil {
+			return nil, fmt.Errorf("failed to claim guest bookings: %w", err)
+		}
+	}
+
+	return claimed, nil
+}
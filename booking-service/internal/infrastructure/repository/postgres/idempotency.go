@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+	stdSql "database/sql"
+	stdErrors "errors"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+)
+
+const (
+	idempotencyKeysTable = "idempotency_keys"
+
+	keyColumn         = "key"
+	requestHashColumn = "request_hash"
+	responseColumn    = "response"
+)
+
+// ClaimIdempotencyKey резервирует ключ пользователя за текущей транзакцией. Если ключ уже занят и не истек,
+// возвращает сохраненную запись и false. Конкурентный запрос с тем же ключом ждет на первичном ключе
+// (user_id, key) до завершения транзакции, занявшей ключ
+func (r *bookingRepository) ClaimIdempotencyKey(
+	ctx context.Context,
+	key model.IdempotencyKey,
+	expiredBefore time.Time,
+) (*model.IdempotencyRecord, bool, error) {
+	sql, args, err := r.builder.
+		Insert(idempotencyKeysTable).
+		Columns(userIdColumn, keyColumn, requestHashColumn).
+		Values(key.UserID, key.Key, key.RequestHash).
+		Suffix(
+			"ON CONFLICT (user_id, key) DO UPDATE SET "+
+				"request_hash = EXCLUDED.request_hash, booking_id = NULL, response = NULL, "+
+				"created_at = CURRENT_TIMESTAMP "+
+				"WHERE idempotency_keys.created_at < ? RETURNING key",
+			expiredBefore,
+		).
+		ToSql()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var claimed string
+	err = r.getExecutor(ctx).QueryRowContext(ctx, sql, args...).Scan(&claimed)
+	if err == nil {
+		return nil, true, nil
+	}
+	if !stdErrors.Is(err, stdSql.ErrNoRows) {
+		return nil, false, fmt.Errorf("failed to claim idempotency key: %w", err)
+	}
+
+	sql, args, err = r.builder.
+		Select(keyColumn, requestHashColumn, bookingIdColumn, responseColumn, createdAtColumn).
+		From(idempotencyKeysTable).
+		Where(squirrel.Eq{userIdColumn: key.UserID, keyColumn: key.Key}).
+		ToSql()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var record model.IdempotencyRecord
+	if err = r.getExecutor(ctx).GetContext(ctx, &record, sql, args...); err != nil {
+		return nil, false, fmt.Errorf("failed to get idempotency key: %w", err)
+	}
+
+	return &record, false, nil
+}
+
+// Сохранение ответа на запрос, занявший ключ
+func (r *bookingRepository) SaveIdempotencyResponse(
+	ctx context.Context,
+	key model.IdempotencyKey,
+	bookingID uuid.UUID,
+	response []byte,
+) error {
+	sql, args, err := r.builder.
+		Update(idempotencyKeysTable).
+		Set(bookingIdColumn, bookingID).
+		Set(responseColumn, response).
+		Where(squirrel.Eq{userIdColumn: key.UserID, keyColumn: key.Key}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	if _, err = r.getExecutor(ctx).ExecContext(ctx, sql, args...); err != nil {
+		return fmt.Errorf("failed to save idempotency response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+)
+
+// Пространство ключей advisory-блокировок продаж по типам комнат
+const roomTypeInventoryLockSpace = "room_type_inventory"
+
+// LockRoomTypeInventory сериализует до конца транзакции проверки потолка продаж типа комнат: брони без
+// комнаты не попадают под exclusion constraint, и без блокировки параллельные продажи превысили бы потолок
+func (r *bookingRepository) LockRoomTypeInventory(ctx context.Context, roomType model.RoomType) error {
+	_, err := r.getExecutor(ctx).ExecContext(
+		ctx,
+		"SELECT pg_advisory_xact_lock(hashtext($1), $2)",
+		roomTypeInventoryLockSpace,
+		int32(roomType),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to lock room type inventory: %w", err)
+	}
+	return nil
+}
+
+// CountRoomTypeBookingsByNight считает продажи типа комнат на каждую из ночей nights: активные брони
+// комнат roomIDs и брони типа без закрепленной комнаты (при заданной capacity — только брони, которым нужна
+// вместимость не меньше нее).
+// Ключ — дата ночи (YYYY-MM-DD)
+func (r *bookingRepository) CountRoomTypeBookingsByNight(
+	ctx context.Context,
+	roomType model.RoomType,
+	capacity *int32,
+	roomIDs []uuid.UUID,
+	nights []time.Time,
+	excludeBookingID uuid.UUID,
+) (map[string]model.RoomTypeNight, error) {
+	sql := fmt.Sprintf(
+		`SELECT n.idx AS night,
+			COUNT(b.id) AS booked,
+			COUNT(b.id) FILTER (WHERE b.%[3]s IS NULL) AS unassigned
+		FROM unnest($1::timestamptz[]) WITH ORDINALITY AS n(midnight, idx)
+		LEFT JOIN %[1]s AS b ON b.check_in < n.midnight AND b.check_out >= n.midnight
+			AND b.%[2]s = ANY($2) AND b.%[4]s <> $3
+			AND (b.%[3]s = ANY($4::uuid[])
+				OR (b.%[3]s IS NULL AND b.%[5]s = $5 AND ($6::int IS NULL OR b.%[6]s >= $6)))
+		GROUP BY n.idx`,
+		bookingsTable, currentStatusColumn, roomIdColumn, idColumn, roomTypeColumn, capacityColumn,
+	)
+	ids := make([]string, len(roomIDs))
+	for i, id := range roomIDs {
+		ids[i] = id.String()
+	}
+	args := []interface{}{
+		nightMidnights(nights),
+		pq.Array(activeStatusValues()),
+		excludeBookingID,
+		pq.Array(ids),
+		roomType,
+		capacity,
+	}
+
+	var rows []struct {
+		Night int `db:"night"`
+		model.RoomTypeNight
+	}
+	if err := r.getExecutor(ctx).SelectContext(ctx, &rows, sql, args...); err != nil {
+		return nil, fmt.Errorf("failed to count room type bookings by night: %w", err)
+	}
+
+	counts := make(map[string]model.RoomTypeNight, len(rows))
+	for _, row := range rows {
+		counts[model.NightDate(nights[row.Night-1])] = row.RoomTypeNight
+	}
+	return counts, nil
+}
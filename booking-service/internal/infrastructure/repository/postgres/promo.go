@@ -0,0 +1,308 @@
+package postgres
+
+import (
+	"context"
+	stdSql "database/sql"
+	stdErrors "errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+	"github.com/semho/hotel-booking/pkg/errors"
+)
+
+const (
+	promoCodesTable       = "promo_codes"
+	promoRedemptionsTable = "promo_redemptions"
+
+	codeColumn                  = "code"
+	discountTypeColumn          = "discount_type"
+	discountPercentColumn       = "discount_percent"
+	discountAmountColumn        = "discount_amount"
+	validFromColumn             = "valid_from"
+	validToColumn               = "valid_to"
+	roomTypesColumn             = "room_types"
+	maxRedemptionsColumn        = "max_redemptions"
+	maxRedemptionsPerUserColumn = "max_redemptions_per_user"
+	redemptionsColumn           = "redemptions"
+	activeColumn                = "active"
+	updatedAtColumn             = "updated_at"
+	promoCodeIdColumn           = "promo_code_id"
+
+	foreignKeyViolation = "23503"
+	promoCodeUnique     = "promo_codes_code_unique"
+)
+
+var promoCodeColumns = []string{
+	idColumn,
+	codeColumn,
+	discountTypeColumn,
+	discountPercentColumn,
+	discountAmountColumn,
+	validFromColumn,
+	validToColumn,
+	roomTypesColumn,
+	maxRedemptionsColumn,
+	maxRedemptionsPerUserColumn,
+	redemptionsColumn,
+	activeColumn,
+	createdAtColumn,
+	updatedAtColumn,
+}
+
+func (r *bookingRepository) CreatePromoCode(ctx context.Context, promo *model.PromoCode) error {
+	sql, args, err := r.builder.
+		Insert(promoCodesTable).
+		Columns(
+			codeColumn,
+			discountTypeColumn,
+			discountPercentColumn,
+			discountAmountColumn,
+			validFromColumn,
+			validToColumn,
+			roomTypesColumn,
+			maxRedemptionsColumn,
+			maxRedemptionsPerUserColumn,
+			activeColumn,
+		).
+		Values(
+			promo.Code,
+			promo.DiscountType,
+			promo.DiscountPercent,
+			promo.DiscountAmount,
+			promo.ValidFrom,
+			promo.ValidTo,
+			promo.RoomTypes,
+			promo.MaxRedemptions,
+			promo.MaxRedemptionsPerUser,
+			promo.Active,
+		).
+		Suffix("RETURNING id, created_at, updated_at").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	err = r.getExecutor(ctx).QueryRowContext(ctx, sql, args...).Scan(&promo.ID, &promo.CreatedAt, &promo.UpdatedAt)
+	if err != nil {
+		var pqErr *pq.Error
+		if stdErrors.As(err, &pqErr) && pqErr.Code == uniqueViolation && pqErr.Constraint == promoCodeUnique {
+			return errors.WithMessage(errors.ErrConflict, "promo code already exists")
+		}
+		return fmt.Errorf("failed to create promo code: %w", err)
+	}
+
+	return nil
+}
+
+func (r *bookingRepository) GetPromoCode(ctx context.Context, id uuid.UUID) (*model.PromoCode, error) {
+	return r.getPromoCode(ctx, squirrel.Eq{idColumn: id}, false)
+}
+
+// GetPromoCodeByCode с forUpdate блокирует строку кода до конца транзакции,
+// чтобы параллельные погашения проверяли лимиты последовательно
+func (r *bookingRepository) GetPromoCodeByCode(
+	ctx context.Context,
+	code string,
+	forUpdate bool,
+) (*model.PromoCode, error) {
+	return r.getPromoCode(ctx, squirrel.Eq{codeColumn: code}, forUpdate)
+}
+
+func (r *bookingRepository) getPromoCode(
+	ctx context.Context,
+	where squirrel.Sqlizer,
+	forUpdate bool,
+) (*model.PromoCode, error) {
+	query := r.builder.
+		Select(promoCodeColumns...).
+		From(promoCodesTable).
+		Where(where)
+	if forUpdate {
+		query = query.Suffix("FOR UPDATE")
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var promo model.PromoCode
+	if err = r.getExecutor(ctx).GetContext(ctx, &promo, sql, args...); err != nil {
+		if stdErrors.Is(err, stdSql.ErrNoRows) {
+			return nil, errors.WithMessage(errors.ErrNotFound, "promo code not found")
+		}
+		return nil, fmt.Errorf("failed to get promo code: %w", err)
+	}
+
+	return &promo, nil
+}
+
+func (r *bookingRepository) ListPromoCodes(ctx context.Context, activeOnly bool) ([]model.PromoCode, error) {
+	query := r.builder.
+		Select(promoCodeColumns...).
+		From(promoCodesTable).
+		OrderBy(createdAtColumn + " DESC")
+	if activeOnly {
+		query = query.Where(squirrel.Eq{activeColumn: true})
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var promos []model.PromoCode
+	if err = r.getExecutor(ctx).SelectContext(ctx, &promos, sql, args...); err != nil {
+		return nil, fmt.Errorf("failed to list promo codes: %w", err)
+	}
+
+	return promos, nil
+}
+
+func (r *bookingRepository) UpdatePromoCode(ctx context.Context, promo *model.PromoCode) error {
+	sql, args, err := r.builder.
+		Update(promoCodesTable).
+		Set(discountTypeColumn, promo.DiscountType).
+		Set(discountPercentColumn, promo.DiscountPercent).
+		Set(discountAmountColumn, promo.DiscountAmount).
+		Set(validFromColumn, promo.ValidFrom).
+		Set(validToColumn, promo.ValidTo).
+		Set(roomTypesColumn, promo.RoomTypes).
+		Set(maxRedemptionsColumn, promo.MaxRedemptions).
+		Set(maxRedemptionsPerUserColumn, promo.MaxRedemptionsPerUser).
+		Set(activeColumn, promo.Active).
+		Set(updatedAtColumn, squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{idColumn: promo.ID}).
+		Suffix("RETURNING " + redemptionsColumn + ", " + updatedAtColumn).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	err = r.getExecutor(ctx).QueryRowContext(ctx, sql, args...).Scan(&promo.Redemptions, &promo.UpdatedAt)
+	if err != nil {
+		if stdErrors.Is(err, stdSql.ErrNoRows) {
+			return errors.WithMessage(errors.ErrNotFound, "promo code not found")
+		}
+		return fmt.Errorf("failed to update promo code: %w", err)
+	}
+
+	return nil
+}
+
+func (r *bookingRepository) DeletePromoCode(ctx context.Context, id uuid.UUID) error {
+	sql, args, err := r.builder.
+		Delete(promoCodesTable).
+		Where(squirrel.Eq{idColumn: id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	result, err := r.getExecutor(ctx).ExecContext(ctx, sql, args...)
+	if err != nil {
+		var pqErr *pq.Error
+		if stdErrors.As(err, &pqErr) && pqErr.Code == foreignKeyViolation {
+			return errors.WithMessage(errors.ErrConflict, "promo code has been redeemed, deactivate it instead")
+		}
+		return fmt.Errorf("failed to delete promo code: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if rows == 0 {
+		return errors.WithMessage(errors.ErrNotFound, "promo code not found")
+	}
+
+	return nil
+}
+
+// CountPromoRedemptions считает погашения кода гостем: по пользователю, а для анонимных броней — по email
+func (r *bookingRepository) CountPromoRedemptions(
+	ctx context.Context,
+	promoCodeID uuid.UUID,
+	userID *uuid.UUID,
+	guestEmail string,
+) (int, error) {
+	query := r.builder.
+		Select("COUNT(*)").
+		From(promoRedemptionsTable).
+		Where(squirrel.Eq{promoCodeIdColumn: promoCodeID})
+	if userID != nil {
+		query = query.Where(squirrel.Eq{userIdColumn: *userID})
+	} else {
+		query = query.Where(squirrel.Eq{userIdColumn: nil, emailColumn: guestEmail})
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var count int
+	if err = r.getExecutor(ctx).GetContext(ctx, &count, sql, args...); err != nil {
+		return 0, fmt.Errorf("failed to count promo redemptions: %w", err)
+	}
+
+	return count, nil
+}
+
+// AddPromoRedemption записывает погашение и увеличивает счетчик кода в текущей транзакции
+func (r *bookingRepository) AddPromoRedemption(ctx context.Context, redemption model.PromoRedemption) error {
+	sql, args, err := r.builder.
+		Insert(promoRedemptionsTable).
+		Columns(promoCodeIdColumn, bookingIdColumn, userIdColumn, emailColumn).
+		Values(redemption.PromoCodeID, redemption.BookingID, redemption.UserID, redemption.GuestEmail).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+	if _, err = r.getExecutor(ctx).ExecContext(ctx, sql, args...); err != nil {
+		return fmt.Errorf("failed to add promo redemption: %w", err)
+	}
+
+	return r.changePromoRedemptions(ctx, redemption.PromoCodeID, 1)
+}
+
+// ReleasePromoRedemption удаляет погашение промокода бронью, если оно было, и уменьшает счетчик кода
+func (r *bookingRepository) ReleasePromoRedemption(ctx context.Context, bookingID uuid.UUID) error {
+	sql, args, err := r.builder.
+		Delete(promoRedemptionsTable).
+		Where(squirrel.Eq{bookingIdColumn: bookingID}).
+		Suffix("RETURNING " + promoCodeIdColumn).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var promoCodeID uuid.UUID
+	err = r.getExecutor(ctx).QueryRowContext(ctx, sql, args...).Scan(&promoCodeID)
+	if stdErrors.Is(err, stdSql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to release promo redemption: %w", err)
+	}
+
+	return r.changePromoRedemptions(ctx, promoCodeID, -1)
+}
+
+func (r *bookingRepository) changePromoRedemptions(ctx context.Context, promoCodeID uuid.UUID, delta int) error {
+	sql, args, err := r.builder.
+		Update(promoCodesTable).
+		Set(redemptionsColumn, squirrel.Expr(redemptionsColumn+" + ?", delta)).
+		Where(squirrel.Eq{idColumn: promoCodeID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+	if _, err = r.getExecutor(ctx).ExecContext(ctx, sql, args...); err != nil {
+		return fmt.Errorf("failed to update promo redemptions: %w", err)
+	}
+	return nil
+}
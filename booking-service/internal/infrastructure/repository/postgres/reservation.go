@@ -0,0 +1,107 @@
+package postgres
+
+import (
+	"context"
+	stdSql "database/sql"
+	stdErrors "errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+	"github.com/semho/hotel-booking/pkg/errors"
+)
+
+const (
+	reservationsTable = "reservations"
+
+	confirmationCodeColumn = "confirmation_code"
+	contactNameColumn      = "contact_name"
+	contactEmailColumn     = "contact_email"
+	contactPhoneColumn     = "contact_phone"
+
+	uniqueViolation        = "23505"
+	confirmationCodeUnique = "reservations_confirmation_code_unique"
+)
+
+var reservationColumns = []string{
+	idColumn,
+	confirmationCodeColumn,
+	userIdColumn,
+	contactNameColumn,
+	contactEmailColumn,
+	contactPhoneColumn,
+	createdAtColumn,
+}
+
+// CreateReservation не вставляет групповую бронь с занятым кодом подтверждения. ON CONFLICT вместо ошибки
+// уникальности не прерывает транзакцию, и вставку можно повторить с другим кодом
+func (r *bookingRepository) CreateReservation(ctx context.Context, reservation *model.Reservation) (bool, error) {
+	sql, args, err := r.builder.
+		Insert(reservationsTable).
+		Columns(
+			confirmationCodeColumn,
+			userIdColumn,
+			contactNameColumn,
+			contactEmailColumn,
+			contactPhoneColumn,
+		).
+		Values(
+			reservation.ConfirmationCode,
+			reservation.UserID,
+			reservation.ContactName,
+			reservation.ContactEmail,
+			reservation.ContactPhone,
+		).
+		Suffix("ON CONFLICT ON CONSTRAINT " + confirmationCodeUnique + " DO NOTHING RETURNING id, created_at").
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	err = r.getExecutor(ctx).QueryRowContext(ctx, sql, args...).Scan(&reservation.ID, &reservation.CreatedAt)
+	if err != nil {
+		if stdErrors.Is(err, stdSql.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to create reservation: %w", err)
+	}
+
+	return true, nil
+}
+
+// Получение групповой брони вместе с бронями комнат
+func (r *bookingRepository) GetReservation(ctx context.Context, reservationID uuid.UUID) (*model.Reservation, error) {
+	sql, args, err := r.builder.
+		Select(reservationColumns...).
+		From(reservationsTable).
+		Where(squirrel.Eq{idColumn: reservationID}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var reservation model.Reservation
+	if err = r.getExecutor(ctx).GetContext(ctx, &reservation, sql, args...); err != nil {
+		if stdErrors.Is(err, stdSql.ErrNoRows) {
+			return nil, errors.WithMessage(errors.ErrNotFound, "reservation not found")
+		}
+		return nil, fmt.Errorf("failed to get reservation: %w", err)
+	}
+
+	sql, args, err = r.selectBookingsWithStatus().
+		Where(squirrel.Eq{"b." + reservationIdColumn: reservationID}).
+		OrderBy("b."+createdAtColumn, "b."+idColumn).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var rows []model.BookingRow
+	if err = r.getExecutor(ctx).SelectContext(ctx, &rows, sql, args...); err != nil {
+		return nil, fmt.Errorf("failed to get reservation bookings: %w", err)
+	}
+	reservation.Bookings = rowsToBookings(rows)
+
+	return &reservation, nil
+}
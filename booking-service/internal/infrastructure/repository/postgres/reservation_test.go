@@ -0,0 +1,58 @@
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+	"github.com/semho/hotel-booking/booking-service/internal/infrastructure/repository/postgres"
+	"github.com/semho/hotel-booking/booking-service/internal/infrastructure/repository/postgres/pgtest"
+	"github.com/semho/hotel-booking/booking-service/internal/infrastructure/unitofwork"
+)
+
+// Занятый код подтверждения не прерывает транзакцию: в ней же создается групповая бронь с другим кодом
+func TestCreateReservationWithTakenConfirmationCode(t *testing.T) {
+	db := pgtest.Open(t)
+	repo := postgres.NewBookingRepository(db)
+	uow := unitofwork.NewBookingUnitOfWork(db)
+	ctx := context.Background()
+
+	newReservation := func(code string) *model.Reservation {
+		return &model.Reservation{
+			ConfirmationCode: code,
+			ContactName:      "Code Check",
+			ContactEmail:     "code-check@example.com",
+		}
+	}
+
+	if created, err := repo.CreateReservation(ctx, newReservation("TAKEN234")); err != nil || !created {
+		t.Fatalf("create first reservation: created=%v, err=%v", created, err)
+	}
+
+	err := uow.WithinTransaction(
+		ctx, func(txCtx context.Context) error {
+			created, err := repo.CreateReservation(txCtx, newReservation("TAKEN234"))
+			if err != nil {
+				return err
+			}
+			if created {
+				return fmt.Errorf("reservation with a taken confirmation code was created")
+			}
+
+			reservation := newReservation("FREE2345")
+			if created, err = repo.CreateReservation(txCtx, reservation); err != nil {
+				return err
+			}
+			if !created {
+				return fmt.Errorf("reservation with a free confirmation code was not created")
+			}
+
+			_, err = repo.GetReservation(txCtx, reservation.ID)
+			return err
+		},
+	)
+	if err != nil {
+		t.Fatalf("retry with another code in the same transaction: %v", err)
+	}
+}
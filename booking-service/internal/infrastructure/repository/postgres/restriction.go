@@ -0,0 +1,209 @@
+package postgres
+
+import (
+	"context"
+	stdSql "database/sql"
+	stdErrors "errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+	"github.com/semho/hotel-booking/pkg/errors"
+)
+
+const (
+	stayRestrictionsTable = "stay_restrictions"
+
+	nameColumn              = "name"
+	dateFromColumn          = "date_from"
+	dateToColumn            = "date_to"
+	weekdaysColumn          = "weekdays"
+	minStayColumn           = "min_stay"
+	maxStayColumn           = "max_stay"
+	closedToArrivalColumn   = "closed_to_arrival"
+	closedToDepartureColumn = "closed_to_departure"
+	minAdvanceDaysColumn    = "min_advance_days"
+	maxAdvanceDaysColumn    = "max_advance_days"
+)
+
+var stayRestrictionColumns = []string{
+	idColumn,
+	nameColumn,
+	dateFromColumn,
+	dateToColumn,
+	roomTypeColumn,
+	weekdaysColumn,
+	minStayColumn,
+	maxStayColumn,
+	closedToArrivalColumn,
+	closedToDepartureColumn,
+	minAdvanceDaysColumn,
+	maxAdvanceDaysColumn,
+	createdAtColumn,
+	updatedAtColumn,
+}
+
+func (r *bookingRepository) CreateStayRestriction(ctx context.Context, restriction *model.StayRestriction) error {
+	sql, args, err := r.builder.
+		Insert(stayRestrictionsTable).
+		Columns(
+			nameColumn,
+			dateFromColumn,
+			dateToColumn,
+			roomTypeColumn,
+			weekdaysColumn,
+			minStayColumn,
+			maxStayColumn,
+			closedToArrivalColumn,
+			closedToDepartureColumn,
+			minAdvanceDaysColumn,
+			maxAdvanceDaysColumn,
+		).
+		Values(
+			restriction.Name,
+			model.NightDate(restriction.DateFrom),
+			model.NightDate(restriction.DateTo),
+			restriction.RoomType,
+			restriction.Weekdays,
+			restriction.MinStay,
+			restriction.MaxStay,
+			restriction.ClosedToArrival,
+			restriction.ClosedToDeparture,
+			restriction.MinAdvanceDays,
+			restriction.MaxAdvanceDays,
+		).
+		Suffix("RETURNING id, created_at, updated_at").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	err = r.getExecutor(ctx).QueryRowContext(ctx, sql, args...).Scan(
+		&restriction.ID,
+		&restriction.CreatedAt,
+		&restriction.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create stay restriction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *bookingRepository) GetStayRestriction(ctx context.Context, id uuid.UUID) (*model.StayRestriction, error) {
+	sql, args, err := r.builder.
+		Select(stayRestrictionColumns...).
+		From(stayRestrictionsTable).
+		Where(squirrel.Eq{idColumn: id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var restriction model.StayRestriction
+	if err = r.getExecutor(ctx).GetContext(ctx, &restriction, sql, args...); err != nil {
+		if stdErrors.Is(err, stdSql.ErrNoRows) {
+			return nil, errors.WithMessage(errors.ErrNotFound, "stay restriction not found")
+		}
+		return nil, fmt.Errorf("failed to get stay restriction: %w", err)
+	}
+
+	return &restriction, nil
+}
+
+// ListStayRestrictions возвращает правила, пересекающиеся с периодом фильтра. Даты сравниваются
+// как календарные, без часового пояса
+func (r *bookingRepository) ListStayRestrictions(
+	ctx context.Context,
+	filter model.StayRestrictionFilter,
+) ([]model.StayRestriction, error) {
+	query := r.builder.
+		Select(stayRestrictionColumns...).
+		From(stayRestrictionsTable).
+		OrderBy(dateFromColumn, createdAtColumn)
+	if filter.From != nil {
+		query = query.Where(squirrel.GtOrEq{dateToColumn: model.NightDate(*filter.From)})
+	}
+	if filter.To != nil {
+		query = query.Where(squirrel.LtOrEq{dateFromColumn: model.NightDate(*filter.To)})
+	}
+	if filter.RoomType != nil {
+		query = query.Where(
+			squirrel.Or{
+				squirrel.Eq{roomTypeColumn: nil},
+				squirrel.Eq{roomTypeColumn: *filter.RoomType},
+			},
+		)
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var restrictions []model.StayRestriction
+	if err = r.getExecutor(ctx).SelectContext(ctx, &restrictions, sql, args...); err != nil {
+		return nil, fmt.Errorf("failed to list stay restrictions: %w", err)
+	}
+
+	return restrictions, nil
+}
+
+func (r *bookingRepository) UpdateStayRestriction(ctx context.Context, restriction *model.StayRestriction) error {
+	sql, args, err := r.builder.
+		Update(stayRestrictionsTable).
+		Set(nameColumn, restriction.Name).
+		Set(dateFromColumn, model.NightDate(restriction.DateFrom)).
+		Set(dateToColumn, model.NightDate(restriction.DateTo)).
+		Set(roomTypeColumn, restriction.RoomType).
+		Set(weekdaysColumn, restriction.Weekdays).
+		Set(minStayColumn, restriction.MinStay).
+		Set(maxStayColumn, restriction.MaxStay).
+		Set(closedToArrivalColumn, restriction.ClosedToArrival).
+		Set(closedToDepartureColumn, restriction.ClosedToDeparture).
+		Set(minAdvanceDaysColumn, restriction.MinAdvanceDays).
+		Set(maxAdvanceDaysColumn, restriction.MaxAdvanceDays).
+		Set(updatedAtColumn, squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{idColumn: restriction.ID}).
+		Suffix("RETURNING " + createdAtColumn + ", " + updatedAtColumn).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	err = r.getExecutor(ctx).QueryRowContext(ctx, sql, args...).Scan(&restriction.CreatedAt, &restriction.UpdatedAt)
+	if err != nil {
+		if stdErrors.Is(err, stdSql.ErrNoRows) {
+			return errors.WithMessage(errors.ErrNotFound, "stay restriction not found")
+		}
+		return fmt.Errorf("failed to update stay restriction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *bookingRepository) DeleteStayRestriction(ctx context.Context, id uuid.UUID) error {
+	sql, args, err := r.builder.
+		Delete(stayRestrictionsTable).
+		Where(squirrel.Eq{idColumn: id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	result, err := r.getExecutor(ctx).ExecContext(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete stay restriction: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if rows == 0 {
+		return errors.WithMessage(errors.ErrNotFound, "stay restriction not found")
+	}
+
+	return nil
+}
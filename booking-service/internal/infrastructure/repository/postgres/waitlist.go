@@ -0,0 +1,366 @@
+package postgres
+
+import (
+	"context"
+	stdSql "database/sql"
+	stdErrors "errors"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+	"github.com/semho/hotel-booking/pkg/errors"
+	pb "github.com/semho/hotel-booking/pkg/proto/booking_v1/booking"
+)
+
+const (
+	waitlistTable           = "waitlist_entries"
+	notificationEventsTable = "notification_events"
+
+	capacityColumn       = "capacity"
+	roomTypeColumn       = "room_type"
+	offerExpiresAtColumn = "offer_expires_at"
+	eventTypeColumn      = "event_type"
+	payloadColumn        = "payload"
+	publishedAtColumn    = "published_at"
+	attemptsColumn       = "attempts"
+	lastErrorColumn      = "last_error"
+)
+
+var waitlistColumns = []string{
+	idColumn,
+	userIdColumn,
+	guestNameColumn,
+	emailColumn,
+	phoneColumn,
+	checkInColumn,
+	checkOutColumn,
+	capacityColumn,
+	roomTypeColumn,
+	statusColumn,
+	bookingIdColumn,
+	offerExpiresAtColumn,
+	createdAtColumn,
+	updatedAtColumn,
+}
+
+func (r *bookingRepository) CreateWaitlistEntry(ctx context.Context, entry *model.WaitlistEntry) error {
+	sql, args, err := r.builder.
+		Insert(waitlistTable).
+		Columns(
+			userIdColumn,
+			guestNameColumn,
+			emailColumn,
+			phoneColumn,
+			checkInColumn,
+			checkOutColumn,
+			capacityColumn,
+			roomTypeColumn,
+			statusColumn,
+		).
+		Values(
+			entry.UserID,
+			entry.GuestName,
+			entry.GuestEmail,
+			entry.GuestPhone,
+			entry.CheckIn,
+			entry.CheckOut,
+			entry.Capacity,
+			entry.RoomType,
+			entry.Status,
+		).
+		Suffix("RETURNING id, created_at, updated_at").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	err = r.getExecutor(ctx).QueryRowContext(ctx, sql, args...).Scan(&entry.ID, &entry.CreatedAt, &entry.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create waitlist entry: %w", err)
+	}
+
+	return nil
+}
+
+func (r *bookingRepository) GetWaitlistEntry(ctx context.Context, id uuid.UUID) (*model.WaitlistEntry, error) {
+	sql, args, err := r.builder.
+		Select(waitlistColumns...).
+		From(waitlistTable).
+		Where(squirrel.Eq{idColumn: id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var entry model.WaitlistEntry
+	if err = r.getExecutor(ctx).GetContext(ctx, &entry, sql, args...); err != nil {
+		if stdErrors.Is(err, stdSql.ErrNoRows) {
+			return nil, errors.WithMessage(errors.ErrNotFound, "waitlist entry not found")
+		}
+		return nil, fmt.Errorf("failed to get waitlist entry: %w", err)
+	}
+
+	return &entry, nil
+}
+
+func (r *bookingRepository) ListWaitlistEntries(
+	ctx context.Context,
+	filter model.WaitlistFilter,
+) ([]model.WaitlistEntry, error) {
+	query := r.builder.
+		Select(waitlistColumns...).
+		From(waitlistTable).
+		OrderBy(createdAtColumn, idColumn)
+	if filter.UserID != nil {
+		query = query.Where(squirrel.Eq{userIdColumn: *filter.UserID})
+	}
+	if filter.Status != nil {
+		query = query.Where(squirrel.Eq{statusColumn: *filter.Status})
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var entries []model.WaitlistEntry
+	if err = r.getExecutor(ctx).SelectContext(ctx, &entries, sql, args...); err != nil {
+		return nil, fmt.Errorf("failed to list waitlist entries: %w", err)
+	}
+
+	return entries, nil
+}
+
+// FindWaitlistCandidates возвращает ожидающие записи, даты которых пересекаются с периодом [checkIn, checkOut),
+// в порядке поступления. Записи с заездом не позже now не учитываются
+func (r *bookingRepository) FindWaitlistCandidates(
+	ctx context.Context,
+	checkIn, checkOut, now time.Time,
+	limit int,
+) ([]uuid.UUID, error) {
+	sql, args, err := r.builder.
+		Select(idColumn).
+		From(waitlistTable).
+		Where(
+			squirrel.And{
+				squirrel.Eq{statusColumn: pb.WaitlistStatus_WAITLIST_STATUS_WAITING},
+				squirrel.Lt{checkInColumn: checkOut},
+				squirrel.Gt{checkOutColumn: checkIn},
+				squirrel.Gt{checkInColumn: now},
+			},
+		).
+		OrderBy(createdAtColumn, idColumn).
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var ids []uuid.UUID
+	if err = r.getExecutor(ctx).SelectContext(ctx, &ids, sql, args...); err != nil {
+		return nil, fmt.Errorf("failed to find waitlist candidates: %w", err)
+	}
+
+	return ids, nil
+}
+
+// LockWaitingEntry блокирует ожидающую запись до конца транзакции. Возвращает nil, если запись уже
+// не ожидает или обрабатывается другой транзакцией
+func (r *bookingRepository) LockWaitingEntry(ctx context.Context, id uuid.UUID) (*model.WaitlistEntry, error) {
+	sql, args, err := r.builder.
+		Select(waitlistColumns...).
+		From(waitlistTable).
+		Where(
+			squirrel.Eq{
+				idColumn:     id,
+				statusColumn: pb.WaitlistStatus_WAITLIST_STATUS_WAITING,
+			},
+		).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var entry model.WaitlistEntry
+	if err = r.getExecutor(ctx).GetContext(ctx, &entry, sql, args...); err != nil {
+		if stdErrors.Is(err, stdSql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to lock waitlist entry: %w", err)
+	}
+
+	return &entry, nil
+}
+
+func (r *bookingRepository) UpdateWaitlistEntry(ctx context.Context, entry *model.WaitlistEntry) error {
+	sql, args, err := r.builder.
+		Update(waitlistTable).
+		Set(statusColumn, entry.Status).
+		Set(bookingIdColumn, entry.BookingID).
+		Set(offerExpiresAtColumn, entry.OfferExpiresAt).
+		Set(updatedAtColumn, squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{idColumn: entry.ID}).
+		Suffix("RETURNING " + updatedAtColumn).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	if err = r.getExecutor(ctx).QueryRowContext(ctx, sql, args...).Scan(&entry.UpdatedAt); err != nil {
+		if stdErrors.Is(err, stdSql.ErrNoRows) {
+			return errors.WithMessage(errors.ErrNotFound, "waitlist entry not found")
+		}
+		return fmt.Errorf("failed to update waitlist entry: %w", err)
+	}
+
+	return nil
+}
+
+// CloseWaitlistOffer переводит запись, которой была предложена бронь bookingID, в статус status
+func (r *bookingRepository) CloseWaitlistOffer(
+	ctx context.Context,
+	bookingID uuid.UUID,
+	status model.WaitlistStatus,
+) error {
+	sql, args, err := r.builder.
+		Update(waitlistTable).
+		Set(statusColumn, status).
+		Set(updatedAtColumn, squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(
+			squirrel.Eq{
+				bookingIdColumn: bookingID,
+				statusColumn:    pb.WaitlistStatus_WAITLIST_STATUS_OFFERED,
+			},
+		).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	if _, err = r.getExecutor(ctx).ExecContext(ctx, sql, args...); err != nil {
+		return fmt.Errorf("failed to close waitlist offer: %w", err)
+	}
+	return nil
+}
+
+// ExpireWaitlistEntries закрывает ожидающие записи, дата заезда которых наступила
+func (r *bookingRepository) ExpireWaitlistEntries(ctx context.Context, now time.Time) (int, error) {
+	sql, args, err := r.builder.
+		Update(waitlistTable).
+		Set(statusColumn, pb.WaitlistStatus_WAITLIST_STATUS_EXPIRED).
+		Set(updatedAtColumn, squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(
+			squirrel.And{
+				squirrel.Eq{statusColumn: pb.WaitlistStatus_WAITLIST_STATUS_WAITING},
+				squirrel.LtOrEq{checkInColumn: now},
+			},
+		).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	result, err := r.getExecutor(ctx).ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to expire waitlist entries: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	return int(rows), nil
+}
+
+// AddNotificationEvent записывает уведомление в outbox в текущей транзакции
+func (r *bookingRepository) AddNotificationEvent(ctx context.Context, event *model.NotificationEvent) error {
+	sql, args, err := r.builder.
+		Insert(notificationEventsTable).
+		Columns(eventTypeColumn, payloadColumn).
+		Values(event.Type, []byte(event.Payload)).
+		Suffix("RETURNING id, created_at").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	err = r.getExecutor(ctx).QueryRowContext(ctx, sql, args...).Scan(&event.ID, &event.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to add notification event: %w", err)
+	}
+	return nil
+}
+
+// LockPendingNotificationEvents блокирует неотправленные уведомления до конца транзакции.
+// SKIP LOCKED не дает двум репликам отправить одно уведомление
+func (r *bookingRepository) LockPendingNotificationEvents(
+	ctx context.Context,
+	maxAttempts, limit int,
+) ([]model.NotificationEvent, error) {
+	sql, args, err := r.builder.
+		Select(idColumn, eventTypeColumn, payloadColumn, createdAtColumn, attemptsColumn).
+		From(notificationEventsTable).
+		Where(
+			squirrel.And{
+				squirrel.Eq{publishedAtColumn: nil},
+				squirrel.Lt{attemptsColumn: maxAttempts},
+			},
+		).
+		OrderBy(createdAtColumn).
+		Limit(uint64(limit)).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var events []model.NotificationEvent
+	if err = r.getExecutor(ctx).SelectContext(ctx, &events, sql, args...); err != nil {
+		return nil, fmt.Errorf("failed to lock notification events: %w", err)
+	}
+	return events, nil
+}
+
+func (r *bookingRepository) MarkNotificationEventsPublished(
+	ctx context.Context,
+	ids []uuid.UUID,
+	publishedAt time.Time,
+) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	sql, args, err := r.builder.
+		Update(notificationEventsTable).
+		Set(publishedAtColumn, publishedAt).
+		Where(squirrel.Eq{idColumn: ids}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	if _, err = r.getExecutor(ctx).ExecContext(ctx, sql, args...); err != nil {
+		return fmt.Errorf("failed to mark notification events published: %w", err)
+	}
+	return nil
+}
+
+func (r *bookingRepository) RecordNotificationEventFailure(ctx context.Context, id uuid.UUID, reason string) error {
+	sql, args, err := r.builder.
+		Update(notificationEventsTable).
+		Set(attemptsColumn, squirrel.Expr(attemptsColumn+" + 1")).
+		Set(lastErrorColumn, reason).
+		Where(squirrel.Eq{idColumn: id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	if _, err = r.getExecutor(ctx).ExecContext(ctx, sql, args...); err != nil {
+		return fmt.Errorf("failed to record notification event failure: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/semho/hotel-booking/booking-service/internal/domain/port"
+	"github.com/semho/hotel-booking/pkg/logger"
+)
+
+const (
+	defaultHoldExpiryInterval  = time.Minute
+	defaultHoldExpiryBatchSize = 100
+)
+
+// HoldExpiryWorker периодически отменяет PENDING брони с истекшим сроком удержания.
+// Безопасен при запуске на нескольких репликах: брони блокируются через FOR UPDATE SKIP LOCKED
+type HoldExpiryWorker struct {
+	bookingService port.BookingService
+	interval       time.Duration
+	batchSize      int
+}
+
+func NewHoldExpiryWorker(bookingService port.BookingService, interval time.Duration, batchSize int) *HoldExpiryWorker {
+	if interval <= 0 {
+		interval = defaultHoldExpiryInterval
+	}
+	if batchSize <= 0 {
+		batchSize = defaultHoldExpiryBatchSize
+	}
+
+	return &HoldExpiryWorker{
+		bookingService: bookingService,
+		interval:       interval,
+		batchSize:      batchSize,
+	}
+}
+
+// Run блокируется до отмены контекста
+func (w *HoldExpiryWorker) Run(ctx context.Context) {
+	logger.Log.Info("starting hold expiry worker", "interval", w.interval, "batch_size", w.batchSize)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.expire(ctx)
+
+		select {
+		case <-ctx.Done():
+			logger.Log.Info("hold expiry worker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Обрабатывает пачки, пока не останется просроченных броней
+func (w *HoldExpiryWorker) expire(ctx context.Context) {
+	for ctx.Err() == nil {
+		expired, err := w.bookingService.ExpirePendingBookings(ctx, w.batchSize)
+		if err != nil {
+			logger.Log.Error("failed to expire pending bookings", "error", err)
+			return
+		}
+
+		if expired > 0 {
+			logger.Log.Info("expired pending bookings", "count", expired)
+		}
+
+		if expired < w.batchSize {
+			return
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/semho/hotel-booking/booking-service/internal/domain/model"
+	"github.com/semho/hotel-booking/booking-service/internal/domain/port"
+	"github.com/semho/hotel-booking/pkg/logger"
+)
+
+const cutoffLayout = "15:04"
+
+// NightlyScheduler раз в сутки, в момент cutoff по времени отеля, переводит прошедшие брони в NO_SHOW и COMPLETED.
+// При старте сразу выполняет прогон за последний наступивший cutoff, чтобы не пропустить сутки после простоя
+type NightlyScheduler struct {
+	bookingService port.BookingService
+	calendar       model.HotelCalendar
+	cutoff         time.Duration // смещение от полуночи по времени отеля
+	dryRun         bool
+}
+
+func NewNightlyScheduler(
+	bookingService port.BookingService,
+	calendar model.HotelCalendar,
+	cutoff string,
+	dryRun bool,
+) (*NightlyScheduler, error) {
+	if cutoff == "" {
+		cutoff = "00:00"
+	}
+	cutoffTime, err := time.Parse(cutoffLayout, cutoff)
+	if err != nil {
+		return nil, fmt.Errorf("invalid nightly cutoff %q, expected HH:MM: %w", cutoff, err)
+	}
+
+	return &NightlyScheduler{
+		bookingService: bookingService,
+		calendar:       calendar,
+		cutoff:         time.Duration(cutoffTime.Hour())*time.Hour + time.Duration(cutoffTime.Minute())*time.Minute,
+		dryRun:         dryRun,
+	}, nil
+}
+
+// Run блокируется до отмены контекста
+func (s *NightlyScheduler) Run(ctx context.Context) {
+	logger.Log.Info(
+		"starting nightly scheduler",
+		"timezone", s.calendar.Location.String(),
+		"cutoff", s.cutoff,
+		"dry_run", s.dryRun,
+	)
+
+	for {
+		if _, err := s.RunOnce(ctx, time.Now(), s.dryRun); err != nil {
+			logger.Log.Error("nightly booking transitions failed", "error", err)
+		}
+
+		timer := time.NewTimer(time.Until(s.nextCutoff(time.Now())))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			logger.Log.Info("nightly scheduler stopped")
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// RunOnce выполняет переходы на последний наступивший к моменту now cutoff
+func (s *NightlyScheduler) RunOnce(ctx context.Context, now time.Time, dryRun bool) (
+	[]model.StatusTransition,
+	error,
+) {
+	run := s.nightlyRun(now)
+	run.DryRun = dryRun
+
+	transitions, err := s.bookingService.RunNightlyTransitions(ctx, run)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range transitions {
+		logger.Log.Info(
+			"nightly booking transition",
+			"booking_id", t.BookingID,
+			"from", t.From,
+			"to", t.To,
+			"dry_run", dryRun,
+		)
+	}
+
+	return transitions, nil
+}
+
+// Границы прогона: брони с заездом до начала гостиничных суток последнего cutoff считаются незаехавшими,
+// а брони с выездом до самого cutoff — завершенными
+func (s *NightlyScheduler) nightlyRun(now time.Time) model.NightlyRun {
+	lastCutoff := s.nextCutoff(now).AddDate(0, 0, -1)
+
+	return model.NightlyRun{
+		NoShowCheckInBefore:    s.calendar.DateOf(lastCutoff),
+		CompleteCheckOutBefore: lastCutoff,
+	}
+}
+
+// Ближайший cutoff строго после now
+func (s *NightlyScheduler) nextCutoff(now time.Time) time.Time {
+	today := s.calendar.DateOf(now)
+	next := today.Add(s.cutoff)
+	if !next.After(now) {
+		next = today.AddDate(0, 0, 1).Add(s.cutoff)
+	}
+	return next
+}
@@ -0,0 +1,79 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/semho/hotel-booking/booking-service/internal/domain/port"
+	"github.com/semho/hotel-booking/pkg/logger"
+)
+
+const (
+	defaultNotificationInterval  = 30 * time.Second
+	defaultNotificationBatchSize = 100
+)
+
+// NotificationWorker периодически отправляет уведомления, записанные в outbox (предложения листа ожидания).
+// Безопасен при запуске на нескольких репликах: уведомления блокируются через FOR UPDATE SKIP LOCKED
+type NotificationWorker struct {
+	bookingService port.BookingService
+	interval       time.Duration
+	batchSize      int
+}
+
+func NewNotificationWorker(
+	bookingService port.BookingService,
+	interval time.Duration,
+	batchSize int,
+) *NotificationWorker {
+	if interval <= 0 {
+		interval = defaultNotificationInterval
+	}
+	if batchSize <= 0 {
+		batchSize = defaultNotificationBatchSize
+	}
+
+	return &NotificationWorker{
+		bookingService: bookingService,
+		interval:       interval,
+		batchSize:      batchSize,
+	}
+}
+
+// Run блокируется до отмены контекста
+func (w *NotificationWorker) Run(ctx context.Context) {
+	logger.Log.Info("starting notification worker", "interval", w.interval, "batch_size", w.batchSize)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.publish(ctx)
+
+		select {
+		case <-ctx.Done():
+			logger.Log.Info("notification worker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Отправляет пачки, пока outbox не опустеет; при неудачной отправке ждет следующего запуска
+func (w *NotificationWorker) publish(ctx context.Context) {
+	for ctx.Err() == nil {
+		published, err := w.bookingService.PublishNotificationEvents(ctx, w.batchSize)
+		if err != nil {
+			logger.Log.Error("failed to publish notifications", "error", err)
+			return
+		}
+
+		if published > 0 {
+			logger.Log.Info("published notifications", "count", published)
+		}
+
+		if published < w.batchSize {
+			return
+		}
+	}
+}
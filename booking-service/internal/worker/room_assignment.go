@@ -0,0 +1,65 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/semho/hotel-booking/booking-service/internal/domain/port"
+	"github.com/semho/hotel-booking/pkg/logger"
+)
+
+const defaultRoomAssignmentInterval = time.Hour
+
+// RoomAssignmentWorker периодически назначает комнаты броням, проданным по типу, перед заездом.
+// Безопасен при запуске на нескольких репликах: типы комнат распределяются под advisory lock
+type RoomAssignmentWorker struct {
+	bookingService port.BookingService
+	interval       time.Duration
+}
+
+func NewRoomAssignmentWorker(bookingService port.BookingService, interval time.Duration) *RoomAssignmentWorker {
+	if interval <= 0 {
+		interval = defaultRoomAssignmentInterval
+	}
+
+	return &RoomAssignmentWorker{
+		bookingService: bookingService,
+		interval:       interval,
+	}
+}
+
+// Run блокируется до отмены контекста
+func (w *RoomAssignmentWorker) Run(ctx context.Context) {
+	logger.Log.Info("starting room assignment worker", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.assign(ctx)
+
+		select {
+		case <-ctx.Done():
+			logger.Log.Info("room assignment worker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *RoomAssignmentWorker) assign(ctx context.Context) {
+	run, err := w.bookingService.AssignRooms(ctx, time.Now())
+	if err != nil {
+		logger.Log.Error("failed to assign rooms", "error", err)
+	}
+	if run == nil {
+		return
+	}
+
+	if run.Assigned > 0 {
+		logger.Log.Info("rooms assigned", "count", run.Assigned)
+	}
+	if len(run.Unplaced) > 0 {
+		logger.Log.Warn("bookings left without room", "count", len(run.Unplaced), "booking_ids", run.Unplaced)
+	}
+}
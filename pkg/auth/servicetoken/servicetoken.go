@@ -0,0 +1,55 @@
+// Package servicetoken ограничивает внутренние gRPC методы вызовами других сервисов: вызывающий сервис
+// передает общий секрет в метаданных, сервер сверяет его перед вызовом метода
+package servicetoken
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// MetadataKey — ключ метаданных gRPC с секретом вызывающего сервиса
+const MetadataKey = "x-service-token"
+
+// WithToken добавляет секрет сервиса в исходящие метаданные вызова
+func WithToken(ctx context.Context, token string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, MetadataKey, token)
+}
+
+// UnaryServerInterceptor пропускает вызовы методов methods (полные имена, например
+// /hotel.booking.v1.BookingService/ClaimGuestBookings) только с секретом token.
+// Если секрет не задан, внутренние методы недоступны никому
+func UnaryServerInterceptor(token string, methods ...string) grpc.UnaryServerInterceptor {
+	internal := make(map[string]struct{}, len(methods))
+	for _, method := range methods {
+		internal[method] = struct{}{}
+	}
+
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if _, ok := internal[info.FullMethod]; ok && !valid(ctx, token) {
+			return nil, status.Error(codes.PermissionDenied, "method is available to internal services only")
+		}
+		return handler(ctx, req)
+	}
+}
+
+func valid(ctx context.Context, token string) bool {
+	if token == "" {
+		return false
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	values := md.Get(MetadataKey)
+	return len(values) == 1 && subtle.ConstantTimeCompare([]byte(values[0]), []byte(token)) == 1
+}
@@ -0,0 +1,80 @@
+package servicetoken
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	const internalMethod = "/hotel.booking.v1.BookingService/ClaimGuestBookings"
+
+	tests := []struct {
+		name     string
+		secret   string
+		method   string
+		token    *string
+		expected codes.Code
+	}{
+		{
+			name:     "internal method with valid token",
+			secret:   "s3cret",
+			method:   internalMethod,
+			token:    ptr("s3cret"),
+			expected: codes.OK,
+		},
+		{
+			name:     "internal method without token",
+			secret:   "s3cret",
+			method:   internalMethod,
+			expected: codes.PermissionDenied,
+		},
+		{
+			name:     "internal method with wrong token",
+			secret:   "s3cret",
+			method:   internalMethod,
+			token:    ptr("other"),
+			expected: codes.PermissionDenied,
+		},
+		{
+			name:     "internal method when secret is not configured",
+			method:   internalMethod,
+			token:    ptr(""),
+			expected: codes.PermissionDenied,
+		},
+		{
+			name:     "public method without token",
+			secret:   "s3cret",
+			method:   "/hotel.booking.v1.BookingService/GetBooking",
+			expected: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				ctx := context.Background()
+				if tt.token != nil {
+					ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(MetadataKey, *tt.token))
+				}
+
+				interceptor := UnaryServerInterceptor(tt.secret, internalMethod)
+				_, err := interceptor(
+					ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method},
+					func(context.Context, interface{}) (interface{}, error) { return nil, nil },
+				)
+				if code := status.Code(err); code != tt.expected {
+					t.Fatalf("expected %s, got %s", tt.expected, code)
+				}
+			},
+		)
+	}
+}
+
+func ptr(s string) *string {
+	return &s
+}
@@ -11,6 +11,7 @@ var (
 	ErrInvalidInput = errors.New("invalid input")
 	ErrInternal     = errors.New("internal error")
 	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
 )
 
 // WithMessage оборачивает ошибку с дополнительным сообщением
@@ -37,3 +38,13 @@ func IsInvalidInput(err error) bool {
 func IsInternal(err error) bool {
 	return errors.Is(err, ErrInternal)
 }
+
+// IsUnauthorized проверяет, является ли ошибка типом ErrUnauthorized
+func IsUnauthorized(err error) bool {
+	return errors.Is(err, ErrUnauthorized)
+}
+
+// IsForbidden проверяет, является ли ошибка типом ErrForbidden
+func IsForbidden(err error) bool {
+	return errors.Is(err, ErrForbidden)
+}
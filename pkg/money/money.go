@@ -0,0 +1,196 @@
+package money
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/semho/hotel-booking/pkg/errors"
+	moneypb "github.com/semho/hotel-booking/pkg/proto/money_v1/money"
+	"github.com/shopspring/decimal"
+)
+
+// Число знаков после запятой для валют, отличных от двух (ISO 4217)
+var minorUnits = map[string]int32{
+	"JPY": 0,
+	"KRW": 0,
+	"VND": 0,
+	"BHD": 3,
+	"KWD": 3,
+	"OMR": 3,
+	"TND": 3,
+}
+
+const defaultMinorUnits = 2
+
+// Money — денежная сумма в минимальных единицах валюты (копейках, центах) с кодом валюты ISO 4217.
+// Целочисленное хранение исключает ошибки округления при сложении и пересчете сумм
+type Money struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+func New(amount int64, currency string) Money {
+	return Money{Amount: amount, Currency: strings.ToUpper(currency)}
+}
+
+// Zero — нулевая сумма в валюте
+func Zero(currency string) Money {
+	return New(0, currency)
+}
+
+// MinorUnits возвращает число знаков после запятой в валюте
+func MinorUnits(currency string) int32 {
+	if units, ok := minorUnits[strings.ToUpper(currency)]; ok {
+		return units
+	}
+	return defaultMinorUnits
+}
+
+// ValidateCurrency проверяет, что код валюты состоит из трех латинских букв
+func ValidateCurrency(currency string) error {
+	if len(currency) != 3 {
+		return errors.WithMessage(errors.ErrInvalidInput, fmt.Sprintf("invalid currency code %q", currency))
+	}
+	for _, c := range strings.ToUpper(currency) {
+		if c < 'A' || c > 'Z' {
+			return errors.WithMessage(errors.ErrInvalidInput, fmt.Sprintf("invalid currency code %q", currency))
+		}
+	}
+	return nil
+}
+
+// FromDecimal переводит сумму в минимальные единицы валюты с банковским округлением
+func FromDecimal(amount decimal.Decimal, currency string) Money {
+	units := MinorUnits(currency)
+	return New(amount.Shift(units).RoundBank(0).IntPart(), currency)
+}
+
+// Parse разбирает десятичную сумму вида "1234.50"
+func Parse(amount string, currency string) (Money, error) {
+	value, err := decimal.NewFromString(amount)
+	if err != nil {
+		return Money{}, errors.WithMessage(errors.ErrInvalidInput, fmt.Sprintf("invalid amount %q", amount))
+	}
+	return FromDecimal(value, currency), nil
+}
+
+// Decimal возвращает сумму в основных единицах валюты
+func (m Money) Decimal() decimal.Decimal {
+	return decimal.New(m.Amount, -MinorUnits(m.Currency))
+}
+
+// String возвращает сумму с точностью валюты без кода, например "1234.50"
+func (m Money) String() string {
+	return m.Decimal().StringFixed(MinorUnits(m.Currency))
+}
+
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.Amount < 0
+}
+
+// Add складывает суммы в одной валюте
+func (m Money) Add(other Money) (Money, error) {
+	if err := m.sameCurrency(other); err != nil {
+		return Money{}, err
+	}
+	return New(m.Amount+other.Amount, m.Currency), nil
+}
+
+// Sub вычитает сумму в той же валюте
+func (m Money) Sub(other Money) (Money, error) {
+	if err := m.sameCurrency(other); err != nil {
+		return Money{}, err
+	}
+	return New(m.Amount-other.Amount, m.Currency), nil
+}
+
+// Percent возвращает процент от суммы, округленный до минимальной единицы валюты
+func (m Money) Percent(percent int) Money {
+	amount := decimal.NewFromInt(m.Amount).Mul(decimal.NewFromInt(int64(percent))).Div(decimal.NewFromInt(100))
+	return New(amount.RoundBank(0).IntPart(), m.Currency)
+}
+
+// Convert пересчитывает сумму в валюту currency по курсу rate (единиц currency за единицу исходной валюты)
+func (m Money) Convert(rate decimal.Decimal, currency string) Money {
+	return FromDecimal(m.Decimal().Mul(rate), currency)
+}
+
+func (m Money) sameCurrency(other Money) error {
+	if m.Currency != other.Currency {
+		return errors.WithMessage(
+			errors.ErrInvalidInput,
+			fmt.Sprintf("currency mismatch: %s and %s", m.Currency, other.Currency),
+		)
+	}
+	return nil
+}
+
+// Sum складывает суммы в валюте currency
+func Sum(currency string, amounts ...Money) (Money, error) {
+	total := Zero(currency)
+	for _, amount := range amounts {
+		var err error
+		if total, err = total.Add(amount); err != nil {
+			return Money{}, err
+		}
+	}
+	return total, nil
+}
+
+// Value сохраняет сумму в составной тип Postgres money_amount (amount BIGINT, currency CHAR(3))
+func (m Money) Value() (driver.Value, error) {
+	return fmt.Sprintf("(%d,%s)", m.Amount, m.Currency), nil
+}
+
+// Scan читает составной тип money_amount в текстовом представлении "(12345,RUB)"
+func (m *Money) Scan(src any) error {
+	var value string
+	switch v := src.(type) {
+	case []byte:
+		value = string(v)
+	case string:
+		value = v
+	default:
+		return fmt.Errorf("unsupported money type %T", src)
+	}
+
+	parts := strings.Split(strings.Trim(value, "()"), ",")
+	if len(parts) != 2 {
+		return fmt.Errorf("invalid money value %q", value)
+	}
+	amount, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid money amount %q: %w", value, err)
+	}
+
+	*m = New(amount, strings.TrimSpace(strings.Trim(parts[1], `"`)))
+	return nil
+}
+
+func (m Money) ToProto() *moneypb.Money {
+	return &moneypb.Money{
+		Amount:   m.Amount,
+		Currency: m.Currency,
+	}
+}
+
+// OptionalToProto возвращает nil для отсутствующей суммы
+func OptionalToProto(m *Money) *moneypb.Money {
+	if m == nil {
+		return nil
+	}
+	return m.ToProto()
+}
+
+func FromProto(m *moneypb.Money) Money {
+	if m == nil {
+		return Money{}
+	}
+	return New(m.Amount, m.Currency)
+}
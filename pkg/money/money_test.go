@@ -0,0 +1,258 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/semho/hotel-booking/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+func TestFromDecimal(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   string
+		currency string
+		want     Money
+	}{
+		{name: "whole amount", amount: "10", currency: "RUB", want: New(1000, "RUB")},
+		{name: "currency code is upper-cased", amount: "1.5", currency: "rub", want: New(150, "RUB")},
+		{name: "below half rounds down", amount: "1.004", currency: "RUB", want: New(100, "RUB")},
+		{name: "above half rounds up", amount: "1.006", currency: "RUB", want: New(101, "RUB")},
+		{name: "half rounds to even down", amount: "1.005", currency: "RUB", want: New(100, "RUB")},
+		{name: "half rounds to even up", amount: "1.015", currency: "RUB", want: New(102, "RUB")},
+		{name: "negative half rounds to even", amount: "-1.005", currency: "RUB", want: New(-100, "RUB")},
+		{name: "negative half rounds to even up", amount: "-1.015", currency: "RUB", want: New(-102, "RUB")},
+		{name: "zero decimals", amount: "1500", currency: "JPY", want: New(1500, "JPY")},
+		{name: "zero decimals half to even down", amount: "100.5", currency: "JPY", want: New(100, "JPY")},
+		{name: "zero decimals half to even up", amount: "101.5", currency: "JPY", want: New(102, "JPY")},
+		{name: "three decimals", amount: "1.234", currency: "KWD", want: New(1234, "KWD")},
+		{name: "three decimals half to even down", amount: "0.1245", currency: "BHD", want: New(124, "BHD")},
+		{name: "three decimals half to even up", amount: "0.1235", currency: "BHD", want: New(124, "BHD")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FromDecimal(decimal.RequireFromString(tt.amount), tt.currency)
+			if got != tt.want {
+				t.Fatalf("FromDecimal(%s, %s) = %+v, want %+v", tt.amount, tt.currency, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   string
+		currency string
+		want     Money
+		wantErr  bool
+	}{
+		{name: "two decimals", amount: "1234.50", currency: "RUB", want: New(123450, "RUB")},
+		{name: "short fraction", amount: "1234.5", currency: "RUB", want: New(123450, "RUB")},
+		{name: "negative", amount: "-10.01", currency: "USD", want: New(-1001, "USD")},
+		{name: "extra precision is rounded to even", amount: "0.125", currency: "EUR", want: New(12, "EUR")},
+		{name: "zero decimals", amount: "500", currency: "JPY", want: New(500, "JPY")},
+		{name: "three decimals", amount: "1.2345", currency: "KWD", want: New(1234, "KWD")},
+		{name: "empty", amount: "", currency: "RUB", wantErr: true},
+		{name: "not a number", amount: "12,50", currency: "RUB", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.amount, tt.currency)
+			if tt.wantErr {
+				if !errors.IsInvalidInput(err) {
+					t.Fatalf("Parse(%q) error = %v, want invalid input", tt.amount, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse(%q) unexpected error: %v", tt.amount, err)
+			}
+			if got != tt.want {
+				t.Fatalf("Parse(%q, %s) = %+v, want %+v", tt.amount, tt.currency, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		money Money
+		want  string
+	}{
+		{money: New(123450, "RUB"), want: "1234.50"},
+		{money: New(-5, "USD"), want: "-0.05"},
+		{money: New(1500, "JPY"), want: "1500"},
+		{money: New(1234, "KWD"), want: "1.234"},
+		{money: New(5, "BHD"), want: "0.005"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.money.String(); got != tt.want {
+			t.Errorf("%+v.String() = %q, want %q", tt.money, got, tt.want)
+		}
+	}
+}
+
+func TestPercent(t *testing.T) {
+	tests := []struct {
+		name    string
+		money   Money
+		percent int
+		want    Money
+	}{
+		{name: "exact", money: New(1000, "RUB"), percent: 15, want: New(150, "RUB")},
+		{name: "zero percent", money: New(1000, "RUB"), percent: 0, want: New(0, "RUB")},
+		{name: "whole amount", money: New(1000, "RUB"), percent: 100, want: New(1000, "RUB")},
+		{name: "half rounds to even down", money: New(1005, "RUB"), percent: 10, want: New(100, "RUB")},
+		{name: "half rounds to even up", money: New(1015, "RUB"), percent: 10, want: New(102, "RUB")},
+		{name: "zero decimals half to even down", money: New(1, "JPY"), percent: 50, want: New(0, "JPY")},
+		{name: "zero decimals half to even up", money: New(3, "JPY"), percent: 50, want: New(2, "JPY")},
+		{name: "three decimals", money: New(1234, "KWD"), percent: 25, want: New(308, "KWD")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.money.Percent(tt.percent); got != tt.want {
+				t.Fatalf("%+v.Percent(%d) = %+v, want %+v", tt.money, tt.percent, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name     string
+		money    Money
+		rate     string
+		currency string
+		want     Money
+	}{
+		{name: "to two decimals", money: New(10000, "USD"), rate: "90.5", currency: "RUB", want: New(905000, "RUB")},
+		{name: "fractional rate", money: New(100000, "RUB"), rate: "0.0105", currency: "USD", want: New(1050, "USD")},
+		{name: "half rounds to even", money: New(100, "USD"), rate: "0.0125", currency: "EUR", want: New(1, "EUR")},
+		{name: "to zero decimals", money: New(10000, "USD"), rate: "150.255", currency: "JPY", want: New(15026, "JPY")},
+		{name: "from zero decimals", money: New(1000, "JPY"), rate: "0.0067", currency: "USD", want: New(670, "USD")},
+		{name: "to three decimals", money: New(100, "RUB"), rate: "0.0041", currency: "BHD", want: New(4, "BHD")},
+		{name: "from three decimals", money: New(1234, "KWD"), rate: "3.25", currency: "USD", want: New(401, "USD")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.money.Convert(decimal.RequireFromString(tt.rate), tt.currency)
+			if got != tt.want {
+				t.Fatalf("%+v.Convert(%s, %s) = %+v, want %+v", tt.money, tt.rate, tt.currency, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSum(t *testing.T) {
+	tests := []struct {
+		name     string
+		currency string
+		amounts  []Money
+		want     Money
+		wantErr  bool
+	}{
+		{name: "no amounts", currency: "RUB", want: New(0, "RUB")},
+		{name: "same currency", currency: "RUB", amounts: []Money{New(100, "RUB"), New(250, "RUB")}, want: New(350, "RUB")},
+		{name: "negative amounts", currency: "USD", amounts: []Money{New(100, "USD"), New(-30, "USD")}, want: New(70, "USD")},
+		{name: "mixed currencies", currency: "RUB", amounts: []Money{New(100, "RUB"), New(1, "USD")}, wantErr: true},
+		{name: "other currency than total", currency: "USD", amounts: []Money{New(100, "RUB")}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Sum(tt.currency, tt.amounts...)
+			if tt.wantErr {
+				if !errors.IsInvalidInput(err) {
+					t.Fatalf("Sum error = %v, want invalid input", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Sum unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("Sum = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValue(t *testing.T) {
+	tests := []struct {
+		money Money
+		want  string
+	}{
+		{money: New(12345, "RUB"), want: "(12345,RUB)"},
+		{money: New(-100, "USD"), want: "(-100,USD)"},
+		{money: New(0, "JPY"), want: "(0,JPY)"},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.money.Value()
+		if err != nil {
+			t.Fatalf("%+v.Value() unexpected error: %v", tt.money, err)
+		}
+		if got != tt.want {
+			t.Errorf("%+v.Value() = %v, want %q", tt.money, got, tt.want)
+		}
+	}
+}
+
+func TestScan(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     any
+		want    Money
+		wantErr bool
+	}{
+		{name: "string", src: "(12345,RUB)", want: New(12345, "RUB")},
+		{name: "bytes", src: []byte("(-100,USD)"), want: New(-100, "USD")},
+		{name: "quoted currency", src: `(500,"JPY")`, want: New(500, "JPY")},
+		{name: "padded currency", src: "(1234,KWD )", want: New(1234, "KWD")},
+		{name: "unsupported type", src: int64(100), wantErr: true},
+		{name: "not an amount", src: "(abc,RUB)", wantErr: true},
+		{name: "wrong field count", src: "(1,RUB,USD)", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Money
+			err := got.Scan(tt.src)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Scan(%v) = %+v, want error", tt.src, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Scan(%v) unexpected error: %v", tt.src, err)
+			}
+			if got != tt.want {
+				t.Fatalf("Scan(%v) = %+v, want %+v", tt.src, got, tt.want)
+			}
+		})
+	}
+}
+
+// Сумма, сохраненная через Value, читается Scan без изменений
+func TestValueScanRoundTrip(t *testing.T) {
+	for _, m := range []Money{New(123450, "RUB"), New(-1, "USD"), New(1500, "JPY"), New(1234, "KWD")} {
+		value, err := m.Value()
+		if err != nil {
+			t.Fatalf("%+v.Value() unexpected error: %v", m, err)
+		}
+		var got Money
+		if err := got.Scan(value); err != nil {
+			t.Fatalf("Scan(%v) unexpected error: %v", value, err)
+		}
+		if got != m {
+			t.Errorf("round trip of %+v = %+v", m, got)
+		}
+	}
+}
@@ -7,6 +7,7 @@
 package booking
 
 import (
+	money "github.com/semho/hotel-booking/pkg/proto/money_v1/money"
 	room "github.com/semho/hotel-booking/pkg/proto/room_v1/room"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -82,15 +83,230 @@ func (BookingStatus) EnumDescriptor() ([]byte, []int) {
 	return file_booking_booking_proto_rawDescGZIP(), []int{0}
 }
 
+// Тип скидки промокода
+type PromoDiscountType int32
+
+const (
+	PromoDiscountType_PROMO_DISCOUNT_TYPE_UNSPECIFIED PromoDiscountType = 0
+	PromoDiscountType_PROMO_DISCOUNT_TYPE_PERCENT     PromoDiscountType = 1 // Процент от цены каждой ночи
+	PromoDiscountType_PROMO_DISCOUNT_TYPE_FIXED       PromoDiscountType = 2 // Фиксированная сумма на всю бронь
+)
+
+// Enum value maps for PromoDiscountType.
+var (
+	PromoDiscountType_name = map[int32]string{
+		0: "PROMO_DISCOUNT_TYPE_UNSPECIFIED",
+		1: "PROMO_DISCOUNT_TYPE_PERCENT",
+		2: "PROMO_DISCOUNT_TYPE_FIXED",
+	}
+	PromoDiscountType_value = map[string]int32{
+		"PROMO_DISCOUNT_TYPE_UNSPECIFIED": 0,
+		"PROMO_DISCOUNT_TYPE_PERCENT":     1,
+		"PROMO_DISCOUNT_TYPE_FIXED":       2,
+	}
+)
+
+func (x PromoDiscountType) Enum() *PromoDiscountType {
+	p := new(PromoDiscountType)
+	*p = x
+	return p
+}
+
+func (x PromoDiscountType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PromoDiscountType) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_booking_proto_enumTypes[1].Descriptor()
+}
+
+func (PromoDiscountType) Type() protoreflect.EnumType {
+	return &file_booking_booking_proto_enumTypes[1]
+}
+
+func (x PromoDiscountType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PromoDiscountType.Descriptor instead.
+func (PromoDiscountType) EnumDescriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{1}
+}
+
+// Состояние комнаты на ночь в календаре доступности
+type AvailabilityStatus int32
+
+const (
+	AvailabilityStatus_AVAILABILITY_STATUS_UNSPECIFIED    AvailabilityStatus = 0
+	AvailabilityStatus_AVAILABILITY_STATUS_FREE           AvailabilityStatus = 1 // Можно продать
+	AvailabilityStatus_AVAILABILITY_STATUS_BOOKED         AvailabilityStatus = 2 // Занята активной бронью
+	AvailabilityStatus_AVAILABILITY_STATUS_BLOCKED        AvailabilityStatus = 3 // В ремонте
+	AvailabilityStatus_AVAILABILITY_STATUS_OUT_OF_SERVICE AvailabilityStatus = 4 // Выведена из эксплуатации
+)
+
+// Enum value maps for AvailabilityStatus.
+var (
+	AvailabilityStatus_name = map[int32]string{
+		0: "AVAILABILITY_STATUS_UNSPECIFIED",
+		1: "AVAILABILITY_STATUS_FREE",
+		2: "AVAILABILITY_STATUS_BOOKED",
+		3: "AVAILABILITY_STATUS_BLOCKED",
+		4: "AVAILABILITY_STATUS_OUT_OF_SERVICE",
+	}
+	AvailabilityStatus_value = map[string]int32{
+		"AVAILABILITY_STATUS_UNSPECIFIED":    0,
+		"AVAILABILITY_STATUS_FREE":           1,
+		"AVAILABILITY_STATUS_BOOKED":         2,
+		"AVAILABILITY_STATUS_BLOCKED":        3,
+		"AVAILABILITY_STATUS_OUT_OF_SERVICE": 4,
+	}
+)
+
+func (x AvailabilityStatus) Enum() *AvailabilityStatus {
+	p := new(AvailabilityStatus)
+	*p = x
+	return p
+}
+
+func (x AvailabilityStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AvailabilityStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_booking_proto_enumTypes[2].Descriptor()
+}
+
+func (AvailabilityStatus) Type() protoreflect.EnumType {
+	return &file_booking_booking_proto_enumTypes[2]
+}
+
+func (x AvailabilityStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AvailabilityStatus.Descriptor instead.
+func (AvailabilityStatus) EnumDescriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{2}
+}
+
+// Строки календаря доступности
+type CalendarGrouping int32
+
+const (
+	CalendarGrouping_CALENDAR_GROUPING_UNSPECIFIED CalendarGrouping = 0 // То же, что ROOM
+	CalendarGrouping_CALENDAR_GROUPING_ROOM        CalendarGrouping = 1
+	CalendarGrouping_CALENDAR_GROUPING_ROOM_TYPE   CalendarGrouping = 2
+)
+
+// Enum value maps for CalendarGrouping.
+var (
+	CalendarGrouping_name = map[int32]string{
+		0: "CALENDAR_GROUPING_UNSPECIFIED",
+		1: "CALENDAR_GROUPING_ROOM",
+		2: "CALENDAR_GROUPING_ROOM_TYPE",
+	}
+	CalendarGrouping_value = map[string]int32{
+		"CALENDAR_GROUPING_UNSPECIFIED": 0,
+		"CALENDAR_GROUPING_ROOM":        1,
+		"CALENDAR_GROUPING_ROOM_TYPE":   2,
+	}
+)
+
+func (x CalendarGrouping) Enum() *CalendarGrouping {
+	p := new(CalendarGrouping)
+	*p = x
+	return p
+}
+
+func (x CalendarGrouping) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CalendarGrouping) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_booking_proto_enumTypes[3].Descriptor()
+}
+
+func (CalendarGrouping) Type() protoreflect.EnumType {
+	return &file_booking_booking_proto_enumTypes[3]
+}
+
+func (x CalendarGrouping) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CalendarGrouping.Descriptor instead.
+func (CalendarGrouping) EnumDescriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{3}
+}
+
+// Статусы записи листа ожидания
+type WaitlistStatus int32
+
+const (
+	WaitlistStatus_WAITLIST_STATUS_UNSPECIFIED WaitlistStatus = 0
+	WaitlistStatus_WAITLIST_STATUS_WAITING     WaitlistStatus = 1 // Ждет освобождения подходящей комнаты
+	WaitlistStatus_WAITLIST_STATUS_OFFERED     WaitlistStatus = 2 // Гостю удержана комната, создана PENDING бронь
+	WaitlistStatus_WAITLIST_STATUS_EXPIRED     WaitlistStatus = 3 // Предложение не подтверждено вовремя или даты прошли
+	WaitlistStatus_WAITLIST_STATUS_CANCELLED   WaitlistStatus = 4 // Гость покинул лист ожидания или отменил предложенную бронь
+)
+
+// Enum value maps for WaitlistStatus.
+var (
+	WaitlistStatus_name = map[int32]string{
+		0: "WAITLIST_STATUS_UNSPECIFIED",
+		1: "WAITLIST_STATUS_WAITING",
+		2: "WAITLIST_STATUS_OFFERED",
+		3: "WAITLIST_STATUS_EXPIRED",
+		4: "WAITLIST_STATUS_CANCELLED",
+	}
+	WaitlistStatus_value = map[string]int32{
+		"WAITLIST_STATUS_UNSPECIFIED": 0,
+		"WAITLIST_STATUS_WAITING":     1,
+		"WAITLIST_STATUS_OFFERED":     2,
+		"WAITLIST_STATUS_EXPIRED":     3,
+		"WAITLIST_STATUS_CANCELLED":   4,
+	}
+)
+
+func (x WaitlistStatus) Enum() *WaitlistStatus {
+	p := new(WaitlistStatus)
+	*p = x
+	return p
+}
+
+func (x WaitlistStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WaitlistStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_booking_proto_enumTypes[4].Descriptor()
+}
+
+func (WaitlistStatus) Type() protoreflect.EnumType {
+	return &file_booking_booking_proto_enumTypes[4]
+}
+
+func (x WaitlistStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WaitlistStatus.Descriptor instead.
+func (WaitlistStatus) EnumDescriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{4}
+}
+
 type GetAvailableRoomsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// Учитываются только даты; заезд и выезд — в стандартное время по часовому поясу отеля
 	CheckIn  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=check_in,json=checkIn,proto3" json:"check_in,omitempty"`
 	CheckOut *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=check_out,json=checkOut,proto3" json:"check_out,omitempty"`
 	Capacity *int32                 `protobuf:"varint,3,opt,name=capacity,proto3,oneof" json:"capacity,omitempty"`
 	Type     *room.RoomType         `protobuf:"varint,4,opt,name=type,proto3,enum=hotel.room.v1.RoomType,oneof" json:"type,omitempty"`
+	// Валюта (ISO 4217), в которую пересчитываются цены комнат
+	Currency *string `protobuf:"bytes,5,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
 }
 
 func (x *GetAvailableRoomsRequest) Reset() {
@@ -153,6 +369,13 @@ func (x *GetAvailableRoomsRequest) GetType() room.RoomType {
 	return room.RoomType(0)
 }
 
+func (x *GetAvailableRoomsRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
 type GetAvailableRoomsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -205,6 +428,7 @@ type CreateBookingRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// Учитываются только даты; заезд и выезд — в стандартное время по часовому поясу отеля
 	CheckIn    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=check_in,json=checkIn,proto3" json:"check_in,omitempty"`
 	CheckOut   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=check_out,json=checkOut,proto3" json:"check_out,omitempty"`
 	Capacity   *int32                 `protobuf:"varint,3,opt,name=capacity,proto3,oneof" json:"capacity,omitempty"`
@@ -213,6 +437,11 @@ type CreateBookingRequest struct {
 	GuestName  string                 `protobuf:"bytes,6,opt,name=guest_name,json=guestName,proto3" json:"guest_name,omitempty"`
 	GuestEmail string                 `protobuf:"bytes,7,opt,name=guest_email,json=guestEmail,proto3" json:"guest_email,omitempty"`
 	GuestPhone string                 `protobuf:"bytes,8,opt,name=guest_phone,json=guestPhone,proto3" json:"guest_phone,omitempty"`
+	// Конкретная комната, выбранная гостем из GetAvailableRooms. Если не задана, бронируется тип комнаты:
+	// бронь создается без room_id, комната назначается распределением перед заездом или при заселении
+	RoomId *string `protobuf:"bytes,9,opt,name=room_id,json=roomId,proto3,oneof" json:"room_id,omitempty"`
+	// Промокод на скидку
+	PromoCode *string `protobuf:"bytes,10,opt,name=promo_code,json=promoCode,proto3,oneof" json:"promo_code,omitempty"`
 }
 
 func (x *CreateBookingRequest) Reset() {
@@ -303,6 +532,20 @@ func (x *CreateBookingRequest) GetGuestPhone() string {
 	return ""
 }
 
+func (x *CreateBookingRequest) GetRoomId() string {
+	if x != nil && x.RoomId != nil {
+		return *x.RoomId
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetPromoCode() string {
+	if x != nil && x.PromoCode != nil {
+		return *x.PromoCode
+	}
+	return ""
+}
+
 type CreateBookingResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -468,27 +711,18 @@ func (x *UpdateBookingStatusResponse) GetBooking() *Booking {
 	return nil
 }
 
-// структура Booking
-type Booking struct {
+type GetBookingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	RoomId        string                 `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	UserId        *string                `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
-	GuestName     string                 `protobuf:"bytes,4,opt,name=guest_name,json=guestName,proto3" json:"guest_name,omitempty"`
-	GuestEmail    string                 `protobuf:"bytes,5,opt,name=guest_email,json=guestEmail,proto3" json:"guest_email,omitempty"`
-	GuestPhone    string                 `protobuf:"bytes,6,opt,name=guest_phone,json=guestPhone,proto3" json:"guest_phone,omitempty"`
-	CheckIn       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=check_in,json=checkIn,proto3" json:"check_in,omitempty"`
-	CheckOut      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=check_out,json=checkOut,proto3" json:"check_out,omitempty"`
-	TotalPrice    float64                `protobuf:"fixed64,9,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	CurrentStatus BookingStatus          `protobuf:"varint,11,opt,name=current_status,json=currentStatus,proto3,enum=hotel.booking.v1.BookingStatus" json:"current_status,omitempty"`
+	BookingId string `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	// Валюта (ISO 4217) для converted_total_price
+	Currency *string `protobuf:"bytes,2,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
 }
 
-func (x *Booking) Reset() {
-	*x = Booking{}
+func (x *GetBookingRequest) Reset() {
+	*x = GetBookingRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_booking_booking_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -496,13 +730,13 @@ func (x *Booking) Reset() {
 	}
 }
 
-func (x *Booking) String() string {
+func (x *GetBookingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Booking) ProtoMessage() {}
+func (*GetBookingRequest) ProtoMessage() {}
 
-func (x *Booking) ProtoReflect() protoreflect.Message {
+func (x *GetBookingRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_booking_booking_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -514,303 +748,7432 @@ func (x *Booking) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Booking.ProtoReflect.Descriptor instead.
-func (*Booking) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBookingRequest.ProtoReflect.Descriptor instead.
+func (*GetBookingRequest) Descriptor() ([]byte, []int) {
 	return file_booking_booking_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Booking) GetId() string {
+func (x *GetBookingRequest) GetBookingId() string {
 	if x != nil {
-		return x.Id
+		return x.BookingId
 	}
 	return ""
 }
 
-func (x *Booking) GetRoomId() string {
-	if x != nil {
-		return x.RoomId
+func (x *GetBookingRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
 	}
 	return ""
 }
 
-func (x *Booking) GetUserId() string {
-	if x != nil && x.UserId != nil {
-		return *x.UserId
+type GetBookingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Booking *Booking `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+}
+
+func (x *GetBookingResponse) Reset() {
+	*x = GetBookingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Booking) GetGuestName() string {
-	if x != nil {
-		return x.GuestName
+func (x *GetBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingResponse) ProtoMessage() {}
+
+func (x *GetBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Booking) GetGuestEmail() string {
+// Deprecated: Use GetBookingResponse.ProtoReflect.Descriptor instead.
+func (*GetBookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetBookingResponse) GetBooking() *Booking {
 	if x != nil {
-		return x.GuestEmail
+		return x.Booking
+	}
+	return nil
+}
+
+type ListBookingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId *string        `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	RoomId *string        `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3,oneof" json:"room_id,omitempty"`
+	Status *BookingStatus `protobuf:"varint,3,opt,name=status,proto3,enum=hotel.booking.v1.BookingStatus,oneof" json:"status,omitempty"`
+	// Брони, пересекающиеся с периодом [from, to)
+	From     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	PageSize int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Курсор из next_page_token предыдущего ответа
+	PageToken string `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Валюта (ISO 4217) для converted_total_price
+	Currency       *string `protobuf:"bytes,8,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	GuestProfileId *string `protobuf:"bytes,9,opt,name=guest_profile_id,json=guestProfileId,proto3,oneof" json:"guest_profile_id,omitempty"`
+}
+
+func (x *ListBookingsRequest) Reset() {
+	*x = ListBookingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookingsRequest) ProtoMessage() {}
+
+func (x *ListBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookingsRequest.ProtoReflect.Descriptor instead.
+func (*ListBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListBookingsRequest) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
 	}
 	return ""
 }
 
-func (x *Booking) GetGuestPhone() string {
-	if x != nil {
-		return x.GuestPhone
+func (x *ListBookingsRequest) GetRoomId() string {
+	if x != nil && x.RoomId != nil {
+		return *x.RoomId
 	}
 	return ""
 }
 
-func (x *Booking) GetCheckIn() *timestamppb.Timestamp {
+func (x *ListBookingsRequest) GetStatus() BookingStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
+}
+
+func (x *ListBookingsRequest) GetFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CheckIn
+		return x.From
 	}
 	return nil
 }
 
-func (x *Booking) GetCheckOut() *timestamppb.Timestamp {
+func (x *ListBookingsRequest) GetTo() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CheckOut
+		return x.To
 	}
 	return nil
 }
 
-func (x *Booking) GetTotalPrice() float64 {
+func (x *ListBookingsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.TotalPrice
+		return x.PageSize
 	}
 	return 0
 }
 
-func (x *Booking) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ListBookingsRequest) GetPageToken() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.PageToken
 	}
-	return nil
+	return ""
 }
 
-func (x *Booking) GetCurrentStatus() BookingStatus {
-	if x != nil {
-		return x.CurrentStatus
+func (x *ListBookingsRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
 	}
-	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
+	return ""
 }
 
-var File_booking_booking_proto protoreflect.FileDescriptor
+func (x *ListBookingsRequest) GetGuestProfileId() string {
+	if x != nil && x.GuestProfileId != nil {
+		return *x.GuestProfileId
+	}
+	return ""
+}
 
-var file_booking_booking_proto_rawDesc = []byte{
-	0x0a, 0x15, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
-	0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62,
-	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x72, 0x6f, 0x6f, 0x6d, 0x2f, 0x72,
-	0x6f, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf3, 0x01, 0x0a, 0x18, 0x47, 0x65,
-	0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f,
-	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x12, 0x37, 0x0a,
-	0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x63, 0x68,
-	0x65, 0x63, 0x6b, 0x4f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69,
-	0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61,
-	0x63, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x72, 0x6f,
-	0x6f, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x48, 0x01,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x61,
-	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22,
-	0x46, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52,
-	0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05,
-	0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x68, 0x6f,
-	0x74, 0x65, 0x6c, 0x2e, 0x72, 0x6f, 0x6f, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d,
-	0x52, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x22, 0xfa, 0x02, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x35, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07,
-	0x63, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x12, 0x37, 0x0a, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b,
-	0x5f, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x4f, 0x75, 0x74,
-	0x12, 0x1f, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x48, 0x00, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x88, 0x01,
-	0x01, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x17, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x72, 0x6f, 0x6f, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x48, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01,
-	0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x75, 0x65, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x75, 0x65, 0x73, 0x74, 0x45, 0x6d, 0x61, 0x69,
-	0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x68, 0x6f, 0x6e, 0x65,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x75, 0x65, 0x73, 0x74, 0x50, 0x68, 0x6f,
-	0x6e, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x42,
-	0x07, 0x0a, 0x05, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x22, 0x4c, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f,
-	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a,
-	0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76,
-	0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
-	0x6e, 0x67, 0x22, 0xab, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f,
-	0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64,
-	0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x1f, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
-	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x42, 0x79,
-	0x22, 0x52, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
-	0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x33, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
-	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x6f, 0x6f,
-	0x6b, 0x69, 0x6e, 0x67, 0x22, 0xd1, 0x03, 0x0a, 0x07, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x07, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x75, 0x65, 0x73, 0x74,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x75, 0x65,
-	0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x75, 0x65, 0x73, 0x74, 0x5f,
-	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x75, 0x65,
-	0x73, 0x74, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x75, 0x65, 0x73, 0x74,
-	0x5f, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x75,
-	0x65, 0x73, 0x74, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63,
-	0x6b, 0x5f, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x12,
-	0x37, 0x0a, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08,
-	0x63, 0x68, 0x65, 0x63, 0x6b, 0x4f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x46, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x68,
-	0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
-	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0a, 0x0a, 0x08,
-	0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x2a, 0xc1, 0x01, 0x0a, 0x0d, 0x42, 0x6f, 0x6f,
-	0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x42, 0x4f,
-	0x4f, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53,
-	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x42, 0x4f,
-	0x4f, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e,
-	0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x42, 0x4f, 0x4f, 0x4b, 0x49, 0x4e,
-	0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d,
-	0x45, 0x44, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x42, 0x4f, 0x4f, 0x4b, 0x49, 0x4e, 0x47, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44,
-	0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x42, 0x4f, 0x4f, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x04,
-	0x12, 0x1a, 0x0a, 0x16, 0x42, 0x4f, 0x4f, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x4e, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x57, 0x10, 0x05, 0x32, 0xbf, 0x03, 0x0a,
-	0x0e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x8d, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
-	0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x2a, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f,
-	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69,
-	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2b, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
-	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
-	0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
-	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x2d, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x12,
-	0x7a, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
-	0x12, 0x26, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c,
-	0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x22, 0x10, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12, 0xa0, 0x01, 0x0a, 0x13,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x2c, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b,
-	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f,
-	0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2d, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
-	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69,
-	0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x1a, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
-	0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x62, 0x6f, 0x6f, 0x6b,
-	0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x3d,
-	0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x65, 0x6d,
-	0x68, 0x6f, 0x2f, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2d, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
-	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
-	0x6e, 0x67, 0x5f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+type ListBookingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bookings []*Booking `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"`
+	// Пустой, если страниц больше нет
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
-var (
-	file_booking_booking_proto_rawDescOnce sync.Once
-	file_booking_booking_proto_rawDescData = file_booking_booking_proto_rawDesc
-)
+func (x *ListBookingsResponse) Reset() {
+	*x = ListBookingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_booking_booking_proto_rawDescGZIP() []byte {
-	file_booking_booking_proto_rawDescOnce.Do(func() {
-		file_booking_booking_proto_rawDescData = protoimpl.X.CompressGZIP(file_booking_booking_proto_rawDescData)
-	})
-	return file_booking_booking_proto_rawDescData
+func (x *ListBookingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_booking_booking_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_booking_booking_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
-var file_booking_booking_proto_goTypes = []interface{}{
-	(BookingStatus)(0),                  // 0: hotel.booking.v1.BookingStatus
-	(*GetAvailableRoomsRequest)(nil),    // 1: hotel.booking.v1.GetAvailableRoomsRequest
-	(*GetAvailableRoomsResponse)(nil),   // 2: hotel.booking.v1.GetAvailableRoomsResponse
-	(*CreateBookingRequest)(nil),        // 3: hotel.booking.v1.CreateBookingRequest
-	(*CreateBookingResponse)(nil),       // 4: hotel.booking.v1.CreateBookingResponse
-	(*UpdateBookingStatusRequest)(nil),  // 5: hotel.booking.v1.UpdateBookingStatusRequest
-	(*UpdateBookingStatusResponse)(nil), // 6: hotel.booking.v1.UpdateBookingStatusResponse
-	(*Booking)(nil),                     // 7: hotel.booking.v1.Booking
-	(*timestamppb.Timestamp)(nil),       // 8: google.protobuf.Timestamp
-	(room.RoomType)(0),                  // 9: hotel.room.v1.RoomType
-	(*room.Room)(nil),                   // 10: hotel.room.v1.Room
+func (*ListBookingsResponse) ProtoMessage() {}
+
+func (x *ListBookingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_booking_booking_proto_depIdxs = []int32{
-	8,  // 0: hotel.booking.v1.GetAvailableRoomsRequest.check_in:type_name -> google.protobuf.Timestamp
-	8,  // 1: hotel.booking.v1.GetAvailableRoomsRequest.check_out:type_name -> google.protobuf.Timestamp
-	9,  // 2: hotel.booking.v1.GetAvailableRoomsRequest.type:type_name -> hotel.room.v1.RoomType
-	10, // 3: hotel.booking.v1.GetAvailableRoomsResponse.rooms:type_name -> hotel.room.v1.Room
-	8,  // 4: hotel.booking.v1.CreateBookingRequest.check_in:type_name -> google.protobuf.Timestamp
-	8,  // 5: hotel.booking.v1.CreateBookingRequest.check_out:type_name -> google.protobuf.Timestamp
-	9,  // 6: hotel.booking.v1.CreateBookingRequest.type:type_name -> hotel.room.v1.RoomType
-	7,  // 7: hotel.booking.v1.CreateBookingResponse.booking:type_name -> hotel.booking.v1.Booking
-	0,  // 8: hotel.booking.v1.UpdateBookingStatusRequest.status:type_name -> hotel.booking.v1.BookingStatus
-	7,  // 9: hotel.booking.v1.UpdateBookingStatusResponse.booking:type_name -> hotel.booking.v1.Booking
-	8,  // 10: hotel.booking.v1.Booking.check_in:type_name -> google.protobuf.Timestamp
-	8,  // 11: hotel.booking.v1.Booking.check_out:type_name -> google.protobuf.Timestamp
-	8,  // 12: hotel.booking.v1.Booking.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 13: hotel.booking.v1.Booking.current_status:type_name -> hotel.booking.v1.BookingStatus
-	1,  // 14: hotel.booking.v1.BookingService.GetAvailableRooms:input_type -> hotel.booking.v1.GetAvailableRoomsRequest
-	3,  // 15: hotel.booking.v1.BookingService.CreateBooking:input_type -> hotel.booking.v1.CreateBookingRequest
-	5,  // 16: hotel.booking.v1.BookingService.UpdateBookingStatus:input_type -> hotel.booking.v1.UpdateBookingStatusRequest
-	2,  // 17: hotel.booking.v1.BookingService.GetAvailableRooms:output_type -> hotel.booking.v1.GetAvailableRoomsResponse
-	4,  // 18: hotel.booking.v1.BookingService.CreateBooking:output_type -> hotel.booking.v1.CreateBookingResponse
-	6,  // 19: hotel.booking.v1.BookingService.UpdateBookingStatus:output_type -> hotel.booking.v1.UpdateBookingStatusResponse
-	17, // [17:20] is the sub-list for method output_type
-	14, // [14:17] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+
+// Deprecated: Use ListBookingsResponse.ProtoReflect.Descriptor instead.
+func (*ListBookingsResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{9}
 }
 
-func init() { file_booking_booking_proto_init() }
-func file_booking_booking_proto_init() {
-	if File_booking_booking_proto != nil {
-		return
+func (x *ListBookingsResponse) GetBookings() []*Booking {
+	if x != nil {
+		return x.Bookings
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_booking_booking_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAvailableRoomsRequest); i {
+	return nil
+}
+
+func (x *ListBookingsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetBookingHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookingId string `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+}
+
+func (x *GetBookingHistoryRequest) Reset() {
+	*x = GetBookingHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBookingHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingHistoryRequest) ProtoMessage() {}
+
+func (x *GetBookingHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetBookingHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetBookingHistoryRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+type GetBookingHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	History []*BookingStatusChange `protobuf:"bytes,1,rep,name=history,proto3" json:"history,omitempty"`
+}
+
+func (x *GetBookingHistoryResponse) Reset() {
+	*x = GetBookingHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBookingHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingHistoryResponse) ProtoMessage() {}
+
+func (x *GetBookingHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetBookingHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetBookingHistoryResponse) GetHistory() []*BookingStatusChange {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+// Запись истории статусов брони
+type BookingStatusChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status    BookingStatus          `protobuf:"varint,2,opt,name=status,proto3,enum=hotel.booking.v1.BookingStatus" json:"status,omitempty"`
+	Reason    string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	ChangedBy string                 `protobuf:"bytes,4,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	ChangedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+}
+
+func (x *BookingStatusChange) Reset() {
+	*x = BookingStatusChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BookingStatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingStatusChange) ProtoMessage() {}
+
+func (x *BookingStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingStatusChange.ProtoReflect.Descriptor instead.
+func (*BookingStatusChange) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BookingStatusChange) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BookingStatusChange) GetStatus() BookingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
+}
+
+func (x *BookingStatusChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BookingStatusChange) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+func (x *BookingStatusChange) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+// структура Booking
+type Booking struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Пустой у брони на тип комнаты, пока комната не назначена распределением перед заездом или при заселении
+	RoomId     string  `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	UserId     *string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	GuestName  string  `protobuf:"bytes,4,opt,name=guest_name,json=guestName,proto3" json:"guest_name,omitempty"`
+	GuestEmail string  `protobuf:"bytes,5,opt,name=guest_email,json=guestEmail,proto3" json:"guest_email,omitempty"`
+	GuestPhone string  `protobuf:"bytes,6,opt,name=guest_phone,json=guestPhone,proto3" json:"guest_phone,omitempty"`
+	// Моменты стандартного заезда и выезда по времени отеля
+	CheckIn       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=check_in,json=checkIn,proto3" json:"check_in,omitempty"`
+	CheckOut      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=check_out,json=checkOut,proto3" json:"check_out,omitempty"`
+	TotalPrice    *money.Money           `protobuf:"bytes,22,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CurrentStatus BookingStatus          `protobuf:"varint,11,opt,name=current_status,json=currentStatus,proto3,enum=hotel.booking.v1.BookingStatus" json:"current_status,omitempty"`
+	// Статусы, в которые бронь может перейти из текущего
+	AllowedTransitions []BookingStatus `protobuf:"varint,12,rep,packed,name=allowed_transitions,json=allowedTransitions,proto3,enum=hotel.booking.v1.BookingStatus" json:"allowed_transitions,omitempty"`
+	// До какого момента PENDING бронь удерживает комнату, заполняется только для PENDING
+	HoldExpiresAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=hold_expires_at,json=holdExpiresAt,proto3" json:"hold_expires_at,omitempty"`
+	// Номер комнаты, закрепленный при заселении
+	RoomNumber string `protobuf:"bytes,14,opt,name=room_number,json=roomNumber,proto3" json:"room_number,omitempty"`
+	// Фактические время заезда и выезда
+	CheckedInAt  *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=checked_in_at,json=checkedInAt,proto3" json:"checked_in_at,omitempty"`
+	CheckedOutAt *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=checked_out_at,json=checkedOutAt,proto3" json:"checked_out_at,omitempty"`
+	// Удержанный штраф и сумма к возврату, заполняются при отмене через CancelBooking
+	CancellationPenalty *money.Money `protobuf:"bytes,23,opt,name=cancellation_penalty,json=cancellationPenalty,proto3" json:"cancellation_penalty,omitempty"`
+	RefundAmount        *money.Money `protobuf:"bytes,24,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`
+	// Стоимость в запрошенной валюте по текущему курсу; total_price остается в валюте расчетов
+	ConvertedTotalPrice *money.Money `protobuf:"bytes,25,opt,name=converted_total_price,json=convertedTotalPrice,proto3" json:"converted_total_price,omitempty"`
+	// Примененный промокод, скидка видна в price_breakdown
+	PromoCode   *string                `protobuf:"bytes,26,opt,name=promo_code,json=promoCode,proto3,oneof" json:"promo_code,omitempty"`
+	CancelledAt *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	// Групповая бронь, в которую входит бронь комнаты
+	ReservationId *string `protobuf:"bytes,20,opt,name=reservation_id,json=reservationId,proto3,oneof" json:"reservation_id,omitempty"`
+	// Цена каждой ночи по тарифному плану, в сумме дает total_price
+	PriceBreakdown []*NightPrice `protobuf:"bytes,21,rep,name=price_breakdown,json=priceBreakdown,proto3" json:"price_breakdown,omitempty"`
+	// Тип комнаты, на который оформлена бронь
+	RoomType room.RoomType `protobuf:"varint,27,opt,name=room_type,json=roomType,proto3,enum=hotel.room.v1.RoomType" json:"room_type,omitempty"`
+	// Профиль гостя, найденный по email или телефону брони
+	GuestProfileId *string `protobuf:"bytes,28,opt,name=guest_profile_id,json=guestProfileId,proto3,oneof" json:"guest_profile_id,omitempty"`
+}
+
+func (x *Booking) Reset() {
+	*x = Booking{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Booking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Booking) ProtoMessage() {}
+
+func (x *Booking) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Booking.ProtoReflect.Descriptor instead.
+func (*Booking) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Booking) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Booking) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *Booking) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *Booking) GetGuestName() string {
+	if x != nil {
+		return x.GuestName
+	}
+	return ""
+}
+
+func (x *Booking) GetGuestEmail() string {
+	if x != nil {
+		return x.GuestEmail
+	}
+	return ""
+}
+
+func (x *Booking) GetGuestPhone() string {
+	if x != nil {
+		return x.GuestPhone
+	}
+	return ""
+}
+
+func (x *Booking) GetCheckIn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckIn
+	}
+	return nil
+}
+
+func (x *Booking) GetCheckOut() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckOut
+	}
+	return nil
+}
+
+func (x *Booking) GetTotalPrice() *money.Money {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return nil
+}
+
+func (x *Booking) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Booking) GetCurrentStatus() BookingStatus {
+	if x != nil {
+		return x.CurrentStatus
+	}
+	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
+}
+
+func (x *Booking) GetAllowedTransitions() []BookingStatus {
+	if x != nil {
+		return x.AllowedTransitions
+	}
+	return nil
+}
+
+func (x *Booking) GetHoldExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.HoldExpiresAt
+	}
+	return nil
+}
+
+func (x *Booking) GetRoomNumber() string {
+	if x != nil {
+		return x.RoomNumber
+	}
+	return ""
+}
+
+func (x *Booking) GetCheckedInAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedInAt
+	}
+	return nil
+}
+
+func (x *Booking) GetCheckedOutAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedOutAt
+	}
+	return nil
+}
+
+func (x *Booking) GetCancellationPenalty() *money.Money {
+	if x != nil {
+		return x.CancellationPenalty
+	}
+	return nil
+}
+
+func (x *Booking) GetRefundAmount() *money.Money {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return nil
+}
+
+func (x *Booking) GetConvertedTotalPrice() *money.Money {
+	if x != nil {
+		return x.ConvertedTotalPrice
+	}
+	return nil
+}
+
+func (x *Booking) GetPromoCode() string {
+	if x != nil && x.PromoCode != nil {
+		return *x.PromoCode
+	}
+	return ""
+}
+
+func (x *Booking) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
+
+func (x *Booking) GetReservationId() string {
+	if x != nil && x.ReservationId != nil {
+		return *x.ReservationId
+	}
+	return ""
+}
+
+func (x *Booking) GetPriceBreakdown() []*NightPrice {
+	if x != nil {
+		return x.PriceBreakdown
+	}
+	return nil
+}
+
+func (x *Booking) GetRoomType() room.RoomType {
+	if x != nil {
+		return x.RoomType
+	}
+	return room.RoomType(0)
+}
+
+func (x *Booking) GetGuestProfileId() string {
+	if x != nil && x.GuestProfileId != nil {
+		return *x.GuestProfileId
+	}
+	return ""
+}
+
+// Примененное к цене ночи правило тарифа
+type PriceAdjustment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// date_override, weekend, season:<name>, occupancy, length_of_stay, promo:<code>
+	Rule string `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	// Изменение цены в процентах, отрицательное для скидок
+	Percent int32 `protobuf:"varint,2,opt,name=percent,proto3" json:"percent,omitempty"`
+	// Изменение цены суммой (фиксированная скидка промокода), отрицательное для скидок
+	Amount *money.Money `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *PriceAdjustment) Reset() {
+	*x = PriceAdjustment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceAdjustment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceAdjustment) ProtoMessage() {}
+
+func (x *PriceAdjustment) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceAdjustment.ProtoReflect.Descriptor instead.
+func (*PriceAdjustment) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PriceAdjustment) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *PriceAdjustment) GetPercent() int32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *PriceAdjustment) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type NightPrice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Начало ночи (дата заезда на эту ночь)
+	Date *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// Цена до корректировок: базовая цена комнаты или цена на дату
+	BasePrice   *money.Money       `protobuf:"bytes,5,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	Price       *money.Money       `protobuf:"bytes,6,opt,name=price,proto3" json:"price,omitempty"`
+	Adjustments []*PriceAdjustment `protobuf:"bytes,4,rep,name=adjustments,proto3" json:"adjustments,omitempty"`
+}
+
+func (x *NightPrice) Reset() {
+	*x = NightPrice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NightPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NightPrice) ProtoMessage() {}
+
+func (x *NightPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NightPrice.ProtoReflect.Descriptor instead.
+func (*NightPrice) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *NightPrice) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *NightPrice) GetBasePrice() *money.Money {
+	if x != nil {
+		return x.BasePrice
+	}
+	return nil
+}
+
+func (x *NightPrice) GetPrice() *money.Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *NightPrice) GetAdjustments() []*PriceAdjustment {
+	if x != nil {
+		return x.Adjustments
+	}
+	return nil
+}
+
+type RunNightlyTransitionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Только вернуть планируемые переходы, ничего не меняя
+	DryRun bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Момент, на который выполняется прогон (по умолчанию текущий)
+	AsOf *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3,oneof" json:"as_of,omitempty"`
+}
+
+func (x *RunNightlyTransitionsRequest) Reset() {
+	*x = RunNightlyTransitionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunNightlyTransitionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunNightlyTransitionsRequest) ProtoMessage() {}
+
+func (x *RunNightlyTransitionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunNightlyTransitionsRequest.ProtoReflect.Descriptor instead.
+func (*RunNightlyTransitionsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RunNightlyTransitionsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *RunNightlyTransitionsRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+type RunNightlyTransitionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transitions []*BookingStatusTransition `protobuf:"bytes,1,rep,name=transitions,proto3" json:"transitions,omitempty"`
+}
+
+func (x *RunNightlyTransitionsResponse) Reset() {
+	*x = RunNightlyTransitionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunNightlyTransitionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunNightlyTransitionsResponse) ProtoMessage() {}
+
+func (x *RunNightlyTransitionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunNightlyTransitionsResponse.ProtoReflect.Descriptor instead.
+func (*RunNightlyTransitionsResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RunNightlyTransitionsResponse) GetTransitions() []*BookingStatusTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+type BookingStatusTransition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookingId string        `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	From      BookingStatus `protobuf:"varint,2,opt,name=from,proto3,enum=hotel.booking.v1.BookingStatus" json:"from,omitempty"`
+	To        BookingStatus `protobuf:"varint,3,opt,name=to,proto3,enum=hotel.booking.v1.BookingStatus" json:"to,omitempty"`
+	Reason    string        `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *BookingStatusTransition) Reset() {
+	*x = BookingStatusTransition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BookingStatusTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingStatusTransition) ProtoMessage() {}
+
+func (x *BookingStatusTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingStatusTransition.ProtoReflect.Descriptor instead.
+func (*BookingStatusTransition) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BookingStatusTransition) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *BookingStatusTransition) GetFrom() BookingStatus {
+	if x != nil {
+		return x.From
+	}
+	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
+}
+
+func (x *BookingStatusTransition) GetTo() BookingStatus {
+	if x != nil {
+		return x.To
+	}
+	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
+}
+
+func (x *BookingStatusTransition) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CheckInRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookingId string `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	// Комната для заселения, если отличается от забронированной
+	RoomId    *string `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3,oneof" json:"room_id,omitempty"`
+	ChangedBy string  `protobuf:"bytes,3,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+}
+
+func (x *CheckInRequest) Reset() {
+	*x = CheckInRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckInRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInRequest) ProtoMessage() {}
+
+func (x *CheckInRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInRequest.ProtoReflect.Descriptor instead.
+func (*CheckInRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CheckInRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *CheckInRequest) GetRoomId() string {
+	if x != nil && x.RoomId != nil {
+		return *x.RoomId
+	}
+	return ""
+}
+
+func (x *CheckInRequest) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+type CheckInResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Booking *Booking `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+}
+
+func (x *CheckInResponse) Reset() {
+	*x = CheckInResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckInResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInResponse) ProtoMessage() {}
+
+func (x *CheckInResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInResponse.ProtoReflect.Descriptor instead.
+func (*CheckInResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CheckInResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+type CheckOutRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookingId string `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	ChangedBy string `protobuf:"bytes,2,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+}
+
+func (x *CheckOutRequest) Reset() {
+	*x = CheckOutRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckOutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckOutRequest) ProtoMessage() {}
+
+func (x *CheckOutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckOutRequest.ProtoReflect.Descriptor instead.
+func (*CheckOutRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CheckOutRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *CheckOutRequest) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+type CheckOutResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Booking *Booking `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+}
+
+func (x *CheckOutResponse) Reset() {
+	*x = CheckOutResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckOutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckOutResponse) ProtoMessage() {}
+
+func (x *CheckOutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckOutResponse.ProtoReflect.Descriptor instead.
+func (*CheckOutResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CheckOutResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+type AssignRoomRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookingId string `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	RoomId    string `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ChangedBy string `protobuf:"bytes,3,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+}
+
+func (x *AssignRoomRequest) Reset() {
+	*x = AssignRoomRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssignRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignRoomRequest) ProtoMessage() {}
+
+func (x *AssignRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignRoomRequest.ProtoReflect.Descriptor instead.
+func (*AssignRoomRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AssignRoomRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *AssignRoomRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *AssignRoomRequest) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+type AssignRoomResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Booking *Booking `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+}
+
+func (x *AssignRoomResponse) Reset() {
+	*x = AssignRoomResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssignRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignRoomResponse) ProtoMessage() {}
+
+func (x *AssignRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignRoomResponse.ProtoReflect.Descriptor instead.
+func (*AssignRoomResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AssignRoomResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+// Незаданные поля остаются без изменений
+type ModifyBookingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookingId  string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	CheckIn    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=check_in,json=checkIn,proto3" json:"check_in,omitempty"`
+	CheckOut   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=check_out,json=checkOut,proto3" json:"check_out,omitempty"`
+	Type       *room.RoomType         `protobuf:"varint,4,opt,name=type,proto3,enum=hotel.room.v1.RoomType,oneof" json:"type,omitempty"`
+	GuestName  *string                `protobuf:"bytes,5,opt,name=guest_name,json=guestName,proto3,oneof" json:"guest_name,omitempty"`
+	GuestEmail *string                `protobuf:"bytes,6,opt,name=guest_email,json=guestEmail,proto3,oneof" json:"guest_email,omitempty"`
+	GuestPhone *string                `protobuf:"bytes,7,opt,name=guest_phone,json=guestPhone,proto3,oneof" json:"guest_phone,omitempty"`
+	ChangedBy  string                 `protobuf:"bytes,8,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+}
+
+func (x *ModifyBookingRequest) Reset() {
+	*x = ModifyBookingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModifyBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyBookingRequest) ProtoMessage() {}
+
+func (x *ModifyBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyBookingRequest.ProtoReflect.Descriptor instead.
+func (*ModifyBookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ModifyBookingRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *ModifyBookingRequest) GetCheckIn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckIn
+	}
+	return nil
+}
+
+func (x *ModifyBookingRequest) GetCheckOut() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckOut
+	}
+	return nil
+}
+
+func (x *ModifyBookingRequest) GetType() room.RoomType {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return room.RoomType(0)
+}
+
+func (x *ModifyBookingRequest) GetGuestName() string {
+	if x != nil && x.GuestName != nil {
+		return *x.GuestName
+	}
+	return ""
+}
+
+func (x *ModifyBookingRequest) GetGuestEmail() string {
+	if x != nil && x.GuestEmail != nil {
+		return *x.GuestEmail
+	}
+	return ""
+}
+
+func (x *ModifyBookingRequest) GetGuestPhone() string {
+	if x != nil && x.GuestPhone != nil {
+		return *x.GuestPhone
+	}
+	return ""
+}
+
+func (x *ModifyBookingRequest) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+type ModifyBookingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Booking *Booking `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+}
+
+func (x *ModifyBookingResponse) Reset() {
+	*x = ModifyBookingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModifyBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyBookingResponse) ProtoMessage() {}
+
+func (x *ModifyBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyBookingResponse.ProtoReflect.Descriptor instead.
+func (*ModifyBookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ModifyBookingResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+type CancellationQuote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalPrice     *money.Money `protobuf:"bytes,8,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	PenaltyPercent int32        `protobuf:"varint,2,opt,name=penalty_percent,json=penaltyPercent,proto3" json:"penalty_percent,omitempty"`
+	Penalty        *money.Money `protobuf:"bytes,9,opt,name=penalty,proto3" json:"penalty,omitempty"`
+	Refund         *money.Money `protobuf:"bytes,10,opt,name=refund,proto3" json:"refund,omitempty"`
+	// Полных дней до заезда на момент расчета
+	DaysBeforeCheckIn int32 `protobuf:"varint,5,opt,name=days_before_check_in,json=daysBeforeCheckIn,proto3" json:"days_before_check_in,omitempty"`
+	// До какого момента отмена бесплатна, не заполняется для невозвратного тарифа
+	FreeCancellationUntil *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=free_cancellation_until,json=freeCancellationUntil,proto3" json:"free_cancellation_until,omitempty"`
+	NonRefundable         bool                   `protobuf:"varint,7,opt,name=non_refundable,json=nonRefundable,proto3" json:"non_refundable,omitempty"`
+}
+
+func (x *CancellationQuote) Reset() {
+	*x = CancellationQuote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancellationQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancellationQuote) ProtoMessage() {}
+
+func (x *CancellationQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancellationQuote.ProtoReflect.Descriptor instead.
+func (*CancellationQuote) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CancellationQuote) GetTotalPrice() *money.Money {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return nil
+}
+
+func (x *CancellationQuote) GetPenaltyPercent() int32 {
+	if x != nil {
+		return x.PenaltyPercent
+	}
+	return 0
+}
+
+func (x *CancellationQuote) GetPenalty() *money.Money {
+	if x != nil {
+		return x.Penalty
+	}
+	return nil
+}
+
+func (x *CancellationQuote) GetRefund() *money.Money {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+func (x *CancellationQuote) GetDaysBeforeCheckIn() int32 {
+	if x != nil {
+		return x.DaysBeforeCheckIn
+	}
+	return 0
+}
+
+func (x *CancellationQuote) GetFreeCancellationUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FreeCancellationUntil
+	}
+	return nil
+}
+
+func (x *CancellationQuote) GetNonRefundable() bool {
+	if x != nil {
+		return x.NonRefundable
+	}
+	return false
+}
+
+type GetCancellationQuoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookingId string `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+}
+
+func (x *GetCancellationQuoteRequest) Reset() {
+	*x = GetCancellationQuoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCancellationQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCancellationQuoteRequest) ProtoMessage() {}
+
+func (x *GetCancellationQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCancellationQuoteRequest.ProtoReflect.Descriptor instead.
+func (*GetCancellationQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetCancellationQuoteRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+type GetCancellationQuoteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Quote *CancellationQuote `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+}
+
+func (x *GetCancellationQuoteResponse) Reset() {
+	*x = GetCancellationQuoteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCancellationQuoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCancellationQuoteResponse) ProtoMessage() {}
+
+func (x *GetCancellationQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCancellationQuoteResponse.ProtoReflect.Descriptor instead.
+func (*GetCancellationQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetCancellationQuoteResponse) GetQuote() *CancellationQuote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+type CancelBookingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookingId string `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Reason    string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	ChangedBy string `protobuf:"bytes,3,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+}
+
+func (x *CancelBookingRequest) Reset() {
+	*x = CancelBookingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingRequest) ProtoMessage() {}
+
+func (x *CancelBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingRequest.ProtoReflect.Descriptor instead.
+func (*CancelBookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CancelBookingRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *CancelBookingRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CancelBookingRequest) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+type CancelBookingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Booking *Booking           `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	Quote   *CancellationQuote `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+}
+
+func (x *CancelBookingResponse) Reset() {
+	*x = CancelBookingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingResponse) ProtoMessage() {}
+
+func (x *CancelBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingResponse.ProtoReflect.Descriptor instead.
+func (*CancelBookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CancelBookingResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+func (x *CancelBookingResponse) GetQuote() *CancellationQuote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+// Комната в групповой брони: конкретная (room_id) или первая свободная по типу и вместимости
+type ReservationRoomRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Если не задана, бронируется тип комнаты, как в CreateBookingRequest.room_id
+	RoomId   *string        `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3,oneof" json:"room_id,omitempty"`
+	Type     *room.RoomType `protobuf:"varint,2,opt,name=type,proto3,enum=hotel.room.v1.RoomType,oneof" json:"type,omitempty"`
+	Capacity *int32         `protobuf:"varint,3,opt,name=capacity,proto3,oneof" json:"capacity,omitempty"`
+	// Гость, проживающий в комнате; по умолчанию контактное лицо
+	GuestName string `protobuf:"bytes,4,opt,name=guest_name,json=guestName,proto3" json:"guest_name,omitempty"`
+}
+
+func (x *ReservationRoomRequest) Reset() {
+	*x = ReservationRoomRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReservationRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservationRoomRequest) ProtoMessage() {}
+
+func (x *ReservationRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationRoomRequest.ProtoReflect.Descriptor instead.
+func (*ReservationRoomRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ReservationRoomRequest) GetRoomId() string {
+	if x != nil && x.RoomId != nil {
+		return *x.RoomId
+	}
+	return ""
+}
+
+func (x *ReservationRoomRequest) GetType() room.RoomType {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return room.RoomType(0)
+}
+
+func (x *ReservationRoomRequest) GetCapacity() int32 {
+	if x != nil && x.Capacity != nil {
+		return *x.Capacity
+	}
+	return 0
+}
+
+func (x *ReservationRoomRequest) GetGuestName() string {
+	if x != nil {
+		return x.GuestName
+	}
+	return ""
+}
+
+type CreateReservationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CheckIn      *timestamppb.Timestamp    `protobuf:"bytes,1,opt,name=check_in,json=checkIn,proto3" json:"check_in,omitempty"`
+	CheckOut     *timestamppb.Timestamp    `protobuf:"bytes,2,opt,name=check_out,json=checkOut,proto3" json:"check_out,omitempty"`
+	UserId       *string                   `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	ContactName  string                    `protobuf:"bytes,4,opt,name=contact_name,json=contactName,proto3" json:"contact_name,omitempty"`
+	ContactEmail string                    `protobuf:"bytes,5,opt,name=contact_email,json=contactEmail,proto3" json:"contact_email,omitempty"`
+	ContactPhone string                    `protobuf:"bytes,6,opt,name=contact_phone,json=contactPhone,proto3" json:"contact_phone,omitempty"`
+	Rooms        []*ReservationRoomRequest `protobuf:"bytes,7,rep,name=rooms,proto3" json:"rooms,omitempty"`
+}
+
+func (x *CreateReservationRequest) Reset() {
+	*x = CreateReservationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReservationRequest) ProtoMessage() {}
+
+func (x *CreateReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReservationRequest.ProtoReflect.Descriptor instead.
+func (*CreateReservationRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CreateReservationRequest) GetCheckIn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckIn
+	}
+	return nil
+}
+
+func (x *CreateReservationRequest) GetCheckOut() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckOut
+	}
+	return nil
+}
+
+func (x *CreateReservationRequest) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *CreateReservationRequest) GetContactName() string {
+	if x != nil {
+		return x.ContactName
+	}
+	return ""
+}
+
+func (x *CreateReservationRequest) GetContactEmail() string {
+	if x != nil {
+		return x.ContactEmail
+	}
+	return ""
+}
+
+func (x *CreateReservationRequest) GetContactPhone() string {
+	if x != nil {
+		return x.ContactPhone
+	}
+	return ""
+}
+
+func (x *CreateReservationRequest) GetRooms() []*ReservationRoomRequest {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+type CreateReservationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reservation *Reservation `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+}
+
+func (x *CreateReservationResponse) Reset() {
+	*x = CreateReservationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReservationResponse) ProtoMessage() {}
+
+func (x *CreateReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReservationResponse.ProtoReflect.Descriptor instead.
+func (*CreateReservationResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CreateReservationResponse) GetReservation() *Reservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+type GetReservationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReservationId string `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+}
+
+func (x *GetReservationRequest) Reset() {
+	*x = GetReservationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReservationRequest) ProtoMessage() {}
+
+func (x *GetReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReservationRequest.ProtoReflect.Descriptor instead.
+func (*GetReservationRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetReservationRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+type GetReservationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reservation *Reservation `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+}
+
+func (x *GetReservationResponse) Reset() {
+	*x = GetReservationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReservationResponse) ProtoMessage() {}
+
+func (x *GetReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReservationResponse.ProtoReflect.Descriptor instead.
+func (*GetReservationResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetReservationResponse) GetReservation() *Reservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+type Reservation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ConfirmationCode string                 `protobuf:"bytes,2,opt,name=confirmation_code,json=confirmationCode,proto3" json:"confirmation_code,omitempty"`
+	UserId           *string                `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	ContactName      string                 `protobuf:"bytes,4,opt,name=contact_name,json=contactName,proto3" json:"contact_name,omitempty"`
+	ContactEmail     string                 `protobuf:"bytes,5,opt,name=contact_email,json=contactEmail,proto3" json:"contact_email,omitempty"`
+	ContactPhone     string                 `protobuf:"bytes,6,opt,name=contact_phone,json=contactPhone,proto3" json:"contact_phone,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Bookings         []*Booking             `protobuf:"bytes,8,rep,name=bookings,proto3" json:"bookings,omitempty"`
+	// Суммарная стоимость всех комнат
+	TotalPrice *money.Money `protobuf:"bytes,10,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+}
+
+func (x *Reservation) Reset() {
+	*x = Reservation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Reservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reservation) ProtoMessage() {}
+
+func (x *Reservation) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reservation.ProtoReflect.Descriptor instead.
+func (*Reservation) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Reservation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Reservation) GetConfirmationCode() string {
+	if x != nil {
+		return x.ConfirmationCode
+	}
+	return ""
+}
+
+func (x *Reservation) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *Reservation) GetContactName() string {
+	if x != nil {
+		return x.ContactName
+	}
+	return ""
+}
+
+func (x *Reservation) GetContactEmail() string {
+	if x != nil {
+		return x.ContactEmail
+	}
+	return ""
+}
+
+func (x *Reservation) GetContactPhone() string {
+	if x != nil {
+		return x.ContactPhone
+	}
+	return ""
+}
+
+func (x *Reservation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Reservation) GetBookings() []*Booking {
+	if x != nil {
+		return x.Bookings
+	}
+	return nil
+}
+
+func (x *Reservation) GetTotalPrice() *money.Money {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return nil
+}
+
+type PromoCode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code         string            `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	DiscountType PromoDiscountType `protobuf:"varint,3,opt,name=discount_type,json=discountType,proto3,enum=hotel.booking.v1.PromoDiscountType" json:"discount_type,omitempty"`
+	// Для PROMO_DISCOUNT_TYPE_PERCENT
+	DiscountPercent int32 `protobuf:"varint,4,opt,name=discount_percent,json=discountPercent,proto3" json:"discount_percent,omitempty"`
+	// Для PROMO_DISCOUNT_TYPE_FIXED, в валюте расчетов отеля
+	DiscountAmount *money.Money `protobuf:"bytes,5,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	// Период действия по дате создания брони, границы не обязательны
+	ValidFrom *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidTo   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`
+	// Типы комнат, на которые действует код; пустой список — на все
+	RoomTypes             []room.RoomType        `protobuf:"varint,8,rep,packed,name=room_types,json=roomTypes,proto3,enum=hotel.room.v1.RoomType" json:"room_types,omitempty"`
+	MaxRedemptions        *int32                 `protobuf:"varint,9,opt,name=max_redemptions,json=maxRedemptions,proto3,oneof" json:"max_redemptions,omitempty"`
+	MaxRedemptionsPerUser *int32                 `protobuf:"varint,10,opt,name=max_redemptions_per_user,json=maxRedemptionsPerUser,proto3,oneof" json:"max_redemptions_per_user,omitempty"`
+	Redemptions           int32                  `protobuf:"varint,11,opt,name=redemptions,proto3" json:"redemptions,omitempty"`
+	Active                bool                   `protobuf:"varint,12,opt,name=active,proto3" json:"active,omitempty"`
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt             *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *PromoCode) Reset() {
+	*x = PromoCode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromoCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoCode) ProtoMessage() {}
+
+func (x *PromoCode) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoCode.ProtoReflect.Descriptor instead.
+func (*PromoCode) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PromoCode) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PromoCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *PromoCode) GetDiscountType() PromoDiscountType {
+	if x != nil {
+		return x.DiscountType
+	}
+	return PromoDiscountType_PROMO_DISCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *PromoCode) GetDiscountPercent() int32 {
+	if x != nil {
+		return x.DiscountPercent
+	}
+	return 0
+}
+
+func (x *PromoCode) GetDiscountAmount() *money.Money {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return nil
+}
+
+func (x *PromoCode) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *PromoCode) GetValidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+func (x *PromoCode) GetRoomTypes() []room.RoomType {
+	if x != nil {
+		return x.RoomTypes
+	}
+	return nil
+}
+
+func (x *PromoCode) GetMaxRedemptions() int32 {
+	if x != nil && x.MaxRedemptions != nil {
+		return *x.MaxRedemptions
+	}
+	return 0
+}
+
+func (x *PromoCode) GetMaxRedemptionsPerUser() int32 {
+	if x != nil && x.MaxRedemptionsPerUser != nil {
+		return *x.MaxRedemptionsPerUser
+	}
+	return 0
+}
+
+func (x *PromoCode) GetRedemptions() int32 {
+	if x != nil {
+		return x.Redemptions
+	}
+	return 0
+}
+
+func (x *PromoCode) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *PromoCode) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PromoCode) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreatePromoCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code                  string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	DiscountType          PromoDiscountType      `protobuf:"varint,2,opt,name=discount_type,json=discountType,proto3,enum=hotel.booking.v1.PromoDiscountType" json:"discount_type,omitempty"`
+	DiscountPercent       int32                  `protobuf:"varint,3,opt,name=discount_percent,json=discountPercent,proto3" json:"discount_percent,omitempty"`
+	DiscountAmount        *money.Money           `protobuf:"bytes,4,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	ValidFrom             *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidTo               *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`
+	RoomTypes             []room.RoomType        `protobuf:"varint,7,rep,packed,name=room_types,json=roomTypes,proto3,enum=hotel.room.v1.RoomType" json:"room_types,omitempty"`
+	MaxRedemptions        *int32                 `protobuf:"varint,8,opt,name=max_redemptions,json=maxRedemptions,proto3,oneof" json:"max_redemptions,omitempty"`
+	MaxRedemptionsPerUser *int32                 `protobuf:"varint,9,opt,name=max_redemptions_per_user,json=maxRedemptionsPerUser,proto3,oneof" json:"max_redemptions_per_user,omitempty"`
+}
+
+func (x *CreatePromoCodeRequest) Reset() {
+	*x = CreatePromoCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePromoCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromoCodeRequest) ProtoMessage() {}
+
+func (x *CreatePromoCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromoCodeRequest.ProtoReflect.Descriptor instead.
+func (*CreatePromoCodeRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreatePromoCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreatePromoCodeRequest) GetDiscountType() PromoDiscountType {
+	if x != nil {
+		return x.DiscountType
+	}
+	return PromoDiscountType_PROMO_DISCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *CreatePromoCodeRequest) GetDiscountPercent() int32 {
+	if x != nil {
+		return x.DiscountPercent
+	}
+	return 0
+}
+
+func (x *CreatePromoCodeRequest) GetDiscountAmount() *money.Money {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return nil
+}
+
+func (x *CreatePromoCodeRequest) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *CreatePromoCodeRequest) GetValidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+func (x *CreatePromoCodeRequest) GetRoomTypes() []room.RoomType {
+	if x != nil {
+		return x.RoomTypes
+	}
+	return nil
+}
+
+func (x *CreatePromoCodeRequest) GetMaxRedemptions() int32 {
+	if x != nil && x.MaxRedemptions != nil {
+		return *x.MaxRedemptions
+	}
+	return 0
+}
+
+func (x *CreatePromoCodeRequest) GetMaxRedemptionsPerUser() int32 {
+	if x != nil && x.MaxRedemptionsPerUser != nil {
+		return *x.MaxRedemptionsPerUser
+	}
+	return 0
+}
+
+type GetPromoCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetPromoCodeRequest) Reset() {
+	*x = GetPromoCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPromoCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPromoCodeRequest) ProtoMessage() {}
+
+func (x *GetPromoCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPromoCodeRequest.ProtoReflect.Descriptor instead.
+func (*GetPromoCodeRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetPromoCodeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListPromoCodesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Только активные коды
+	ActiveOnly bool `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+}
+
+func (x *ListPromoCodesRequest) Reset() {
+	*x = ListPromoCodesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPromoCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromoCodesRequest) ProtoMessage() {}
+
+func (x *ListPromoCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromoCodesRequest.ProtoReflect.Descriptor instead.
+func (*ListPromoCodesRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListPromoCodesRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+type ListPromoCodesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PromoCodes []*PromoCode `protobuf:"bytes,1,rep,name=promo_codes,json=promoCodes,proto3" json:"promo_codes,omitempty"`
+}
+
+func (x *ListPromoCodesResponse) Reset() {
+	*x = ListPromoCodesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPromoCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromoCodesResponse) ProtoMessage() {}
+
+func (x *ListPromoCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromoCodesResponse.ProtoReflect.Descriptor instead.
+func (*ListPromoCodesResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListPromoCodesResponse) GetPromoCodes() []*PromoCode {
+	if x != nil {
+		return x.PromoCodes
+	}
+	return nil
+}
+
+// Полная замена условий промокода, сам код не меняется
+type UpdatePromoCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DiscountType          PromoDiscountType      `protobuf:"varint,2,opt,name=discount_type,json=discountType,proto3,enum=hotel.booking.v1.PromoDiscountType" json:"discount_type,omitempty"`
+	DiscountPercent       int32                  `protobuf:"varint,3,opt,name=discount_percent,json=discountPercent,proto3" json:"discount_percent,omitempty"`
+	DiscountAmount        *money.Money           `protobuf:"bytes,4,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	ValidFrom             *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidTo               *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`
+	RoomTypes             []room.RoomType        `protobuf:"varint,7,rep,packed,name=room_types,json=roomTypes,proto3,enum=hotel.room.v1.RoomType" json:"room_types,omitempty"`
+	MaxRedemptions        *int32                 `protobuf:"varint,8,opt,name=max_redemptions,json=maxRedemptions,proto3,oneof" json:"max_redemptions,omitempty"`
+	MaxRedemptionsPerUser *int32                 `protobuf:"varint,9,opt,name=max_redemptions_per_user,json=maxRedemptionsPerUser,proto3,oneof" json:"max_redemptions_per_user,omitempty"`
+	Active                bool                   `protobuf:"varint,10,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (x *UpdatePromoCodeRequest) Reset() {
+	*x = UpdatePromoCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePromoCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePromoCodeRequest) ProtoMessage() {}
+
+func (x *UpdatePromoCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePromoCodeRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePromoCodeRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *UpdatePromoCodeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdatePromoCodeRequest) GetDiscountType() PromoDiscountType {
+	if x != nil {
+		return x.DiscountType
+	}
+	return PromoDiscountType_PROMO_DISCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *UpdatePromoCodeRequest) GetDiscountPercent() int32 {
+	if x != nil {
+		return x.DiscountPercent
+	}
+	return 0
+}
+
+func (x *UpdatePromoCodeRequest) GetDiscountAmount() *money.Money {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return nil
+}
+
+func (x *UpdatePromoCodeRequest) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *UpdatePromoCodeRequest) GetValidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+func (x *UpdatePromoCodeRequest) GetRoomTypes() []room.RoomType {
+	if x != nil {
+		return x.RoomTypes
+	}
+	return nil
+}
+
+func (x *UpdatePromoCodeRequest) GetMaxRedemptions() int32 {
+	if x != nil && x.MaxRedemptions != nil {
+		return *x.MaxRedemptions
+	}
+	return 0
+}
+
+func (x *UpdatePromoCodeRequest) GetMaxRedemptionsPerUser() int32 {
+	if x != nil && x.MaxRedemptionsPerUser != nil {
+		return *x.MaxRedemptionsPerUser
+	}
+	return 0
+}
+
+func (x *UpdatePromoCodeRequest) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type PromoCodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PromoCode *PromoCode `protobuf:"bytes,1,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+}
+
+func (x *PromoCodeResponse) Reset() {
+	*x = PromoCodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromoCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoCodeResponse) ProtoMessage() {}
+
+func (x *PromoCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoCodeResponse.ProtoReflect.Descriptor instead.
+func (*PromoCodeResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PromoCodeResponse) GetPromoCode() *PromoCode {
+	if x != nil {
+		return x.PromoCode
+	}
+	return nil
+}
+
+type DeletePromoCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeletePromoCodeRequest) Reset() {
+	*x = DeletePromoCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePromoCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePromoCodeRequest) ProtoMessage() {}
+
+func (x *DeletePromoCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePromoCodeRequest.ProtoReflect.Descriptor instead.
+func (*DeletePromoCodeRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DeletePromoCodeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeletePromoCodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeletePromoCodeResponse) Reset() {
+	*x = DeletePromoCodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePromoCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePromoCodeResponse) ProtoMessage() {}
+
+func (x *DeletePromoCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePromoCodeResponse.ProtoReflect.Descriptor instead.
+func (*DeletePromoCodeResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{46}
+}
+
+// Ограничение продаж на период дат. Правило применяется к дате, если она попадает в период
+// и ее день недели есть в weekdays
+type StayRestriction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Период дат, обе границы включительно; учитываются только даты
+	DateFrom *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	// Не задан — все типы комнат
+	RoomType *room.RoomType `protobuf:"varint,5,opt,name=room_type,json=roomType,proto3,enum=hotel.room.v1.RoomType,oneof" json:"room_type,omitempty"`
+	// Дни недели (0 — воскресенье, 6 — суббота); пустой список — все дни
+	Weekdays []int32 `protobuf:"varint,6,rep,packed,name=weekdays,proto3" json:"weekdays,omitempty"`
+	// Длительность проживания в ночах, если хотя бы одна ночь проживания попадает под правило
+	MinStay *int32 `protobuf:"varint,7,opt,name=min_stay,json=minStay,proto3,oneof" json:"min_stay,omitempty"`
+	MaxStay *int32 `protobuf:"varint,8,opt,name=max_stay,json=maxStay,proto3,oneof" json:"max_stay,omitempty"`
+	// Заезд или выезд в дату, попадающую под правило, запрещен
+	ClosedToArrival   bool `protobuf:"varint,9,opt,name=closed_to_arrival,json=closedToArrival,proto3" json:"closed_to_arrival,omitempty"`
+	ClosedToDeparture bool `protobuf:"varint,10,opt,name=closed_to_departure,json=closedToDeparture,proto3" json:"closed_to_departure,omitempty"`
+	// За сколько дней до заезда, попадающего под правило, можно бронировать: не позже min и не раньше max
+	MinAdvanceDays *int32                 `protobuf:"varint,11,opt,name=min_advance_days,json=minAdvanceDays,proto3,oneof" json:"min_advance_days,omitempty"`
+	MaxAdvanceDays *int32                 `protobuf:"varint,12,opt,name=max_advance_days,json=maxAdvanceDays,proto3,oneof" json:"max_advance_days,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *StayRestriction) Reset() {
+	*x = StayRestriction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StayRestriction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StayRestriction) ProtoMessage() {}
+
+func (x *StayRestriction) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StayRestriction.ProtoReflect.Descriptor instead.
+func (*StayRestriction) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *StayRestriction) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StayRestriction) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StayRestriction) GetDateFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateFrom
+	}
+	return nil
+}
+
+func (x *StayRestriction) GetDateTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+func (x *StayRestriction) GetRoomType() room.RoomType {
+	if x != nil && x.RoomType != nil {
+		return *x.RoomType
+	}
+	return room.RoomType(0)
+}
+
+func (x *StayRestriction) GetWeekdays() []int32 {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *StayRestriction) GetMinStay() int32 {
+	if x != nil && x.MinStay != nil {
+		return *x.MinStay
+	}
+	return 0
+}
+
+func (x *StayRestriction) GetMaxStay() int32 {
+	if x != nil && x.MaxStay != nil {
+		return *x.MaxStay
+	}
+	return 0
+}
+
+func (x *StayRestriction) GetClosedToArrival() bool {
+	if x != nil {
+		return x.ClosedToArrival
+	}
+	return false
+}
+
+func (x *StayRestriction) GetClosedToDeparture() bool {
+	if x != nil {
+		return x.ClosedToDeparture
+	}
+	return false
+}
+
+func (x *StayRestriction) GetMinAdvanceDays() int32 {
+	if x != nil && x.MinAdvanceDays != nil {
+		return *x.MinAdvanceDays
+	}
+	return 0
+}
+
+func (x *StayRestriction) GetMaxAdvanceDays() int32 {
+	if x != nil && x.MaxAdvanceDays != nil {
+		return *x.MaxAdvanceDays
+	}
+	return 0
+}
+
+func (x *StayRestriction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *StayRestriction) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateStayRestrictionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DateFrom          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	RoomType          *room.RoomType         `protobuf:"varint,4,opt,name=room_type,json=roomType,proto3,enum=hotel.room.v1.RoomType,oneof" json:"room_type,omitempty"`
+	Weekdays          []int32                `protobuf:"varint,5,rep,packed,name=weekdays,proto3" json:"weekdays,omitempty"`
+	MinStay           *int32                 `protobuf:"varint,6,opt,name=min_stay,json=minStay,proto3,oneof" json:"min_stay,omitempty"`
+	MaxStay           *int32                 `protobuf:"varint,7,opt,name=max_stay,json=maxStay,proto3,oneof" json:"max_stay,omitempty"`
+	ClosedToArrival   bool                   `protobuf:"varint,8,opt,name=closed_to_arrival,json=closedToArrival,proto3" json:"closed_to_arrival,omitempty"`
+	ClosedToDeparture bool                   `protobuf:"varint,9,opt,name=closed_to_departure,json=closedToDeparture,proto3" json:"closed_to_departure,omitempty"`
+	MinAdvanceDays    *int32                 `protobuf:"varint,10,opt,name=min_advance_days,json=minAdvanceDays,proto3,oneof" json:"min_advance_days,omitempty"`
+	MaxAdvanceDays    *int32                 `protobuf:"varint,11,opt,name=max_advance_days,json=maxAdvanceDays,proto3,oneof" json:"max_advance_days,omitempty"`
+}
+
+func (x *CreateStayRestrictionRequest) Reset() {
+	*x = CreateStayRestrictionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateStayRestrictionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStayRestrictionRequest) ProtoMessage() {}
+
+func (x *CreateStayRestrictionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStayRestrictionRequest.ProtoReflect.Descriptor instead.
+func (*CreateStayRestrictionRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreateStayRestrictionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateStayRestrictionRequest) GetDateFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateFrom
+	}
+	return nil
+}
+
+func (x *CreateStayRestrictionRequest) GetDateTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+func (x *CreateStayRestrictionRequest) GetRoomType() room.RoomType {
+	if x != nil && x.RoomType != nil {
+		return *x.RoomType
+	}
+	return room.RoomType(0)
+}
+
+func (x *CreateStayRestrictionRequest) GetWeekdays() []int32 {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *CreateStayRestrictionRequest) GetMinStay() int32 {
+	if x != nil && x.MinStay != nil {
+		return *x.MinStay
+	}
+	return 0
+}
+
+func (x *CreateStayRestrictionRequest) GetMaxStay() int32 {
+	if x != nil && x.MaxStay != nil {
+		return *x.MaxStay
+	}
+	return 0
+}
+
+func (x *CreateStayRestrictionRequest) GetClosedToArrival() bool {
+	if x != nil {
+		return x.ClosedToArrival
+	}
+	return false
+}
+
+func (x *CreateStayRestrictionRequest) GetClosedToDeparture() bool {
+	if x != nil {
+		return x.ClosedToDeparture
+	}
+	return false
+}
+
+func (x *CreateStayRestrictionRequest) GetMinAdvanceDays() int32 {
+	if x != nil && x.MinAdvanceDays != nil {
+		return *x.MinAdvanceDays
+	}
+	return 0
+}
+
+func (x *CreateStayRestrictionRequest) GetMaxAdvanceDays() int32 {
+	if x != nil && x.MaxAdvanceDays != nil {
+		return *x.MaxAdvanceDays
+	}
+	return 0
+}
+
+type GetStayRestrictionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetStayRestrictionRequest) Reset() {
+	*x = GetStayRestrictionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStayRestrictionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStayRestrictionRequest) ProtoMessage() {}
+
+func (x *GetStayRestrictionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStayRestrictionRequest.ProtoReflect.Descriptor instead.
+func (*GetStayRestrictionRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetStayRestrictionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListStayRestrictionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Только правила, пересекающиеся с периодом дат [from, to]
+	From *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	To   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	// Правила типа и правила для всех типов
+	RoomType *room.RoomType `protobuf:"varint,3,opt,name=room_type,json=roomType,proto3,enum=hotel.room.v1.RoomType,oneof" json:"room_type,omitempty"`
+}
+
+func (x *ListStayRestrictionsRequest) Reset() {
+	*x = ListStayRestrictionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStayRestrictionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStayRestrictionsRequest) ProtoMessage() {}
+
+func (x *ListStayRestrictionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStayRestrictionsRequest.ProtoReflect.Descriptor instead.
+func (*ListStayRestrictionsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListStayRestrictionsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListStayRestrictionsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListStayRestrictionsRequest) GetRoomType() room.RoomType {
+	if x != nil && x.RoomType != nil {
+		return *x.RoomType
+	}
+	return room.RoomType(0)
+}
+
+type ListStayRestrictionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Restrictions []*StayRestriction `protobuf:"bytes,1,rep,name=restrictions,proto3" json:"restrictions,omitempty"`
+}
+
+func (x *ListStayRestrictionsResponse) Reset() {
+	*x = ListStayRestrictionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStayRestrictionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStayRestrictionsResponse) ProtoMessage() {}
+
+func (x *ListStayRestrictionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStayRestrictionsResponse.ProtoReflect.Descriptor instead.
+func (*ListStayRestrictionsResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListStayRestrictionsResponse) GetRestrictions() []*StayRestriction {
+	if x != nil {
+		return x.Restrictions
+	}
+	return nil
+}
+
+// Полная замена условий ограничения
+type UpdateStayRestrictionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DateFrom          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	RoomType          *room.RoomType         `protobuf:"varint,5,opt,name=room_type,json=roomType,proto3,enum=hotel.room.v1.RoomType,oneof" json:"room_type,omitempty"`
+	Weekdays          []int32                `protobuf:"varint,6,rep,packed,name=weekdays,proto3" json:"weekdays,omitempty"`
+	MinStay           *int32                 `protobuf:"varint,7,opt,name=min_stay,json=minStay,proto3,oneof" json:"min_stay,omitempty"`
+	MaxStay           *int32                 `protobuf:"varint,8,opt,name=max_stay,json=maxStay,proto3,oneof" json:"max_stay,omitempty"`
+	ClosedToArrival   bool                   `protobuf:"varint,9,opt,name=closed_to_arrival,json=closedToArrival,proto3" json:"closed_to_arrival,omitempty"`
+	ClosedToDeparture bool                   `protobuf:"varint,10,opt,name=closed_to_departure,json=closedToDeparture,proto3" json:"closed_to_departure,omitempty"`
+	MinAdvanceDays    *int32                 `protobuf:"varint,11,opt,name=min_advance_days,json=minAdvanceDays,proto3,oneof" json:"min_advance_days,omitempty"`
+	MaxAdvanceDays    *int32                 `protobuf:"varint,12,opt,name=max_advance_days,json=maxAdvanceDays,proto3,oneof" json:"max_advance_days,omitempty"`
+}
+
+func (x *UpdateStayRestrictionRequest) Reset() {
+	*x = UpdateStayRestrictionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateStayRestrictionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStayRestrictionRequest) ProtoMessage() {}
+
+func (x *UpdateStayRestrictionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStayRestrictionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateStayRestrictionRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *UpdateStayRestrictionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateStayRestrictionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateStayRestrictionRequest) GetDateFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateFrom
+	}
+	return nil
+}
+
+func (x *UpdateStayRestrictionRequest) GetDateTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+func (x *UpdateStayRestrictionRequest) GetRoomType() room.RoomType {
+	if x != nil && x.RoomType != nil {
+		return *x.RoomType
+	}
+	return room.RoomType(0)
+}
+
+func (x *UpdateStayRestrictionRequest) GetWeekdays() []int32 {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *UpdateStayRestrictionRequest) GetMinStay() int32 {
+	if x != nil && x.MinStay != nil {
+		return *x.MinStay
+	}
+	return 0
+}
+
+func (x *UpdateStayRestrictionRequest) GetMaxStay() int32 {
+	if x != nil && x.MaxStay != nil {
+		return *x.MaxStay
+	}
+	return 0
+}
+
+func (x *UpdateStayRestrictionRequest) GetClosedToArrival() bool {
+	if x != nil {
+		return x.ClosedToArrival
+	}
+	return false
+}
+
+func (x *UpdateStayRestrictionRequest) GetClosedToDeparture() bool {
+	if x != nil {
+		return x.ClosedToDeparture
+	}
+	return false
+}
+
+func (x *UpdateStayRestrictionRequest) GetMinAdvanceDays() int32 {
+	if x != nil && x.MinAdvanceDays != nil {
+		return *x.MinAdvanceDays
+	}
+	return 0
+}
+
+func (x *UpdateStayRestrictionRequest) GetMaxAdvanceDays() int32 {
+	if x != nil && x.MaxAdvanceDays != nil {
+		return *x.MaxAdvanceDays
+	}
+	return 0
+}
+
+type StayRestrictionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Restriction *StayRestriction `protobuf:"bytes,1,opt,name=restriction,proto3" json:"restriction,omitempty"`
+}
+
+func (x *StayRestrictionResponse) Reset() {
+	*x = StayRestrictionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StayRestrictionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StayRestrictionResponse) ProtoMessage() {}
+
+func (x *StayRestrictionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StayRestrictionResponse.ProtoReflect.Descriptor instead.
+func (*StayRestrictionResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *StayRestrictionResponse) GetRestriction() *StayRestriction {
+	if x != nil {
+		return x.Restriction
+	}
+	return nil
+}
+
+type DeleteStayRestrictionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteStayRestrictionRequest) Reset() {
+	*x = DeleteStayRestrictionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteStayRestrictionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStayRestrictionRequest) ProtoMessage() {}
+
+func (x *DeleteStayRestrictionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStayRestrictionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteStayRestrictionRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DeleteStayRestrictionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteStayRestrictionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteStayRestrictionResponse) Reset() {
+	*x = DeleteStayRestrictionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteStayRestrictionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStayRestrictionResponse) ProtoMessage() {}
+
+func (x *DeleteStayRestrictionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStayRestrictionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteStayRestrictionResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{55}
+}
+
+// Профиль гостя, объединяющий его брони по нормализованным email и телефону
+type GuestProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name   string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Emails []string `protobuf:"bytes,3,rep,name=emails,proto3" json:"emails,omitempty"`
+	Phones []string `protobuf:"bytes,4,rep,name=phones,proto3" json:"phones,omitempty"`
+	// Предпочтения гостя
+	BedType        string `protobuf:"bytes,5,opt,name=bed_type,json=bedType,proto3" json:"bed_type,omitempty"`
+	PreferredFloor string `protobuf:"bytes,6,opt,name=preferred_floor,json=preferredFloor,proto3" json:"preferred_floor,omitempty"`
+	Allergies      string `protobuf:"bytes,7,opt,name=allergies,proto3" json:"allergies,omitempty"`
+	// Завершенные проживания и их суммарная стоимость в валюте расчетов отеля
+	StayCount     int32        `protobuf:"varint,8,opt,name=stay_count,json=stayCount,proto3" json:"stay_count,omitempty"`
+	LifetimeValue *money.Money `protobuf:"bytes,9,opt,name=lifetime_value,json=lifetimeValue,proto3" json:"lifetime_value,omitempty"`
+	// Дата выезда последнего завершенного проживания
+	LastStayAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_stay_at,json=lastStayAt,proto3" json:"last_stay_at,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *GuestProfile) Reset() {
+	*x = GuestProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GuestProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuestProfile) ProtoMessage() {}
+
+func (x *GuestProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuestProfile.ProtoReflect.Descriptor instead.
+func (*GuestProfile) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GuestProfile) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GuestProfile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GuestProfile) GetEmails() []string {
+	if x != nil {
+		return x.Emails
+	}
+	return nil
+}
+
+func (x *GuestProfile) GetPhones() []string {
+	if x != nil {
+		return x.Phones
+	}
+	return nil
+}
+
+func (x *GuestProfile) GetBedType() string {
+	if x != nil {
+		return x.BedType
+	}
+	return ""
+}
+
+func (x *GuestProfile) GetPreferredFloor() string {
+	if x != nil {
+		return x.PreferredFloor
+	}
+	return ""
+}
+
+func (x *GuestProfile) GetAllergies() string {
+	if x != nil {
+		return x.Allergies
+	}
+	return ""
+}
+
+func (x *GuestProfile) GetStayCount() int32 {
+	if x != nil {
+		return x.StayCount
+	}
+	return 0
+}
+
+func (x *GuestProfile) GetLifetimeValue() *money.Money {
+	if x != nil {
+		return x.LifetimeValue
+	}
+	return nil
+}
+
+func (x *GuestProfile) GetLastStayAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastStayAt
+	}
+	return nil
+}
+
+func (x *GuestProfile) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *GuestProfile) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetGuestProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetGuestProfileRequest) Reset() {
+	*x = GetGuestProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGuestProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGuestProfileRequest) ProtoMessage() {}
+
+func (x *GetGuestProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGuestProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetGuestProfileRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetGuestProfileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type SearchGuestProfilesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Часть имени, email или телефона; пустой — все профили
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// По умолчанию 20, не больше 100
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *SearchGuestProfilesRequest) Reset() {
+	*x = SearchGuestProfilesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchGuestProfilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchGuestProfilesRequest) ProtoMessage() {}
+
+func (x *SearchGuestProfilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchGuestProfilesRequest.ProtoReflect.Descriptor instead.
+func (*SearchGuestProfilesRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SearchGuestProfilesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchGuestProfilesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchGuestProfilesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profiles []*GuestProfile `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
+}
+
+func (x *SearchGuestProfilesResponse) Reset() {
+	*x = SearchGuestProfilesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchGuestProfilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchGuestProfilesResponse) ProtoMessage() {}
+
+func (x *SearchGuestProfilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchGuestProfilesResponse.ProtoReflect.Descriptor instead.
+func (*SearchGuestProfilesResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SearchGuestProfilesResponse) GetProfiles() []*GuestProfile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+// Полная замена имени и предпочтений гостя
+type UpdateGuestProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	BedType        string `protobuf:"bytes,3,opt,name=bed_type,json=bedType,proto3" json:"bed_type,omitempty"`
+	PreferredFloor string `protobuf:"bytes,4,opt,name=preferred_floor,json=preferredFloor,proto3" json:"preferred_floor,omitempty"`
+	Allergies      string `protobuf:"bytes,5,opt,name=allergies,proto3" json:"allergies,omitempty"`
+}
+
+func (x *UpdateGuestProfileRequest) Reset() {
+	*x = UpdateGuestProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateGuestProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGuestProfileRequest) ProtoMessage() {}
+
+func (x *UpdateGuestProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGuestProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGuestProfileRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UpdateGuestProfileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateGuestProfileRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateGuestProfileRequest) GetBedType() string {
+	if x != nil {
+		return x.BedType
+	}
+	return ""
+}
+
+func (x *UpdateGuestProfileRequest) GetPreferredFloor() string {
+	if x != nil {
+		return x.PreferredFloor
+	}
+	return ""
+}
+
+func (x *UpdateGuestProfileRequest) GetAllergies() string {
+	if x != nil {
+		return x.Allergies
+	}
+	return ""
+}
+
+// Профили source_ids сливаются в профиль id
+type MergeGuestProfilesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SourceIds []string `protobuf:"bytes,2,rep,name=source_ids,json=sourceIds,proto3" json:"source_ids,omitempty"`
+}
+
+func (x *MergeGuestProfilesRequest) Reset() {
+	*x = MergeGuestProfilesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeGuestProfilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeGuestProfilesRequest) ProtoMessage() {}
+
+func (x *MergeGuestProfilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeGuestProfilesRequest.ProtoReflect.Descriptor instead.
+func (*MergeGuestProfilesRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *MergeGuestProfilesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MergeGuestProfilesRequest) GetSourceIds() []string {
+	if x != nil {
+		return x.SourceIds
+	}
+	return nil
+}
+
+type GuestProfileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profile *GuestProfile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *GuestProfileResponse) Reset() {
+	*x = GuestProfileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GuestProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuestProfileResponse) ProtoMessage() {}
+
+func (x *GuestProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuestProfileResponse.ProtoReflect.Descriptor instead.
+func (*GuestProfileResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GuestProfileResponse) GetProfile() *GuestProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type ClaimGuestBookingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Подтвержденный пользователем email; брони ищутся по нему без учета регистра
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *ClaimGuestBookingsRequest) Reset() {
+	*x = ClaimGuestBookingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimGuestBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimGuestBookingsRequest) ProtoMessage() {}
+
+func (x *ClaimGuestBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimGuestBookingsRequest.ProtoReflect.Descriptor instead.
+func (*ClaimGuestBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ClaimGuestBookingsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ClaimGuestBookingsRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type ClaimGuestBookingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Брони, привязанные к пользователю этим вызовом
+	BookingIds []string `protobuf:"bytes,1,rep,name=booking_ids,json=bookingIds,proto3" json:"booking_ids,omitempty"`
+}
+
+func (x *ClaimGuestBookingsResponse) Reset() {
+	*x = ClaimGuestBookingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimGuestBookingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimGuestBookingsResponse) ProtoMessage() {}
+
+func (x *ClaimGuestBookingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimGuestBookingsResponse.ProtoReflect.Descriptor instead.
+func (*ClaimGuestBookingsResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ClaimGuestBookingsResponse) GetBookingIds() []string {
+	if x != nil {
+		return x.BookingIds
+	}
+	return nil
+}
+
+type WaitlistEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId     string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GuestName  string                 `protobuf:"bytes,3,opt,name=guest_name,json=guestName,proto3" json:"guest_name,omitempty"`
+	GuestEmail string                 `protobuf:"bytes,4,opt,name=guest_email,json=guestEmail,proto3" json:"guest_email,omitempty"`
+	GuestPhone string                 `protobuf:"bytes,5,opt,name=guest_phone,json=guestPhone,proto3" json:"guest_phone,omitempty"`
+	CheckIn    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=check_in,json=checkIn,proto3" json:"check_in,omitempty"`
+	CheckOut   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=check_out,json=checkOut,proto3" json:"check_out,omitempty"`
+	Capacity   *int32                 `protobuf:"varint,8,opt,name=capacity,proto3,oneof" json:"capacity,omitempty"`
+	Type       *room.RoomType         `protobuf:"varint,9,opt,name=type,proto3,enum=hotel.room.v1.RoomType,oneof" json:"type,omitempty"`
+	Status     WaitlistStatus         `protobuf:"varint,10,opt,name=status,proto3,enum=hotel.booking.v1.WaitlistStatus" json:"status,omitempty"`
+	// PENDING бронь, созданная при освобождении комнаты
+	BookingId *string `protobuf:"bytes,11,opt,name=booking_id,json=bookingId,proto3,oneof" json:"booking_id,omitempty"`
+	// До этого времени гость должен подтвердить предложенную бронь
+	OfferExpiresAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=offer_expires_at,json=offerExpiresAt,proto3" json:"offer_expires_at,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *WaitlistEntry) Reset() {
+	*x = WaitlistEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaitlistEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitlistEntry) ProtoMessage() {}
+
+func (x *WaitlistEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitlistEntry.ProtoReflect.Descriptor instead.
+func (*WaitlistEntry) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *WaitlistEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetGuestName() string {
+	if x != nil {
+		return x.GuestName
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetGuestEmail() string {
+	if x != nil {
+		return x.GuestEmail
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetGuestPhone() string {
+	if x != nil {
+		return x.GuestPhone
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetCheckIn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckIn
+	}
+	return nil
+}
+
+func (x *WaitlistEntry) GetCheckOut() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckOut
+	}
+	return nil
+}
+
+func (x *WaitlistEntry) GetCapacity() int32 {
+	if x != nil && x.Capacity != nil {
+		return *x.Capacity
+	}
+	return 0
+}
+
+func (x *WaitlistEntry) GetType() room.RoomType {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return room.RoomType(0)
+}
+
+func (x *WaitlistEntry) GetStatus() WaitlistStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WaitlistStatus_WAITLIST_STATUS_UNSPECIFIED
+}
+
+func (x *WaitlistEntry) GetBookingId() string {
+	if x != nil && x.BookingId != nil {
+		return *x.BookingId
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetOfferExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OfferExpiresAt
+	}
+	return nil
+}
+
+func (x *WaitlistEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WaitlistEntry) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type JoinWaitlistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CheckIn    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=check_in,json=checkIn,proto3" json:"check_in,omitempty"`
+	CheckOut   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=check_out,json=checkOut,proto3" json:"check_out,omitempty"`
+	Capacity   *int32                 `protobuf:"varint,4,opt,name=capacity,proto3,oneof" json:"capacity,omitempty"`
+	Type       *room.RoomType         `protobuf:"varint,5,opt,name=type,proto3,enum=hotel.room.v1.RoomType,oneof" json:"type,omitempty"`
+	GuestName  string                 `protobuf:"bytes,6,opt,name=guest_name,json=guestName,proto3" json:"guest_name,omitempty"`
+	GuestEmail string                 `protobuf:"bytes,7,opt,name=guest_email,json=guestEmail,proto3" json:"guest_email,omitempty"`
+	GuestPhone string                 `protobuf:"bytes,8,opt,name=guest_phone,json=guestPhone,proto3" json:"guest_phone,omitempty"`
+}
+
+func (x *JoinWaitlistRequest) Reset() {
+	*x = JoinWaitlistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinWaitlistRequest) ProtoMessage() {}
+
+func (x *JoinWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*JoinWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *JoinWaitlistRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *JoinWaitlistRequest) GetCheckIn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckIn
+	}
+	return nil
+}
+
+func (x *JoinWaitlistRequest) GetCheckOut() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckOut
+	}
+	return nil
+}
+
+func (x *JoinWaitlistRequest) GetCapacity() int32 {
+	if x != nil && x.Capacity != nil {
+		return *x.Capacity
+	}
+	return 0
+}
+
+func (x *JoinWaitlistRequest) GetType() room.RoomType {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return room.RoomType(0)
+}
+
+func (x *JoinWaitlistRequest) GetGuestName() string {
+	if x != nil {
+		return x.GuestName
+	}
+	return ""
+}
+
+func (x *JoinWaitlistRequest) GetGuestEmail() string {
+	if x != nil {
+		return x.GuestEmail
+	}
+	return ""
+}
+
+func (x *JoinWaitlistRequest) GetGuestPhone() string {
+	if x != nil {
+		return x.GuestPhone
+	}
+	return ""
+}
+
+type WaitlistEntryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entry *WaitlistEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *WaitlistEntryResponse) Reset() {
+	*x = WaitlistEntryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaitlistEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitlistEntryResponse) ProtoMessage() {}
+
+func (x *WaitlistEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitlistEntryResponse.ProtoReflect.Descriptor instead.
+func (*WaitlistEntryResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *WaitlistEntryResponse) GetEntry() *WaitlistEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type GetWaitlistEntryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetWaitlistEntryRequest) Reset() {
+	*x = GetWaitlistEntryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWaitlistEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWaitlistEntryRequest) ProtoMessage() {}
+
+func (x *GetWaitlistEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWaitlistEntryRequest.ProtoReflect.Descriptor instead.
+func (*GetWaitlistEntryRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetWaitlistEntryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListWaitlistEntriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId *string         `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	Status *WaitlistStatus `protobuf:"varint,2,opt,name=status,proto3,enum=hotel.booking.v1.WaitlistStatus,oneof" json:"status,omitempty"`
+}
+
+func (x *ListWaitlistEntriesRequest) Reset() {
+	*x = ListWaitlistEntriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWaitlistEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWaitlistEntriesRequest) ProtoMessage() {}
+
+func (x *ListWaitlistEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWaitlistEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWaitlistEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListWaitlistEntriesRequest) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *ListWaitlistEntriesRequest) GetStatus() WaitlistStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return WaitlistStatus_WAITLIST_STATUS_UNSPECIFIED
+}
+
+type ListWaitlistEntriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*WaitlistEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *ListWaitlistEntriesResponse) Reset() {
+	*x = ListWaitlistEntriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWaitlistEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWaitlistEntriesResponse) ProtoMessage() {}
+
+func (x *ListWaitlistEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWaitlistEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWaitlistEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListWaitlistEntriesResponse) GetEntries() []*WaitlistEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type LeaveWaitlistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *LeaveWaitlistRequest) Reset() {
+	*x = LeaveWaitlistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaveWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveWaitlistRequest) ProtoMessage() {}
+
+func (x *LeaveWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*LeaveWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *LeaveWaitlistRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetOversoldNightsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Период ночей [from, to), по умолчанию 90 дней начиная с текущего
+	From *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	To   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3,oneof" json:"to,omitempty"`
+}
+
+func (x *GetOversoldNightsRequest) Reset() {
+	*x = GetOversoldNightsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOversoldNightsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOversoldNightsRequest) ProtoMessage() {}
+
+func (x *GetOversoldNightsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOversoldNightsRequest.ProtoReflect.Descriptor instead.
+func (*GetOversoldNightsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetOversoldNightsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetOversoldNightsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+// Ночь, на которую броней типа продано больше, чем есть комнат
+type OversoldNight struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Night    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=night,proto3" json:"night,omitempty"`
+	RoomType room.RoomType          `protobuf:"varint,2,opt,name=room_type,json=roomType,proto3,enum=hotel.room.v1.RoomType" json:"room_type,omitempty"`
+	// Комнаты типа, которые можно продать на ночь: кроме комнат в ремонте и выведенных из эксплуатации
+	Inventory int32 `protobuf:"varint,3,opt,name=inventory,proto3" json:"inventory,omitempty"`
+	// Потолок продаж с учетом допустимого овербукинга
+	Ceiling int32 `protobuf:"varint,4,opt,name=ceiling,proto3" json:"ceiling,omitempty"`
+	// Активные брони типа, из них без закрепленной комнаты
+	Booked     int32 `protobuf:"varint,5,opt,name=booked,proto3" json:"booked,omitempty"`
+	Unassigned int32 `protobuf:"varint,6,opt,name=unassigned,proto3" json:"unassigned,omitempty"`
+	// На сколько броней продажи превышают число комнат
+	Oversold int32 `protobuf:"varint,7,opt,name=oversold,proto3" json:"oversold,omitempty"`
+}
+
+func (x *OversoldNight) Reset() {
+	*x = OversoldNight{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OversoldNight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OversoldNight) ProtoMessage() {}
+
+func (x *OversoldNight) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OversoldNight.ProtoReflect.Descriptor instead.
+func (*OversoldNight) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *OversoldNight) GetNight() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Night
+	}
+	return nil
+}
+
+func (x *OversoldNight) GetRoomType() room.RoomType {
+	if x != nil {
+		return x.RoomType
+	}
+	return room.RoomType(0)
+}
+
+func (x *OversoldNight) GetInventory() int32 {
+	if x != nil {
+		return x.Inventory
+	}
+	return 0
+}
+
+func (x *OversoldNight) GetCeiling() int32 {
+	if x != nil {
+		return x.Ceiling
+	}
+	return 0
+}
+
+func (x *OversoldNight) GetBooked() int32 {
+	if x != nil {
+		return x.Booked
+	}
+	return 0
+}
+
+func (x *OversoldNight) GetUnassigned() int32 {
+	if x != nil {
+		return x.Unassigned
+	}
+	return 0
+}
+
+func (x *OversoldNight) GetOversold() int32 {
+	if x != nil {
+		return x.Oversold
+	}
+	return 0
+}
+
+type GetOversoldNightsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nights []*OversoldNight `protobuf:"bytes,1,rep,name=nights,proto3" json:"nights,omitempty"`
+}
+
+func (x *GetOversoldNightsResponse) Reset() {
+	*x = GetOversoldNightsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOversoldNightsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOversoldNightsResponse) ProtoMessage() {}
+
+func (x *GetOversoldNightsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOversoldNightsResponse.ProtoReflect.Descriptor instead.
+func (*GetOversoldNightsResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetOversoldNightsResponse) GetNights() []*OversoldNight {
+	if x != nil {
+		return x.Nights
+	}
+	return nil
+}
+
+type GetAvailabilityCalendarRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Период ночей [from, to), по умолчанию 30 дней начиная с текущего
+	From    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	To      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	GroupBy CalendarGrouping       `protobuf:"varint,3,opt,name=group_by,json=groupBy,proto3,enum=hotel.booking.v1.CalendarGrouping" json:"group_by,omitempty"`
+	// Только комнаты типа, не задан — все
+	RoomType *room.RoomType `protobuf:"varint,4,opt,name=room_type,json=roomType,proto3,enum=hotel.room.v1.RoomType,oneof" json:"room_type,omitempty"`
+}
+
+func (x *GetAvailabilityCalendarRequest) Reset() {
+	*x = GetAvailabilityCalendarRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAvailabilityCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailabilityCalendarRequest) ProtoMessage() {}
+
+func (x *GetAvailabilityCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailabilityCalendarRequest.ProtoReflect.Descriptor instead.
+func (*GetAvailabilityCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetAvailabilityCalendarRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetAvailabilityCalendarRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetAvailabilityCalendarRequest) GetGroupBy() CalendarGrouping {
+	if x != nil {
+		return x.GroupBy
+	}
+	return CalendarGrouping_CALENDAR_GROUPING_UNSPECIFIED
+}
+
+func (x *GetAvailabilityCalendarRequest) GetRoomType() room.RoomType {
+	if x != nil && x.RoomType != nil {
+		return *x.RoomType
+	}
+	return room.RoomType(0)
+}
+
+// Состояние строки календаря на одну ночь
+type AvailabilityCalendarNight struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Night  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=night,proto3" json:"night,omitempty"`
+	Status AvailabilityStatus     `protobuf:"varint,2,opt,name=status,proto3,enum=hotel.booking.v1.AvailabilityStatus" json:"status,omitempty"`
+	// Бронь, занимающая комнату (только в строках комнат)
+	BookingId *string `protobuf:"bytes,3,opt,name=booking_id,json=bookingId,proto3,oneof" json:"booking_id,omitempty"`
+	// Число комнат в каждом состоянии; брони типа без назначенной комнаты учитываются как занятые
+	Free         int32 `protobuf:"varint,4,opt,name=free,proto3" json:"free,omitempty"`
+	Booked       int32 `protobuf:"varint,5,opt,name=booked,proto3" json:"booked,omitempty"`
+	Blocked      int32 `protobuf:"varint,6,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	OutOfService int32 `protobuf:"varint,7,opt,name=out_of_service,json=outOfService,proto3" json:"out_of_service,omitempty"`
+}
+
+func (x *AvailabilityCalendarNight) Reset() {
+	*x = AvailabilityCalendarNight{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AvailabilityCalendarNight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailabilityCalendarNight) ProtoMessage() {}
+
+func (x *AvailabilityCalendarNight) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailabilityCalendarNight.ProtoReflect.Descriptor instead.
+func (*AvailabilityCalendarNight) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *AvailabilityCalendarNight) GetNight() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Night
+	}
+	return nil
+}
+
+func (x *AvailabilityCalendarNight) GetStatus() AvailabilityStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AvailabilityStatus_AVAILABILITY_STATUS_UNSPECIFIED
+}
+
+func (x *AvailabilityCalendarNight) GetBookingId() string {
+	if x != nil && x.BookingId != nil {
+		return *x.BookingId
+	}
+	return ""
+}
+
+func (x *AvailabilityCalendarNight) GetFree() int32 {
+	if x != nil {
+		return x.Free
+	}
+	return 0
+}
+
+func (x *AvailabilityCalendarNight) GetBooked() int32 {
+	if x != nil {
+		return x.Booked
+	}
+	return 0
+}
+
+func (x *AvailabilityCalendarNight) GetBlocked() int32 {
+	if x != nil {
+		return x.Blocked
+	}
+	return 0
+}
+
+func (x *AvailabilityCalendarNight) GetOutOfService() int32 {
+	if x != nil {
+		return x.OutOfService
+	}
+	return 0
+}
+
+// Строка календаря: комната или тип комнат
+type AvailabilityCalendarRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Не заданы в строках типов
+	RoomId     *string                      `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3,oneof" json:"room_id,omitempty"`
+	RoomNumber *string                      `protobuf:"bytes,2,opt,name=room_number,json=roomNumber,proto3,oneof" json:"room_number,omitempty"`
+	RoomType   room.RoomType                `protobuf:"varint,3,opt,name=room_type,json=roomType,proto3,enum=hotel.room.v1.RoomType" json:"room_type,omitempty"`
+	Nights     []*AvailabilityCalendarNight `protobuf:"bytes,4,rep,name=nights,proto3" json:"nights,omitempty"`
+}
+
+func (x *AvailabilityCalendarRow) Reset() {
+	*x = AvailabilityCalendarRow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AvailabilityCalendarRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailabilityCalendarRow) ProtoMessage() {}
+
+func (x *AvailabilityCalendarRow) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailabilityCalendarRow.ProtoReflect.Descriptor instead.
+func (*AvailabilityCalendarRow) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *AvailabilityCalendarRow) GetRoomId() string {
+	if x != nil && x.RoomId != nil {
+		return *x.RoomId
+	}
+	return ""
+}
+
+func (x *AvailabilityCalendarRow) GetRoomNumber() string {
+	if x != nil && x.RoomNumber != nil {
+		return *x.RoomNumber
+	}
+	return ""
+}
+
+func (x *AvailabilityCalendarRow) GetRoomType() room.RoomType {
+	if x != nil {
+		return x.RoomType
+	}
+	return room.RoomType(0)
+}
+
+func (x *AvailabilityCalendarRow) GetNights() []*AvailabilityCalendarNight {
+	if x != nil {
+		return x.Nights
+	}
+	return nil
+}
+
+type GetAvailabilityCalendarResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rows []*AvailabilityCalendarRow `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+}
+
+func (x *GetAvailabilityCalendarResponse) Reset() {
+	*x = GetAvailabilityCalendarResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_booking_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAvailabilityCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailabilityCalendarResponse) ProtoMessage() {}
+
+func (x *GetAvailabilityCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailabilityCalendarResponse.ProtoReflect.Descriptor instead.
+func (*GetAvailabilityCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetAvailabilityCalendarResponse) GetRows() []*AvailabilityCalendarRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+var File_booking_booking_proto protoreflect.FileDescriptor
+
+var file_booking_booking_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x72, 0x6f, 0x6f, 0x6d, 0x2f, 0x72,
+	0x6f, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x6d, 0x6f, 0x6e, 0x65, 0x79,
+	0x2f, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa1, 0x02, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x6f, 0x6f,
+	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e,
+	0x12, 0x37, 0x0a, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x4f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x08, 0x63, 0x61, 0x70,
+	0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x08, 0x63,
+	0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c,
+	0x2e, 0x72, 0x6f, 0x6f, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70,
+	0x65, 0x48, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02,
+	0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x22, 0x46, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a,
+	0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x68,
+	0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x72, 0x6f, 0x6f, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f,
+	0x6d, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x22, 0xd7, 0x03, 0x0a, 0x14, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x35, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x12, 0x37, 0x0a, 0x09, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x4f, 0x75,
+	0x74, 0x12, 0x1f, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x88,
+	0x01, 0x01, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x72, 0x6f, 0x6f, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x48, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x88,
+	0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x75, 0x65, 0x73, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x75, 0x65, 0x73, 0x74, 0x45, 0x6d, 0x61,
+	0x69, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x68, 0x6f, 0x6e,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x75, 0x65, 0x73, 0x74, 0x50, 0x68,
+	0x6f, 0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x22, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f,
+	0x64, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69,
+	0x74, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x6f, 0x6f, 0x6d,
+	0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x22, 0x4c, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68,
+	0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x22, 0xab, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x37,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f,
+	0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x42, 0x79, 0x22, 0x52,
+	0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a,
+	0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x22, 0x60, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x22, 0x49, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x6f,
+	0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x22,
+	0xbc, 0x03, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x48, 0x02, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x08, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x67, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x0e, 0x67, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69,
+	0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0b, 0x0a, 0x09,
+	0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x67, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x75,
+	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c,
+	0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x52, 0x08, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x26, 0x0a,
+	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x39, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64,
+	0x22, 0x5c, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a,
+	0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0xd0,
+	0x01, 0x0a, 0x13, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x42, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0xf6, 0x0a, 0x0a, 0x07, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x75, 0x65, 0x73, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x75, 0x65, 0x73, 0x74, 0x45,
+	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x68,
+	0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x75, 0x65, 0x73, 0x74,
+	0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x69,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x12, 0x37, 0x0a, 0x09,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x4f, 0x75, 0x74, 0x12, 0x36, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x68, 0x6f, 0x74,
+	0x65, 0x6c, 0x2e, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x46, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1f, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x50, 0x0a, 0x13, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1f, 0x2e,
+	0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x12,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x42, 0x0a, 0x0f, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x68, 0x6f, 0x6c, 0x64, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x6f, 0x6f,
+	0x6d, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x0d, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x65, 0x64, 0x5f, 0x69, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x65, 0x64, 0x49, 0x6e, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x0e, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x41, 0x74, 0x12, 0x48, 0x0a, 0x14, 0x63, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74,
+	0x79, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e,
+	0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x13,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x6e, 0x61,
+	0x6c, 0x74, 0x79, 0x12, 0x3a, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x68, 0x6f, 0x74,
+	0x65, 0x6c, 0x2e, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x49, 0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x6d, 0x6f, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3d,
+	0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0b, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2a, 0x0a,
+	0x0e, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x0f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x15, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x69, 0x67, 0x68, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x52, 0x0e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e,
+	0x12, 0x34, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x1b, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x72, 0x6f, 0x6f, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x72, 0x6f,
+	0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2d, 0x0a, 0x10, 0x67, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x03, 0x52, 0x0e, 0x67, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x67, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x4a, 0x04, 0x08, 0x09, 0x10, 0x0a, 0x4a, 0x04,
+	0x08, 0x11, 0x10, 0x12, 0x4a, 0x04, 0x08, 0x12, 0x10, 0x13, 0x22, 0x6e, 0x0a, 0x0f, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x68, 0x6f,
+	0x74, 0x65, 0x6c, 0x2e, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf0, 0x01, 0x0a, 0x0a, 0x4e,
+	0x69, 0x67, 0x68, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x62, 0x61, 0x73,
+	0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x2b, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x0b,
+	0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0x77, 0x0a,
+	0x1c, 0x52, 0x75, 0x6e, 0x4e, 0x69, 0x67, 0x68, 0x74, 0x6c, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x34, 0x0a, 0x05, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x48, 0x00, 0x52, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06,
+	0x5f, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x22, 0x6c, 0x0a, 0x1d, 0x52, 0x75, 0x6e, 0x4e, 0x69, 0x67,
+	0x68, 0x74, 0x6c, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x68,
+	0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb6, 0x01, 0x0a, 0x17, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12,
+	0x33, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e,
+	0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2f, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1f, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x78, 0x0a,
+	0x0e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x1c,
+	0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x42, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x22, 0x46, 0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x49, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x6f,
+	0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x22,
+	0x4f, 0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x42, 0x79,
+	0x22, 0x47, 0x0a, 0x10, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x6a, 0x0a, 0x11, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x42, 0x79, 0x22, 0x49, 0x0a, 0x12, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52,
+	0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68,
+	0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x22, 0x9e, 0x03, 0x0a, 0x14, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x42, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x5f, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x12,
+	0x37, 0x0a, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x4f, 0x75, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x72,
+	0x6f, 0x6f, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x48,
+	0x00, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x67, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x09, 0x67, 0x75, 0x65, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x24,
+	0x0a, 0x0b, 0x67, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0a, 0x67, 0x75, 0x65, 0x73, 0x74, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x67, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x68,
+	0x6f, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0a, 0x67, 0x75, 0x65,
+	0x73, 0x74, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x42, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x67, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x67, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x67, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x68, 0x6f, 0x6e,
+	0x65, 0x22, 0x4c, 0x0a, 0x15, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x42, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x6f,
+	0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x22,
+	0x92, 0x03, 0x0a, 0x11, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x68, 0x6f, 0x74,
+	0x65, 0x6c, 0x2e, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x27, 0x0a,
+	0x0f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74,
+	0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e,
+	0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x07,
+	0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x2d, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e,
+	0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06,
+	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x2f, 0x0a, 0x14, 0x64, 0x61, 0x79, 0x73, 0x5f, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x64, 0x61, 0x79, 0x73, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x12, 0x52, 0x0a, 0x17, 0x66, 0x72, 0x65, 0x65, 0x5f,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x6e, 0x74,
+	0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x15, 0x66, 0x72, 0x65, 0x65, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x6e,
+	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x6e, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x61, 0x62,
+	0x6c, 0x65, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04,
+	0x08, 0x04, 0x10, 0x05, 0x22, 0x3c, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x49, 0x64, 0x22, 0x59, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x39, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x22, 0x6c, 0x0a,
+	0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x42, 0x79, 0x22, 0x87, 0x01, 0x0a, 0x15,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x39, 0x0a, 0x05, 0x71, 0x75,
+	0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x68, 0x6f, 0x74, 0x65,
+	0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x05,
+	0x71, 0x75, 0x6f, 0x74, 0x65, 0x22, 0xca, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1c, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x30,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x68,
+	0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x72, 0x6f, 0x6f, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f,
+	0x6d, 0x54, 0x79, 0x70, 0x65, 0x48, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x1f, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x02, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x88, 0x01,
+	0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x75, 0x65, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x42, 0x07, 0x0a, 0x05,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69,
+	0x74, 0x79, 0x22, 0xe1, 0x02, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x35, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x12, 0x37, 0x0a, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f,
+	0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x4f, 0x75, 0x74, 0x12,
+	0x1c, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x5f, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x3e, 0x0a, 0x05, 0x72, 0x6f,
+	0x6f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x68, 0x6f, 0x74, 0x65,
+	0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x5c, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c,
+	0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x22, 0x59, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f,
+	0x0a, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x91, 0x03, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x45, 0x6d, 0x61,
+	0x69, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x70, 0x68,
+	0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52,
+	0x08, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x36, 0x0a, 0x0b, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x4a, 0x04, 0x08,
+	0x09, 0x10, 0x0a, 0x22, 0xdb, 0x05, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x68,
+	0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x29, 0x0a, 0x10, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x0f, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x6d, 0x6f, 0x6e, 0x65,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x35, 0x0a, 0x08, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x74,
+	0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x07, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x54, 0x6f, 0x12, 0x36, 0x0a, 0x0a,
+	0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x72, 0x6f, 0x6f, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x72, 0x6f, 0x6f, 0x6d, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x64, 0x65,
+	0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52,
+	0x0e, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x3c, 0x0a, 0x18, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x15, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x64, 0x65, 0x6d,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x88, 0x01, 0x01,
+	0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x42, 0x1b, 0x0a, 0x19, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x64,
+	0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65,
+	0x72, 0x22, 0xa8, 0x04, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x48, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d,
+	0x12, 0x35, 0x0a, 0x08, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x54, 0x6f, 0x12, 0x36, 0x0a, 0x0a, 0x72, 0x6f, 0x6f, 0x6d, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x68, 0x6f,
+	0x74, 0x65, 0x6c, 0x2e, 0x72, 0x6f, 0x6f, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x72, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12,
+	0x2c, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x52,
+	0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a,
+	0x18, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x48,
+	0x01, 0x52, 0x15, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x50, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f,
+	0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42,
+	0x1b, 0x0a, 0x19, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x22, 0x25, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x38, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
+	0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x56, 0x0a,
+	0x16, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x6d, 0x6f,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x68,
+	0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x6f,
+	0x43, 0x6f, 0x64, 0x65, 0x73, 0x22, 0xbc, 0x04, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x48, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d,
+	0x12, 0x35, 0x0a, 0x08, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x54, 0x6f, 0x12, 0x36, 0x0a, 0x0a, 0x72, 0x6f, 0x6f, 0x6d, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x68, 0x6f,
+	0x74, 0x65, 0x6c, 0x2e, 0x72, 0x6f, 0x6f, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x72, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12,
+	0x2c, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x52,
+	0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a,
+	0x18, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x48,
+	0x01, 0x52, 0x15, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x50, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x64, 0x65,
+	0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x1b, 0x0a, 0x19, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x75, 0x73, 0x65, 0x72, 0x22, 0x4f, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x6d, 0x6f, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6d,
+	0x6f, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x28, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x19, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbc, 0x05, 0x0a, 0x0f, 0x53,
+	0x74, 0x61, 0x79, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x33, 0x0a, 0x07, 0x64,
+	0x61, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f,
+	0x12, 0x39, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x72, 0x6f, 0x6f, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x48, 0x00, 0x52, 0x08,
+	0x72, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x77,
+	0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08, 0x77,
+	0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73, 0x12, 0x1e, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x5f, 0x73,
+	0x74, 0x61, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x07, 0x6d, 0x69, 0x6e,
+	0x53, 0x74, 0x61, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x73,
+	0x74, 0x61, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x07, 0x6d, 0x61, 0x78,
+	0x53, 0x74, 0x61, 0x79, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6c, 0x6f, 0x73, 0x65,
+	0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x54, 0x6f, 0x41, 0x72, 0x72, 0x69,
+	0x76, 0x61, 0x6c, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x74, 0x6f,
+	0x5f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x11, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x54, 0x6f, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x2d, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x64, 0x76, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x48, 0x03, 0x52,
+	0x0e, 0x6d, 0x69, 0x6e, 0x41, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x61, 0x79, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x64, 0x76, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x48, 0x04, 0x52, 0x0e,
+	0x6d, 0x61, 0x78, 0x41, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x61, 0x79, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x6f, 0x6f, 0x6d,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74,
+	0x61, 0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x79, 0x42,
+	0x13, 0x0a, 0x11, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x64, 0x61, 0x79, 0x73, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x64, 0x76,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x22, 0xc3, 0x04, 0x0a, 0x1c, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x79, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37,
+	0x0a, 0x09, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64,
+	0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x33, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x12, 0x39, 0x0a, 0x09,
+	0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x17, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x72, 0x6f, 0x6f, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x6d,
+	0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x65, 0x65, 0x6b, 0x64,
+	0x61, 0x79, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08, 0x77, 0x65, 0x65, 0x6b, 0x64,
+	0x61, 0x79, 0x73, 0x12, 0x1e, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x79,
+	0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x79, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x79,
+	0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x74, 0x6f,
+	0x5f, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x54, 0x6f, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x12,
+	0x2e, 0x0a, 0x13, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x64, 0x65, 0x70,
+	0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x63, 0x6c,
+	0x6f, 0x73, 0x65, 0x64, 0x54, 0x6f, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x2d, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x64,
+	0x61, 0x79, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x48, 0x03, 0x52, 0x0e, 0x6d, 0x69, 0x6e,
+	0x41, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x61, 0x79, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2d,
+	0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x61,
+	0x79, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x48, 0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x41,
+	0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x61, 0x79, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x61, 0x78,
+	0x5f, 0x73, 0x74, 0x61, 0x79, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x64,
+	0x76, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x61, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x22,
+	0x2b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x79, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xdc, 0x01, 0x0a,
+	0x1b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x79, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x88, 0x01,
+	0x01, 0x12, 0x2f, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x02, 0x74, 0x6f, 0x88,
+	0x01, 0x01, 0x12, 0x39, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x72, 0x6f,
+	0x6f, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x48, 0x02,
+	0x52, 0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x6f, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x65, 0x0a, 0x1c, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x79, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0c, 0x72,
+	0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x79, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0xd3, 0x04, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61,
+	0x79, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d,
+	0x12, 0x33, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x6f, 0x12, 0x39, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c,
+	0x2e, 0x72, 0x6f, 0x6f, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70,
+	0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x1a, 0x0a, 0x08, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x05, 0x52, 0x08, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73, 0x12, 0x1e, 0x0a, 0x08,
+	0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01,
+	0x52, 0x07, 0x6d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08,
+	0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02,
+	0x52, 0x07, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x79, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x11,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61,
+	0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x54,
+	0x6f, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x6c, 0x6f, 0x73,
+	0x65, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x54, 0x6f, 0x44,
+	0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2d, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x5f,
+	0x61, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x03, 0x52, 0x0e, 0x6d, 0x69, 0x6e, 0x41, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65,
+	0x44, 0x61, 0x79, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x61,
+	0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x41, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x44,
+	0x61, 0x79, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x6f, 0x6f, 0x6d, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x61,
+	0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x79, 0x42, 0x13,
+	0x0a, 0x11, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x64,
+	0x61, 0x79, 0x73, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x64, 0x76, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x22, 0x5e, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x79,
+	0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c,
+	0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x79,
+	0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x72, 0x65, 0x73,
+	0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2e, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x74, 0x61, 0x79, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1f, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x74, 0x61, 0x79, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd5, 0x03, 0x0a, 0x0c, 0x47, 0x75,
+	0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x73, 0x12, 0x19,
+	0x0a, 0x08, 0x62, 0x65, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x62, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x46, 0x6c, 0x6f,
+	0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x69, 0x65, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x69, 0x65, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x74, 0x61, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x3c, 0x0a, 0x0e, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e,
+	0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0d,
+	0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3c, 0x0a,
+	0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x79, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0a, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x74, 0x61, 0x79, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x28, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x47, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x48, 0x0a, 0x1a, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x47, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x59, 0x0a, 0x1b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x47,
+	0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x75, 0x65, 0x73, 0x74, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x22, 0xa1, 0x01, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x75, 0x65, 0x73, 0x74,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x65, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a,
+	0x0f, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x66, 0x6c, 0x6f, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65,
+	0x64, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x67,
+	0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x65, 0x72,
+	0x67, 0x69, 0x65, 0x73, 0x22, 0x4a, 0x0a, 0x19, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x47, 0x75, 0x65,
+	0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x73,
+	0x22, 0x50, 0x0a, 0x14, 0x47, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x68, 0x6f, 0x74, 0x65,
+	0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x75, 0x65,
+	0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x22, 0x4a, 0x0a, 0x19, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x47, 0x75, 0x65, 0x73, 0x74,
+	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x3d,
+	0x0a, 0x1a, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x47, 0x75, 0x65, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x73, 0x22, 0x9b, 0x05,
+	0x0a, 0x0d, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x75, 0x65, 0x73,
+	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x75,
+	0x65, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x75,
+	0x65, 0x73, 0x74, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x75, 0x65, 0x73,
+	0x74, 0x5f, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67,
+	0x75, 0x65, 0x73, 0x74, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e,
+	0x12, 0x37, 0x0a, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x4f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x08, 0x63, 0x61, 0x70,
+	0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x08, 0x63,
+	0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c,
+	0x2e, 0x72, 0x6f, 0x6f, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70,
+	0x65, 0x48, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x68,
+	0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x09, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x10, 0x6f, 0x66,
+	0x66, 0x65, 0x72, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0e, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63,
+	0x69, 0x74, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x22, 0xe8, 0x02, 0x0a, 0x13,
+	0x4a, 0x6f, 0x69, 0x6e, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x08,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x49, 0x6e, 0x12, 0x37, 0x0a, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x6f, 0x75, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x4f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x08,
+	0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00,
+	0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x68, 0x6f,
+	0x74, 0x65, 0x6c, 0x2e, 0x72, 0x6f, 0x6f, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d,
+	0x54, 0x79, 0x70, 0x65, 0x48, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x1d, 0x0a, 0x0a, 0x67, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x75, 0x65, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x67, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x75, 0x65, 0x73, 0x74, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12,
+	0x1f, 0x0a, 0x0b, 0x67, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x75, 0x65, 0x73, 0x74, 0x50, 0x68, 0x6f, 0x6e, 0x65,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x4e, 0x0a, 0x15, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69,
+	0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x35, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x29, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x57, 0x61, 0x69,
+	0x74, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x90, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69,
+	0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1c, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3d,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20,
+	0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x48, 0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a,
+	0x08, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x58, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x69, 0x74,
+	0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x26,
+	0x0a, 0x14, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x90, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4f, 0x76,
+	0x65, 0x72, 0x73, 0x6f, 0x6c, 0x64, 0x4e, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x48, 0x01, 0x52, 0x02, 0x74, 0x6f, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x66, 0x72,
+	0x6f, 0x6d, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x6f, 0x22, 0x83, 0x02, 0x0a, 0x0d, 0x4f, 0x76,
+	0x65, 0x72, 0x73, 0x6f, 0x6c, 0x64, 0x4e, 0x69, 0x67, 0x68, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x6e,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x12, 0x34, 0x0a,
+	0x09, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x72, 0x6f, 0x6f, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x63, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x62,
+	0x6f, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x62, 0x6f, 0x6f,
+	0x6b, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x75, 0x6e, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x6f, 0x6c, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x6f, 0x6c, 0x64, 0x22,
+	0x54, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x73, 0x6f, 0x6c, 0x64, 0x4e, 0x69,
+	0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06,
+	0x6e, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x68,
+	0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x4f, 0x76, 0x65, 0x72, 0x73, 0x6f, 0x6c, 0x64, 0x4e, 0x69, 0x67, 0x68, 0x74, 0x52, 0x06, 0x6e,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x22, 0x9e, 0x02, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x48, 0x00, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a,
+	0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x02, 0x74, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x3d,
+	0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x22, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x39, 0x0a,
+	0x09, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x72, 0x6f, 0x6f, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x48, 0x02, 0x52, 0x08, 0x72, 0x6f, 0x6f,
+	0x6d, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x66, 0x72, 0x6f,
+	0x6d, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x6f, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x6f, 0x6f,
+	0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0xaa, 0x02, 0x0a, 0x19, 0x41, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x4e,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x05, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x12, 0x3c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x65, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x66, 0x72, 0x65, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x62, 0x6f, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x62, 0x6f,
+	0x6f, 0x6b, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x24,
+	0x0a, 0x0e, 0x6f, 0x75, 0x74, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6f, 0x75, 0x74, 0x4f, 0x66, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x5f, 0x69, 0x64, 0x22, 0xf4, 0x01, 0x0a, 0x17, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x6f, 0x77, 0x12,
+	0x1c, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a,
+	0x0b, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x01, 0x52, 0x0a, 0x72, 0x6f, 0x6f, 0x6d, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x72,
+	0x6f, 0x6f, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x43, 0x0a, 0x06, 0x6e, 0x69, 0x67,
+	0x68, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x68, 0x6f, 0x74, 0x65,
+	0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
+	0x72, 0x4e, 0x69, 0x67, 0x68, 0x74, 0x52, 0x06, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x73, 0x42, 0x0a,
+	0x0a, 0x08, 0x5f, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x72,
+	0x6f, 0x6f, 0x6d, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x60, 0x0a, 0x1f, 0x47, 0x65,
+	0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x43, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a,
+	0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x68, 0x6f,
+	0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x43, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x2a, 0xc1, 0x01, 0x0a,
+	0x0d, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e,
+	0x0a, 0x1a, 0x42, 0x4f, 0x4f, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a,
+	0x0a, 0x16, 0x42, 0x4f, 0x4f, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x42, 0x4f,
+	0x4f, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4e,
+	0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x42, 0x4f, 0x4f, 0x4b,
+	0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45,
+	0x4c, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x42, 0x4f, 0x4f, 0x4b, 0x49, 0x4e,
+	0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54,
+	0x45, 0x44, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x42, 0x4f, 0x4f, 0x4b, 0x49, 0x4e, 0x47, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4e, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x57, 0x10, 0x05,
+	0x2a, 0x78, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x1f, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x5f, 0x44,
+	0x49, 0x53, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x52,
+	0x4f, 0x4d, 0x4f, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x50,
+	0x52, 0x4f, 0x4d, 0x4f, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x46, 0x49, 0x58, 0x45, 0x44, 0x10, 0x02, 0x2a, 0xc0, 0x01, 0x0a, 0x12, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x23, 0x0a, 0x1f, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54,
+	0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41,
+	0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x52,
+	0x45, 0x45, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x49,
+	0x4c, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x42, 0x4f, 0x4f, 0x4b,
+	0x45, 0x44, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x49,
+	0x4c, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x42, 0x4c, 0x4f, 0x43,
+	0x4b, 0x45, 0x44, 0x10, 0x03, 0x12, 0x26, 0x0a, 0x22, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42,
+	0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x55, 0x54,
+	0x5f, 0x4f, 0x46, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x10, 0x04, 0x2a, 0x72, 0x0a,
+	0x10, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e,
+	0x67, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x41, 0x4c, 0x45, 0x4e, 0x44, 0x41, 0x52, 0x5f, 0x47, 0x52,
+	0x4f, 0x55, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x41, 0x4c, 0x45, 0x4e, 0x44, 0x41, 0x52,
+	0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x4f, 0x4f, 0x4d, 0x10, 0x01,
+	0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x41, 0x4c, 0x45, 0x4e, 0x44, 0x41, 0x52, 0x5f, 0x47, 0x52, 0x4f,
+	0x55, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10,
+	0x02, 0x2a, 0xa7, 0x01, 0x0a, 0x0e, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x1b, 0x57, 0x41, 0x49, 0x54, 0x4c, 0x49, 0x53, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x57, 0x41, 0x49, 0x54, 0x4c, 0x49, 0x53,
+	0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47,
+	0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x57, 0x41, 0x49, 0x54, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x46, 0x46, 0x45, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12,
+	0x1b, 0x0a, 0x17, 0x57, 0x41, 0x49, 0x54, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19,
+	0x57, 0x41, 0x49, 0x54, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x32, 0x9f, 0x28, 0x0a, 0x0e,
+	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x8d,
+	0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52,
+	0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x2a, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2b, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x2d, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x7a,
+	0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12,
+	0x26, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x22, 0x10, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12, 0xa0, 0x01, 0x0a, 0x13, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x2c, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2d, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x1a, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x7e, 0x0a,
+	0x0a, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x2e, 0x68, 0x6f,
+	0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73,
+	0x2f, 0x7b, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x77, 0x0a,
+	0x0c, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x25, 0x2e,
+	0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x9b, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2a, 0x2e, 0x68,
+	0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c,
+	0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x2f,
+	0x7b, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0x8d, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x68, 0x6f, 0x74,
+	0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x14, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x3a, 0x01, 0x2a, 0x12, 0x92, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x28, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x27, 0x12, 0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x8a, 0x01, 0x0a, 0x0d, 0x4d, 0x6f,
+	0x64, 0x69, 0x66, 0x79, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x26, 0x2e, 0x68, 0x6f,
+	0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x6f, 0x64, 0x69, 0x66, 0x79, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x42, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x22, 0x32, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f,
+	0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0xaf, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12,
+	0x2d, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
+	0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x12, 0x30, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2d, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x91, 0x01, 0x0a, 0x0d, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x26, 0x2e, 0x68, 0x6f, 0x74,
+	0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x29, 0x3a, 0x01, 0x2a, 0x22, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x81, 0x01, 0x0a,
+	0x07, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x12, 0x20, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c,
+	0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x68, 0x6f, 0x74,
+	0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x49, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x22, 0x26, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x2d, 0x69, 0x6e, 0x3a, 0x01, 0x2a,
+	0x12, 0x85, 0x01, 0x0a, 0x08, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4f, 0x75, 0x74, 0x12, 0x21, 0x2e,
+	0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x22, 0x27, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x2d, 0x6f, 0x75, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x8d, 0x01, 0x0a, 0x0a, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x23, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x68,
+	0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x22, 0x29, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x2d, 0x72, 0x6f, 0x6f, 0x6d, 0x3a, 0x01, 0x2a, 0x12, 0x78, 0x0a, 0x15, 0x52, 0x75, 0x6e, 0x4e,
+	0x69, 0x67, 0x68, 0x74, 0x6c, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x2e, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x4e, 0x69, 0x67, 0x68, 0x74, 0x6c, 0x79, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2f, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x4e, 0x69, 0x67, 0x68, 0x74, 0x6c, 0x79, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x28, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a,
+	0x22, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x2d,
+	0x63, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x7c, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x25, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x68,
+	0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x2d, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x2f, 0x7b,
+	0x69, 0x64, 0x7d, 0x12, 0x80, 0x01, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72,
+	0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x15, 0x12, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6d, 0x6f,
+	0x2d, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x85, 0x01, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x28, 0x2e, 0x68, 0x6f, 0x74,
+	0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1d, 0x1a, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6d, 0x6f,
+	0x2d, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x88,
+	0x01, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x28, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x68,
+	0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x2a,
+	0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x2d, 0x63,
+	0x6f, 0x64, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x98, 0x01, 0x0a, 0x15, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x79, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61,
+	0x79, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x79, 0x52, 0x65, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x74, 0x61, 0x79, 0x2d, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x3a, 0x01, 0x2a, 0x12, 0x94, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x79,
+	0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x68, 0x6f,
+	0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x79, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c,
+	0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x79,
+	0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x79, 0x2d, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x98, 0x01, 0x0a, 0x14,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x79, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x79,
+	0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x79, 0x52,
+	0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x79, 0x2d, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x9d, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x74, 0x61, 0x79, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x2e, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x79, 0x52, 0x65,
+	0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x79, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x23, 0x1a, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61,
+	0x79, 0x2d, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b,
+	0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0xa0, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x74, 0x61, 0x79, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x2e, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x79, 0x52, 0x65,
+	0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2f, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x79, 0x52, 0x65,
+	0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x2a, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x79, 0x2d, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x88, 0x01, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x47, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x28, 0x2e,
+	0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x47, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x75, 0x65, 0x73, 0x74,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x67, 0x75, 0x65, 0x73, 0x74, 0x2d, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x12, 0x92, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x47,
+	0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2c, 0x2e, 0x68,
+	0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x47, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x68, 0x6f, 0x74,
+	0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x47, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x75, 0x65, 0x73, 0x74,
+	0x2d, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x91, 0x01, 0x0a, 0x12, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x47, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x12, 0x2b, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x75, 0x65, 0x73, 0x74, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x1a, 0x1b, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x75, 0x65, 0x73, 0x74, 0x2d, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x97, 0x01,
+	0x0a, 0x12, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x47, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x47, 0x75, 0x65,
+	0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x26, 0x3a, 0x01, 0x2a, 0x22, 0x21, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x75,
+	0x65, 0x73, 0x74, 0x2d, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x2f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x12, 0x6f, 0x0a, 0x12, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x47, 0x75, 0x65, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2b, 0x2e,
+	0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x47, 0x75, 0x65, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x68, 0x6f, 0x74,
+	0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x47, 0x75, 0x65, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7b, 0x0a, 0x0c, 0x4a, 0x6f, 0x69, 0x6e,
+	0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x25, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c,
+	0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x69, 0x6e,
+	0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15,
+	0x22, 0x10, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x61, 0x69, 0x74, 0x6c, 0x69,
+	0x73, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x85, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x57, 0x61, 0x69,
+	0x74, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x29, 0x2e, 0x68, 0x6f, 0x74,
+	0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x77, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x8c, 0x01,
+	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2c, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x69,
+	0x74, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x69, 0x74, 0x6c,
+	0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x77, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x7f, 0x0a, 0x0d,
+	0x4c, 0x65, 0x61, 0x76, 0x65, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x2e,
+	0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x2a, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x77, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x95, 0x01,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x73, 0x6f, 0x6c, 0x64, 0x4e, 0x69, 0x67,
+	0x68, 0x74, 0x73, 0x12, 0x2a, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x73, 0x6f,
+	0x6c, 0x64, 0x4e, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2b, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x73, 0x6f, 0x6c, 0x64, 0x4e, 0x69,
+	0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x2f, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x6f, 0x6c, 0x64, 0x2d, 0x6e,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0xa5, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
+	0x72, 0x12, 0x30, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x2f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x42, 0x3d, 0x5a,
+	0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x65, 0x6d, 0x68,
+	0x6f, 0x2f, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x2d, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x5f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_booking_booking_proto_rawDescOnce sync.Once
+	file_booking_booking_proto_rawDescData = file_booking_booking_proto_rawDesc
+)
+
+func file_booking_booking_proto_rawDescGZIP() []byte {
+	file_booking_booking_proto_rawDescOnce.Do(func() {
+		file_booking_booking_proto_rawDescData = protoimpl.X.CompressGZIP(file_booking_booking_proto_rawDescData)
+	})
+	return file_booking_booking_proto_rawDescData
+}
+
+var file_booking_booking_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_booking_booking_proto_msgTypes = make([]protoimpl.MessageInfo, 79)
+var file_booking_booking_proto_goTypes = []interface{}{
+	(BookingStatus)(0),                      // 0: hotel.booking.v1.BookingStatus
+	(PromoDiscountType)(0),                  // 1: hotel.booking.v1.PromoDiscountType
+	(AvailabilityStatus)(0),                 // 2: hotel.booking.v1.AvailabilityStatus
+	(CalendarGrouping)(0),                   // 3: hotel.booking.v1.CalendarGrouping
+	(WaitlistStatus)(0),                     // 4: hotel.booking.v1.WaitlistStatus
+	(*GetAvailableRoomsRequest)(nil),        // 5: hotel.booking.v1.GetAvailableRoomsRequest
+	(*GetAvailableRoomsResponse)(nil),       // 6: hotel.booking.v1.GetAvailableRoomsResponse
+	(*CreateBookingRequest)(nil),            // 7: hotel.booking.v1.CreateBookingRequest
+	(*CreateBookingResponse)(nil),           // 8: hotel.booking.v1.CreateBookingResponse
+	(*UpdateBookingStatusRequest)(nil),      // 9: hotel.booking.v1.UpdateBookingStatusRequest
+	(*UpdateBookingStatusResponse)(nil),     // 10: hotel.booking.v1.UpdateBookingStatusResponse
+	(*GetBookingRequest)(nil),               // 11: hotel.booking.v1.GetBookingRequest
+	(*GetBookingResponse)(nil),              // 12: hotel.booking.v1.GetBookingResponse
+	(*ListBookingsRequest)(nil),             // 13: hotel.booking.v1.ListBookingsRequest
+	(*ListBookingsResponse)(nil),            // 14: hotel.booking.v1.ListBookingsResponse
+	(*GetBookingHistoryRequest)(nil),        // 15: hotel.booking.v1.GetBookingHistoryRequest
+	(*GetBookingHistoryResponse)(nil),       // 16: hotel.booking.v1.GetBookingHistoryResponse
+	(*BookingStatusChange)(nil),             // 17: hotel.booking.v1.BookingStatusChange
+	(*Booking)(nil),                         // 18: hotel.booking.v1.Booking
+	(*PriceAdjustment)(nil),                 // 19: hotel.booking.v1.PriceAdjustment
+	(*NightPrice)(nil),                      // 20: hotel.booking.v1.NightPrice
+	(*RunNightlyTransitionsRequest)(nil),    // 21: hotel.booking.v1.RunNightlyTransitionsRequest
+	(*RunNightlyTransitionsResponse)(nil),   // 22: hotel.booking.v1.RunNightlyTransitionsResponse
+	(*BookingStatusTransition)(nil),         // 23: hotel.booking.v1.BookingStatusTransition
+	(*CheckInRequest)(nil),                  // 24: hotel.booking.v1.CheckInRequest
+	(*CheckInResponse)(nil),                 // 25: hotel.booking.v1.CheckInResponse
+	(*CheckOutRequest)(nil),                 // 26: hotel.booking.v1.CheckOutRequest
+	(*CheckOutResponse)(nil),                // 27: hotel.booking.v1.CheckOutResponse
+	(*AssignRoomRequest)(nil),               // 28: hotel.booking.v1.AssignRoomRequest
+	(*AssignRoomResponse)(nil),              // 29: hotel.booking.v1.AssignRoomResponse
+	(*ModifyBookingRequest)(nil),            // 30: hotel.booking.v1.ModifyBookingRequest
+	(*ModifyBookingResponse)(nil),           // 31: hotel.booking.v1.ModifyBookingResponse
+	(*CancellationQuote)(nil),               // 32: hotel.booking.v1.CancellationQuote
+	(*GetCancellationQuoteRequest)(nil),     // 33: hotel.booking.v1.GetCancellationQuoteRequest
+	(*GetCancellationQuoteResponse)(nil),    // 34: hotel.booking.v1.GetCancellationQuoteResponse
+	(*CancelBookingRequest)(nil),            // 35: hotel.booking.v1.CancelBookingRequest
+	(*CancelBookingResponse)(nil),           // 36: hotel.booking.v1.CancelBookingResponse
+	(*ReservationRoomRequest)(nil),          // 37: hotel.booking.v1.ReservationRoomRequest
+	(*CreateReservationRequest)(nil),        // 38: hotel.booking.v1.CreateReservationRequest
+	(*CreateReservationResponse)(nil),       // 39: hotel.booking.v1.CreateReservationResponse
+	(*GetReservationRequest)(nil),           // 40: hotel.booking.v1.GetReservationRequest
+	(*GetReservationResponse)(nil),          // 41: hotel.booking.v1.GetReservationResponse
+	(*Reservation)(nil),                     // 42: hotel.booking.v1.Reservation
+	(*PromoCode)(nil),                       // 43: hotel.booking.v1.PromoCode
+	(*CreatePromoCodeRequest)(nil),          // 44: hotel.booking.v1.CreatePromoCodeRequest
+	(*GetPromoCodeRequest)(nil),             // 45: hotel.booking.v1.GetPromoCodeRequest
+	(*ListPromoCodesRequest)(nil),           // 46: hotel.booking.v1.ListPromoCodesRequest
+	(*ListPromoCodesResponse)(nil),          // 47: hotel.booking.v1.ListPromoCodesResponse
+	(*UpdatePromoCodeRequest)(nil),          // 48: hotel.booking.v1.UpdatePromoCodeRequest
+	(*PromoCodeResponse)(nil),               // 49: hotel.booking.v1.PromoCodeResponse
+	(*DeletePromoCodeRequest)(nil),          // 50: hotel.booking.v1.DeletePromoCodeRequest
+	(*DeletePromoCodeResponse)(nil),         // 51: hotel.booking.v1.DeletePromoCodeResponse
+	(*StayRestriction)(nil),                 // 52: hotel.booking.v1.StayRestriction
+	(*CreateStayRestrictionRequest)(nil),    // 53: hotel.booking.v1.CreateStayRestrictionRequest
+	(*GetStayRestrictionRequest)(nil),       // 54: hotel.booking.v1.GetStayRestrictionRequest
+	(*ListStayRestrictionsRequest)(nil),     // 55: hotel.booking.v1.ListStayRestrictionsRequest
+	(*ListStayRestrictionsResponse)(nil),    // 56: hotel.booking.v1.ListStayRestrictionsResponse
+	(*UpdateStayRestrictionRequest)(nil),    // 57: hotel.booking.v1.UpdateStayRestrictionRequest
+	(*StayRestrictionResponse)(nil),         // 58: hotel.booking.v1.StayRestrictionResponse
+	(*DeleteStayRestrictionRequest)(nil),    // 59: hotel.booking.v1.DeleteStayRestrictionRequest
+	(*DeleteStayRestrictionResponse)(nil),   // 60: hotel.booking.v1.DeleteStayRestrictionResponse
+	(*GuestProfile)(nil),                    // 61: hotel.booking.v1.GuestProfile
+	(*GetGuestProfileRequest)(nil),          // 62: hotel.booking.v1.GetGuestProfileRequest
+	(*SearchGuestProfilesRequest)(nil),      // 63: hotel.booking.v1.SearchGuestProfilesRequest
+	(*SearchGuestProfilesResponse)(nil),     // 64: hotel.booking.v1.SearchGuestProfilesResponse
+	(*UpdateGuestProfileRequest)(nil),       // 65: hotel.booking.v1.UpdateGuestProfileRequest
+	(*MergeGuestProfilesRequest)(nil),       // 66: hotel.booking.v1.MergeGuestProfilesRequest
+	(*GuestProfileResponse)(nil),            // 67: hotel.booking.v1.GuestProfileResponse
+	(*ClaimGuestBookingsRequest)(nil),       // 68: hotel.booking.v1.ClaimGuestBookingsRequest
+	(*ClaimGuestBookingsResponse)(nil),      // 69: hotel.booking.v1.ClaimGuestBookingsResponse
+	(*WaitlistEntry)(nil),                   // 70: hotel.booking.v1.WaitlistEntry
+	(*JoinWaitlistRequest)(nil),             // 71: hotel.booking.v1.JoinWaitlistRequest
+	(*WaitlistEntryResponse)(nil),           // 72: hotel.booking.v1.WaitlistEntryResponse
+	(*GetWaitlistEntryRequest)(nil),         // 73: hotel.booking.v1.GetWaitlistEntryRequest
+	(*ListWaitlistEntriesRequest)(nil),      // 74: hotel.booking.v1.ListWaitlistEntriesRequest
+	(*ListWaitlistEntriesResponse)(nil),     // 75: hotel.booking.v1.ListWaitlistEntriesResponse
+	(*LeaveWaitlistRequest)(nil),            // 76: hotel.booking.v1.LeaveWaitlistRequest
+	(*GetOversoldNightsRequest)(nil),        // 77: hotel.booking.v1.GetOversoldNightsRequest
+	(*OversoldNight)(nil),                   // 78: hotel.booking.v1.OversoldNight
+	(*GetOversoldNightsResponse)(nil),       // 79: hotel.booking.v1.GetOversoldNightsResponse
+	(*GetAvailabilityCalendarRequest)(nil),  // 80: hotel.booking.v1.GetAvailabilityCalendarRequest
+	(*AvailabilityCalendarNight)(nil),       // 81: hotel.booking.v1.AvailabilityCalendarNight
+	(*AvailabilityCalendarRow)(nil),         // 82: hotel.booking.v1.AvailabilityCalendarRow
+	(*GetAvailabilityCalendarResponse)(nil), // 83: hotel.booking.v1.GetAvailabilityCalendarResponse
+	(*timestamppb.Timestamp)(nil),           // 84: google.protobuf.Timestamp
+	(room.RoomType)(0),                      // 85: hotel.room.v1.RoomType
+	(*room.Room)(nil),                       // 86: hotel.room.v1.Room
+	(*money.Money)(nil),                     // 87: hotel.money.v1.Money
+}
+var file_booking_booking_proto_depIdxs = []int32{
+	84,  // 0: hotel.booking.v1.GetAvailableRoomsRequest.check_in:type_name -> google.protobuf.Timestamp
+	84,  // 1: hotel.booking.v1.GetAvailableRoomsRequest.check_out:type_name -> google.protobuf.Timestamp
+	85,  // 2: hotel.booking.v1.GetAvailableRoomsRequest.type:type_name -> hotel.room.v1.RoomType
+	86,  // 3: hotel.booking.v1.GetAvailableRoomsResponse.rooms:type_name -> hotel.room.v1.Room
+	84,  // 4: hotel.booking.v1.CreateBookingRequest.check_in:type_name -> google.protobuf.Timestamp
+	84,  // 5: hotel.booking.v1.CreateBookingRequest.check_out:type_name -> google.protobuf.Timestamp
+	85,  // 6: hotel.booking.v1.CreateBookingRequest.type:type_name -> hotel.room.v1.RoomType
+	18,  // 7: hotel.booking.v1.CreateBookingResponse.booking:type_name -> hotel.booking.v1.Booking
+	0,   // 8: hotel.booking.v1.UpdateBookingStatusRequest.status:type_name -> hotel.booking.v1.BookingStatus
+	18,  // 9: hotel.booking.v1.UpdateBookingStatusResponse.booking:type_name -> hotel.booking.v1.Booking
+	18,  // 10: hotel.booking.v1.GetBookingResponse.booking:type_name -> hotel.booking.v1.Booking
+	0,   // 11: hotel.booking.v1.ListBookingsRequest.status:type_name -> hotel.booking.v1.BookingStatus
+	84,  // 12: hotel.booking.v1.ListBookingsRequest.from:type_name -> google.protobuf.Timestamp
+	84,  // 13: hotel.booking.v1.ListBookingsRequest.to:type_name -> google.protobuf.Timestamp
+	18,  // 14: hotel.booking.v1.ListBookingsResponse.bookings:type_name -> hotel.booking.v1.Booking
+	17,  // 15: hotel.booking.v1.GetBookingHistoryResponse.history:type_name -> hotel.booking.v1.BookingStatusChange
+	0,   // 16: hotel.booking.v1.BookingStatusChange.status:type_name -> hotel.booking.v1.BookingStatus
+	84,  // 17: hotel.booking.v1.BookingStatusChange.changed_at:type_name -> google.protobuf.Timestamp
+	84,  // 18: hotel.booking.v1.Booking.check_in:type_name -> google.protobuf.Timestamp
+	84,  // 19: hotel.booking.v1.Booking.check_out:type_name -> google.protobuf.Timestamp
+	87,  // 20: hotel.booking.v1.Booking.total_price:type_name -> hotel.money.v1.Money
+	84,  // 21: hotel.booking.v1.Booking.created_at:type_name -> google.protobuf.Timestamp
+	0,   // 22: hotel.booking.v1.Booking.current_status:type_name -> hotel.booking.v1.BookingStatus
+	0,   // 23: hotel.booking.v1.Booking.allowed_transitions:type_name -> hotel.booking.v1.BookingStatus
+	84,  // 24: hotel.booking.v1.Booking.hold_expires_at:type_name -> google.protobuf.Timestamp
+	84,  // 25: hotel.booking.v1.Booking.checked_in_at:type_name -> google.protobuf.Timestamp
+	84,  // 26: hotel.booking.v1.Booking.checked_out_at:type_name -> google.protobuf.Timestamp
+	87,  // 27: hotel.booking.v1.Booking.cancellation_penalty:type_name -> hotel.money.v1.Money
+	87,  // 28: hotel.booking.v1.Booking.refund_amount:type_name -> hotel.money.v1.Money
+	87,  // 29: hotel.booking.v1.Booking.converted_total_price:type_name -> hotel.money.v1.Money
+	84,  // 30: hotel.booking.v1.Booking.cancelled_at:type_name -> google.protobuf.Timestamp
+	20,  // 31: hotel.booking.v1.Booking.price_breakdown:type_name -> hotel.booking.v1.NightPrice
+	85,  // 32: hotel.booking.v1.Booking.room_type:type_name -> hotel.room.v1.RoomType
+	87,  // 33: hotel.booking.v1.PriceAdjustment.amount:type_name -> hotel.money.v1.Money
+	84,  // 34: hotel.booking.v1.NightPrice.date:type_name -> google.protobuf.Timestamp
+	87,  // 35: hotel.booking.v1.NightPrice.base_price:type_name -> hotel.money.v1.Money
+	87,  // 36: hotel.booking.v1.NightPrice.price:type_name -> hotel.money.v1.Money
+	19,  // 37: hotel.booking.v1.NightPrice.adjustments:type_name -> hotel.booking.v1.PriceAdjustment
+	84,  // 38: hotel.booking.v1.RunNightlyTransitionsRequest.as_of:type_name -> google.protobuf.Timestamp
+	23,  // 39: hotel.booking.v1.RunNightlyTransitionsResponse.transitions:type_name -> hotel.booking.v1.BookingStatusTransition
+	0,   // 40: hotel.booking.v1.BookingStatusTransition.from:type_name -> hotel.booking.v1.BookingStatus
+	0,   // 41: hotel.booking.v1.BookingStatusTransition.to:type_name -> hotel.booking.v1.BookingStatus
+	18,  // 42: hotel.booking.v1.CheckInResponse.booking:type_name -> hotel.booking.v1.Booking
+	18,  // 43: hotel.booking.v1.CheckOutResponse.booking:type_name -> hotel.booking.v1.Booking
+	18,  // 44: hotel.booking.v1.AssignRoomResponse.booking:type_name -> hotel.booking.v1.Booking
+	84,  // 45: hotel.booking.v1.ModifyBookingRequest.check_in:type_name -> google.protobuf.Timestamp
+	84,  // 46: hotel.booking.v1.ModifyBookingRequest.check_out:type_name -> google.protobuf.Timestamp
+	85,  // 47: hotel.booking.v1.ModifyBookingRequest.type:type_name -> hotel.room.v1.RoomType
+	18,  // 48: hotel.booking.v1.ModifyBookingResponse.booking:type_name -> hotel.booking.v1.Booking
+	87,  // 49: hotel.booking.v1.CancellationQuote.total_price:type_name -> hotel.money.v1.Money
+	87,  // 50: hotel.booking.v1.CancellationQuote.penalty:type_name -> hotel.money.v1.Money
+	87,  // 51: hotel.booking.v1.CancellationQuote.refund:type_name -> hotel.money.v1.Money
+	84,  // 52: hotel.booking.v1.CancellationQuote.free_cancellation_until:type_name -> google.protobuf.Timestamp
+	32,  // 53: hotel.booking.v1.GetCancellationQuoteResponse.quote:type_name -> hotel.booking.v1.CancellationQuote
+	18,  // 54: hotel.booking.v1.CancelBookingResponse.booking:type_name -> hotel.booking.v1.Booking
+	32,  // 55: hotel.booking.v1.CancelBookingResponse.quote:type_name -> hotel.booking.v1.CancellationQuote
+	85,  // 56: hotel.booking.v1.ReservationRoomRequest.type:type_name -> hotel.room.v1.RoomType
+	84,  // 57: hotel.booking.v1.CreateReservationRequest.check_in:type_name -> google.protobuf.Timestamp
+	84,  // 58: hotel.booking.v1.CreateReservationRequest.check_out:type_name -> google.protobuf.Timestamp
+	37,  // 59: hotel.booking.v1.CreateReservationRequest.rooms:type_name -> hotel.booking.v1.ReservationRoomRequest
+	42,  // 60: hotel.booking.v1.CreateReservationResponse.reservation:type_name -> hotel.booking.v1.Reservation
+	42,  // 61: hotel.booking.v1.GetReservationResponse.reservation:type_name -> hotel.booking.v1.Reservation
+	84,  // 62: hotel.booking.v1.Reservation.created_at:type_name -> google.protobuf.Timestamp
+	18,  // 63: hotel.booking.v1.Reservation.bookings:type_name -> hotel.booking.v1.Booking
+	87,  // 64: hotel.booking.v1.Reservation.total_price:type_name -> hotel.money.v1.Money
+	1,   // 65: hotel.booking.v1.PromoCode.discount_type:type_name -> hotel.booking.v1.PromoDiscountType
+	87,  // 66: hotel.booking.v1.PromoCode.discount_amount:type_name -> hotel.money.v1.Money
+	84,  // 67: hotel.booking.v1.PromoCode.valid_from:type_name -> google.protobuf.Timestamp
+	84,  // 68: hotel.booking.v1.PromoCode.valid_to:type_name -> google.protobuf.Timestamp
+	85,  // 69: hotel.booking.v1.PromoCode.room_types:type_name -> hotel.room.v1.RoomType
+	84,  // 70: hotel.booking.v1.PromoCode.created_at:type_name -> google.protobuf.Timestamp
+	84,  // 71: hotel.booking.v1.PromoCode.updated_at:type_name -> google.protobuf.Timestamp
+	1,   // 72: hotel.booking.v1.CreatePromoCodeRequest.discount_type:type_name -> hotel.booking.v1.PromoDiscountType
+	87,  // 73: hotel.booking.v1.CreatePromoCodeRequest.discount_amount:type_name -> hotel.money.v1.Money
+	84,  // 74: hotel.booking.v1.CreatePromoCodeRequest.valid_from:type_name -> google.protobuf.Timestamp
+	84,  // 75: hotel.booking.v1.CreatePromoCodeRequest.valid_to:type_name -> google.protobuf.Timestamp
+	85,  // 76: hotel.booking.v1.CreatePromoCodeRequest.room_types:type_name -> hotel.room.v1.RoomType
+	43,  // 77: hotel.booking.v1.ListPromoCodesResponse.promo_codes:type_name -> hotel.booking.v1.PromoCode
+	1,   // 78: hotel.booking.v1.UpdatePromoCodeRequest.discount_type:type_name -> hotel.booking.v1.PromoDiscountType
+	87,  // 79: hotel.booking.v1.UpdatePromoCodeRequest.discount_amount:type_name -> hotel.money.v1.Money
+	84,  // 80: hotel.booking.v1.UpdatePromoCodeRequest.valid_from:type_name -> google.protobuf.Timestamp
+	84,  // 81: hotel.booking.v1.UpdatePromoCodeRequest.valid_to:type_name -> google.protobuf.Timestamp
+	85,  // 82: hotel.booking.v1.UpdatePromoCodeRequest.room_types:type_name -> hotel.room.v1.RoomType
+	43,  // 83: hotel.booking.v1.PromoCodeResponse.promo_code:type_name -> hotel.booking.v1.PromoCode
+	84,  // 84: hotel.booking.v1.StayRestriction.date_from:type_name -> google.protobuf.Timestamp
+	84,  // 85: hotel.booking.v1.StayRestriction.date_to:type_name -> google.protobuf.Timestamp
+	85,  // 86: hotel.booking.v1.StayRestriction.room_type:type_name -> hotel.room.v1.RoomType
+	84,  // 87: hotel.booking.v1.StayRestriction.created_at:type_name -> google.protobuf.Timestamp
+	84,  // 88: hotel.booking.v1.StayRestriction.updated_at:type_name -> google.protobuf.Timestamp
+	84,  // 89: hotel.booking.v1.CreateStayRestrictionRequest.date_from:type_name -> google.protobuf.Timestamp
+	84,  // 90: hotel.booking.v1.CreateStayRestrictionRequest.date_to:type_name -> google.protobuf.Timestamp
+	85,  // 91: hotel.booking.v1.CreateStayRestrictionRequest.room_type:type_name -> hotel.room.v1.RoomType
+	84,  // 92: hotel.booking.v1.ListStayRestrictionsRequest.from:type_name -> google.protobuf.Timestamp
+	84,  // 93: hotel.booking.v1.ListStayRestrictionsRequest.to:type_name -> google.protobuf.Timestamp
+	85,  // 94: hotel.booking.v1.ListStayRestrictionsRequest.room_type:type_name -> hotel.room.v1.RoomType
+	52,  // 95: hotel.booking.v1.ListStayRestrictionsResponse.restrictions:type_name -> hotel.booking.v1.StayRestriction
+	84,  // 96: hotel.booking.v1.UpdateStayRestrictionRequest.date_from:type_name -> google.protobuf.Timestamp
+	84,  // 97: hotel.booking.v1.UpdateStayRestrictionRequest.date_to:type_name -> google.protobuf.Timestamp
+	85,  // 98: hotel.booking.v1.UpdateStayRestrictionRequest.room_type:type_name -> hotel.room.v1.RoomType
+	52,  // 99: hotel.booking.v1.StayRestrictionResponse.restriction:type_name -> hotel.booking.v1.StayRestriction
+	87,  // 100: hotel.booking.v1.GuestProfile.lifetime_value:type_name -> hotel.money.v1.Money
+	84,  // 101: hotel.booking.v1.GuestProfile.last_stay_at:type_name -> google.protobuf.Timestamp
+	84,  // 102: hotel.booking.v1.GuestProfile.created_at:type_name -> google.protobuf.Timestamp
+	84,  // 103: hotel.booking.v1.GuestProfile.updated_at:type_name -> google.protobuf.Timestamp
+	61,  // 104: hotel.booking.v1.SearchGuestProfilesResponse.profiles:type_name -> hotel.booking.v1.GuestProfile
+	61,  // 105: hotel.booking.v1.GuestProfileResponse.profile:type_name -> hotel.booking.v1.GuestProfile
+	84,  // 106: hotel.booking.v1.WaitlistEntry.check_in:type_name -> google.protobuf.Timestamp
+	84,  // 107: hotel.booking.v1.WaitlistEntry.check_out:type_name -> google.protobuf.Timestamp
+	85,  // 108: hotel.booking.v1.WaitlistEntry.type:type_name -> hotel.room.v1.RoomType
+	4,   // 109: hotel.booking.v1.WaitlistEntry.status:type_name -> hotel.booking.v1.WaitlistStatus
+	84,  // 110: hotel.booking.v1.WaitlistEntry.offer_expires_at:type_name -> google.protobuf.Timestamp
+	84,  // 111: hotel.booking.v1.WaitlistEntry.created_at:type_name -> google.protobuf.Timestamp
+	84,  // 112: hotel.booking.v1.WaitlistEntry.updated_at:type_name -> google.protobuf.Timestamp
+	84,  // 113: hotel.booking.v1.JoinWaitlistRequest.check_in:type_name -> google.protobuf.Timestamp
+	84,  // 114: hotel.booking.v1.JoinWaitlistRequest.check_out:type_name -> google.protobuf.Timestamp
+	85,  // 115: hotel.booking.v1.JoinWaitlistRequest.type:type_name -> hotel.room.v1.RoomType
+	70,  // 116: hotel.booking.v1.WaitlistEntryResponse.entry:type_name -> hotel.booking.v1.WaitlistEntry
+	4,   // 117: hotel.booking.v1.ListWaitlistEntriesRequest.status:type_name -> hotel.booking.v1.WaitlistStatus
+	70,  // 118: hotel.booking.v1.ListWaitlistEntriesResponse.entries:type_name -> hotel.booking.v1.WaitlistEntry
+	84,  // 119: hotel.booking.v1.GetOversoldNightsRequest.from:type_name -> google.protobuf.Timestamp
+	84,  // 120: hotel.booking.v1.GetOversoldNightsRequest.to:type_name -> google.protobuf.Timestamp
+	84,  // 121: hotel.booking.v1.OversoldNight.night:type_name -> google.protobuf.Timestamp
+	85,  // 122: hotel.booking.v1.OversoldNight.room_type:type_name -> hotel.room.v1.RoomType
+	78,  // 123: hotel.booking.v1.GetOversoldNightsResponse.nights:type_name -> hotel.booking.v1.OversoldNight
+	84,  // 124: hotel.booking.v1.GetAvailabilityCalendarRequest.from:type_name -> google.protobuf.Timestamp
+	84,  // 125: hotel.booking.v1.GetAvailabilityCalendarRequest.to:type_name -> google.protobuf.Timestamp
+	3,   // 126: hotel.booking.v1.GetAvailabilityCalendarRequest.group_by:type_name -> hotel.booking.v1.CalendarGrouping
+	85,  // 127: hotel.booking.v1.GetAvailabilityCalendarRequest.room_type:type_name -> hotel.room.v1.RoomType
+	84,  // 128: hotel.booking.v1.AvailabilityCalendarNight.night:type_name -> google.protobuf.Timestamp
+	2,   // 129: hotel.booking.v1.AvailabilityCalendarNight.status:type_name -> hotel.booking.v1.AvailabilityStatus
+	85,  // 130: hotel.booking.v1.AvailabilityCalendarRow.room_type:type_name -> hotel.room.v1.RoomType
+	81,  // 131: hotel.booking.v1.AvailabilityCalendarRow.nights:type_name -> hotel.booking.v1.AvailabilityCalendarNight
+	82,  // 132: hotel.booking.v1.GetAvailabilityCalendarResponse.rows:type_name -> hotel.booking.v1.AvailabilityCalendarRow
+	5,   // 133: hotel.booking.v1.BookingService.GetAvailableRooms:input_type -> hotel.booking.v1.GetAvailableRoomsRequest
+	7,   // 134: hotel.booking.v1.BookingService.CreateBooking:input_type -> hotel.booking.v1.CreateBookingRequest
+	9,   // 135: hotel.booking.v1.BookingService.UpdateBookingStatus:input_type -> hotel.booking.v1.UpdateBookingStatusRequest
+	11,  // 136: hotel.booking.v1.BookingService.GetBooking:input_type -> hotel.booking.v1.GetBookingRequest
+	13,  // 137: hotel.booking.v1.BookingService.ListBookings:input_type -> hotel.booking.v1.ListBookingsRequest
+	15,  // 138: hotel.booking.v1.BookingService.GetBookingHistory:input_type -> hotel.booking.v1.GetBookingHistoryRequest
+	38,  // 139: hotel.booking.v1.BookingService.CreateReservation:input_type -> hotel.booking.v1.CreateReservationRequest
+	40,  // 140: hotel.booking.v1.BookingService.GetReservation:input_type -> hotel.booking.v1.GetReservationRequest
+	30,  // 141: hotel.booking.v1.BookingService.ModifyBooking:input_type -> hotel.booking.v1.ModifyBookingRequest
+	33,  // 142: hotel.booking.v1.BookingService.GetCancellationQuote:input_type -> hotel.booking.v1.GetCancellationQuoteRequest
+	35,  // 143: hotel.booking.v1.BookingService.CancelBooking:input_type -> hotel.booking.v1.CancelBookingRequest
+	24,  // 144: hotel.booking.v1.BookingService.CheckIn:input_type -> hotel.booking.v1.CheckInRequest
+	26,  // 145: hotel.booking.v1.BookingService.CheckOut:input_type -> hotel.booking.v1.CheckOutRequest
+	28,  // 146: hotel.booking.v1.BookingService.AssignRoom:input_type -> hotel.booking.v1.AssignRoomRequest
+	21,  // 147: hotel.booking.v1.BookingService.RunNightlyTransitions:input_type -> hotel.booking.v1.RunNightlyTransitionsRequest
+	44,  // 148: hotel.booking.v1.BookingService.CreatePromoCode:input_type -> hotel.booking.v1.CreatePromoCodeRequest
+	45,  // 149: hotel.booking.v1.BookingService.GetPromoCode:input_type -> hotel.booking.v1.GetPromoCodeRequest
+	46,  // 150: hotel.booking.v1.BookingService.ListPromoCodes:input_type -> hotel.booking.v1.ListPromoCodesRequest
+	48,  // 151: hotel.booking.v1.BookingService.UpdatePromoCode:input_type -> hotel.booking.v1.UpdatePromoCodeRequest
+	50,  // 152: hotel.booking.v1.BookingService.DeletePromoCode:input_type -> hotel.booking.v1.DeletePromoCodeRequest
+	53,  // 153: hotel.booking.v1.BookingService.CreateStayRestriction:input_type -> hotel.booking.v1.CreateStayRestrictionRequest
+	54,  // 154: hotel.booking.v1.BookingService.GetStayRestriction:input_type -> hotel.booking.v1.GetStayRestrictionRequest
+	55,  // 155: hotel.booking.v1.BookingService.ListStayRestrictions:input_type -> hotel.booking.v1.ListStayRestrictionsRequest
+	57,  // 156: hotel.booking.v1.BookingService.UpdateStayRestriction:input_type -> hotel.booking.v1.UpdateStayRestrictionRequest
+	59,  // 157: hotel.booking.v1.BookingService.DeleteStayRestriction:input_type -> hotel.booking.v1.DeleteStayRestrictionRequest
+	62,  // 158: hotel.booking.v1.BookingService.GetGuestProfile:input_type -> hotel.booking.v1.GetGuestProfileRequest
+	63,  // 159: hotel.booking.v1.BookingService.SearchGuestProfiles:input_type -> hotel.booking.v1.SearchGuestProfilesRequest
+	65,  // 160: hotel.booking.v1.BookingService.UpdateGuestProfile:input_type -> hotel.booking.v1.UpdateGuestProfileRequest
+	66,  // 161: hotel.booking.v1.BookingService.MergeGuestProfiles:input_type -> hotel.booking.v1.MergeGuestProfilesRequest
+	68,  // 162: hotel.booking.v1.BookingService.ClaimGuestBookings:input_type -> hotel.booking.v1.ClaimGuestBookingsRequest
+	71,  // 163: hotel.booking.v1.BookingService.JoinWaitlist:input_type -> hotel.booking.v1.JoinWaitlistRequest
+	73,  // 164: hotel.booking.v1.BookingService.GetWaitlistEntry:input_type -> hotel.booking.v1.GetWaitlistEntryRequest
+	74,  // 165: hotel.booking.v1.BookingService.ListWaitlistEntries:input_type -> hotel.booking.v1.ListWaitlistEntriesRequest
+	76,  // 166: hotel.booking.v1.BookingService.LeaveWaitlist:input_type -> hotel.booking.v1.LeaveWaitlistRequest
+	77,  // 167: hotel.booking.v1.BookingService.GetOversoldNights:input_type -> hotel.booking.v1.GetOversoldNightsRequest
+	80,  // 168: hotel.booking.v1.BookingService.GetAvailabilityCalendar:input_type -> hotel.booking.v1.GetAvailabilityCalendarRequest
+	6,   // 169: hotel.booking.v1.BookingService.GetAvailableRooms:output_type -> hotel.booking.v1.GetAvailableRoomsResponse
+	8,   // 170: hotel.booking.v1.BookingService.CreateBooking:output_type -> hotel.booking.v1.CreateBookingResponse
+	10,  // 171: hotel.booking.v1.BookingService.UpdateBookingStatus:output_type -> hotel.booking.v1.UpdateBookingStatusResponse
+	12,  // 172: hotel.booking.v1.BookingService.GetBooking:output_type -> hotel.booking.v1.GetBookingResponse
+	14,  // 173: hotel.booking.v1.BookingService.ListBookings:output_type -> hotel.booking.v1.ListBookingsResponse
+	16,  // 174: hotel.booking.v1.BookingService.GetBookingHistory:output_type -> hotel.booking.v1.GetBookingHistoryResponse
+	39,  // 175: hotel.booking.v1.BookingService.CreateReservation:output_type -> hotel.booking.v1.CreateReservationResponse
+	41,  // 176: hotel.booking.v1.BookingService.GetReservation:output_type -> hotel.booking.v1.GetReservationResponse
+	31,  // 177: hotel.booking.v1.BookingService.ModifyBooking:output_type -> hotel.booking.v1.ModifyBookingResponse
+	34,  // 178: hotel.booking.v1.BookingService.GetCancellationQuote:output_type -> hotel.booking.v1.GetCancellationQuoteResponse
+	36,  // 179: hotel.booking.v1.BookingService.CancelBooking:output_type -> hotel.booking.v1.CancelBookingResponse
+	25,  // 180: hotel.booking.v1.BookingService.CheckIn:output_type -> hotel.booking.v1.CheckInResponse
+	27,  // 181: hotel.booking.v1.BookingService.CheckOut:output_type -> hotel.booking.v1.CheckOutResponse
+	29,  // 182: hotel.booking.v1.BookingService.AssignRoom:output_type -> hotel.booking.v1.AssignRoomResponse
+	22,  // 183: hotel.booking.v1.BookingService.RunNightlyTransitions:output_type -> hotel.booking.v1.RunNightlyTransitionsResponse
+	49,  // 184: hotel.booking.v1.BookingService.CreatePromoCode:output_type -> hotel.booking.v1.PromoCodeResponse
+	49,  // 185: hotel.booking.v1.BookingService.GetPromoCode:output_type -> hotel.booking.v1.PromoCodeResponse
+	47,  // 186: hotel.booking.v1.BookingService.ListPromoCodes:output_type -> hotel.booking.v1.ListPromoCodesResponse
+	49,  // 187: hotel.booking.v1.BookingService.UpdatePromoCode:output_type -> hotel.booking.v1.PromoCodeResponse
+	51,  // 188: hotel.booking.v1.BookingService.DeletePromoCode:output_type -> hotel.booking.v1.DeletePromoCodeResponse
+	58,  // 189: hotel.booking.v1.BookingService.CreateStayRestriction:output_type -> hotel.booking.v1.StayRestrictionResponse
+	58,  // 190: hotel.booking.v1.BookingService.GetStayRestriction:output_type -> hotel.booking.v1.StayRestrictionResponse
+	56,  // 191: hotel.booking.v1.BookingService.ListStayRestrictions:output_type -> hotel.booking.v1.ListStayRestrictionsResponse
+	58,  // 192: hotel.booking.v1.BookingService.UpdateStayRestriction:output_type -> hotel.booking.v1.StayRestrictionResponse
+	60,  // 193: hotel.booking.v1.BookingService.DeleteStayRestriction:output_type -> hotel.booking.v1.DeleteStayRestrictionResponse
+	67,  // 194: hotel.booking.v1.BookingService.GetGuestProfile:output_type -> hotel.booking.v1.GuestProfileResponse
+	64,  // 195: hotel.booking.v1.BookingService.SearchGuestProfiles:output_type -> hotel.booking.v1.SearchGuestProfilesResponse
+	67,  // 196: hotel.booking.v1.BookingService.UpdateGuestProfile:output_type -> hotel.booking.v1.GuestProfileResponse
+	67,  // 197: hotel.booking.v1.BookingService.MergeGuestProfiles:output_type -> hotel.booking.v1.GuestProfileResponse
+	69,  // 198: hotel.booking.v1.BookingService.ClaimGuestBookings:output_type -> hotel.booking.v1.ClaimGuestBookingsResponse
+	72,  // 199: hotel.booking.v1.BookingService.JoinWaitlist:output_type -> hotel.booking.v1.WaitlistEntryResponse
+	72,  // 200: hotel.booking.v1.BookingService.GetWaitlistEntry:output_type -> hotel.booking.v1.WaitlistEntryResponse
+	75,  // 201: hotel.booking.v1.BookingService.ListWaitlistEntries:output_type -> hotel.booking.v1.ListWaitlistEntriesResponse
+	72,  // 202: hotel.booking.v1.BookingService.LeaveWaitlist:output_type -> hotel.booking.v1.WaitlistEntryResponse
+	79,  // 203: hotel.booking.v1.BookingService.GetOversoldNights:output_type -> hotel.booking.v1.GetOversoldNightsResponse
+	83,  // 204: hotel.booking.v1.BookingService.GetAvailabilityCalendar:output_type -> hotel.booking.v1.GetAvailabilityCalendarResponse
+	169, // [169:205] is the sub-list for method output_type
+	133, // [133:169] is the sub-list for method input_type
+	133, // [133:133] is the sub-list for extension type_name
+	133, // [133:133] is the sub-list for extension extendee
+	0,   // [0:133] is the sub-list for field type_name
+}
+
+func init() { file_booking_booking_proto_init() }
+func file_booking_booking_proto_init() {
+	if File_booking_booking_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_booking_booking_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAvailableRoomsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAvailableRoomsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBookingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBookingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBookingStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBookingStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBookingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBookingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBookingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBookingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBookingHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBookingHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BookingStatusChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Booking); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceAdjustment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NightPrice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunNightlyTransitionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunNightlyTransitionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BookingStatusTransition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckInRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckInResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckOutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckOutResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssignRoomRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssignRoomResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModifyBookingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModifyBookingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancellationQuote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCancellationQuoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCancellationQuoteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelBookingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelBookingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReservationRoomRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateReservationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateReservationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReservationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReservationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Reservation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromoCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePromoCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPromoCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPromoCodesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPromoCodesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePromoCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromoCodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePromoCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePromoCodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StayRestriction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateStayRestrictionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -821,8 +8184,8 @@ func file_booking_booking_proto_init() {
 				return nil
 			}
 		}
-		file_booking_booking_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAvailableRoomsResponse); i {
+		file_booking_booking_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStayRestrictionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -833,8 +8196,8 @@ func file_booking_booking_proto_init() {
 				return nil
 			}
 		}
-		file_booking_booking_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBookingRequest); i {
+		file_booking_booking_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStayRestrictionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -845,8 +8208,8 @@ func file_booking_booking_proto_init() {
 				return nil
 			}
 		}
-		file_booking_booking_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBookingResponse); i {
+		file_booking_booking_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStayRestrictionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -857,8 +8220,8 @@ func file_booking_booking_proto_init() {
 				return nil
 			}
 		}
-		file_booking_booking_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateBookingStatusRequest); i {
+		file_booking_booking_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateStayRestrictionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -869,8 +8232,8 @@ func file_booking_booking_proto_init() {
 				return nil
 			}
 		}
-		file_booking_booking_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateBookingStatusResponse); i {
+		file_booking_booking_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StayRestrictionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -881,8 +8244,296 @@ func file_booking_booking_proto_init() {
 				return nil
 			}
 		}
-		file_booking_booking_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Booking); i {
+		file_booking_booking_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteStayRestrictionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteStayRestrictionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GuestProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGuestProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchGuestProfilesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchGuestProfilesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateGuestProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergeGuestProfilesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GuestProfileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimGuestBookingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimGuestBookingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaitlistEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinWaitlistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaitlistEntryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWaitlistEntryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWaitlistEntriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWaitlistEntriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveWaitlistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOversoldNightsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OversoldNight); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOversoldNightsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAvailabilityCalendarRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AvailabilityCalendarNight); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AvailabilityCalendarRow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_booking_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAvailabilityCalendarResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -897,13 +8548,35 @@ func file_booking_booking_proto_init() {
 	file_booking_booking_proto_msgTypes[0].OneofWrappers = []interface{}{}
 	file_booking_booking_proto_msgTypes[2].OneofWrappers = []interface{}{}
 	file_booking_booking_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_booking_booking_proto_msgTypes[8].OneofWrappers = []interface{}{}
+	file_booking_booking_proto_msgTypes[13].OneofWrappers = []interface{}{}
+	file_booking_booking_proto_msgTypes[16].OneofWrappers = []interface{}{}
+	file_booking_booking_proto_msgTypes[19].OneofWrappers = []interface{}{}
+	file_booking_booking_proto_msgTypes[25].OneofWrappers = []interface{}{}
+	file_booking_booking_proto_msgTypes[32].OneofWrappers = []interface{}{}
+	file_booking_booking_proto_msgTypes[33].OneofWrappers = []interface{}{}
+	file_booking_booking_proto_msgTypes[37].OneofWrappers = []interface{}{}
+	file_booking_booking_proto_msgTypes[38].OneofWrappers = []interface{}{}
+	file_booking_booking_proto_msgTypes[39].OneofWrappers = []interface{}{}
+	file_booking_booking_proto_msgTypes[43].OneofWrappers = []interface{}{}
+	file_booking_booking_proto_msgTypes[47].OneofWrappers = []interface{}{}
+	file_booking_booking_proto_msgTypes[48].OneofWrappers = []interface{}{}
+	file_booking_booking_proto_msgTypes[50].OneofWrappers = []interface{}{}
+	file_booking_booking_proto_msgTypes[52].OneofWrappers = []interface{}{}
+	file_booking_booking_proto_msgTypes[65].OneofWrappers = []interface{}{}
+	file_booking_booking_proto_msgTypes[66].OneofWrappers = []interface{}{}
+	file_booking_booking_proto_msgTypes[69].OneofWrappers = []interface{}{}
+	file_booking_booking_proto_msgTypes[72].OneofWrappers = []interface{}{}
+	file_booking_booking_proto_msgTypes[75].OneofWrappers = []interface{}{}
+	file_booking_booking_proto_msgTypes[76].OneofWrappers = []interface{}{}
+	file_booking_booking_proto_msgTypes[77].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_booking_booking_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   7,
+			NumEnums:      5,
+			NumMessages:   79,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -26,6 +26,69 @@ type BookingServiceClient interface {
 	CreateBooking(ctx context.Context, in *CreateBookingRequest, opts ...grpc.CallOption) (*CreateBookingResponse, error)
 	// UpdateBookingStatus updates booking status
 	UpdateBookingStatus(ctx context.Context, in *UpdateBookingStatusRequest, opts ...grpc.CallOption) (*UpdateBookingStatusResponse, error)
+	// GetBooking returns booking with its current status
+	GetBooking(ctx context.Context, in *GetBookingRequest, opts ...grpc.CallOption) (*GetBookingResponse, error)
+	// ListBookings returns bookings page by filter, newest first
+	ListBookings(ctx context.Context, in *ListBookingsRequest, opts ...grpc.CallOption) (*ListBookingsResponse, error)
+	// GetBookingHistory returns booking status changes, newest first
+	GetBookingHistory(ctx context.Context, in *GetBookingHistoryRequest, opts ...grpc.CallOption) (*GetBookingHistoryResponse, error)
+	// CreateReservation books several rooms atomically under one confirmation code
+	CreateReservation(ctx context.Context, in *CreateReservationRequest, opts ...grpc.CallOption) (*CreateReservationResponse, error)
+	// GetReservation returns group reservation with bookings of all its rooms
+	GetReservation(ctx context.Context, in *GetReservationRequest, opts ...grpc.CallOption) (*GetReservationResponse, error)
+	// ModifyBooking changes dates, room type or guest details of an active booking
+	ModifyBooking(ctx context.Context, in *ModifyBookingRequest, opts ...grpc.CallOption) (*ModifyBookingResponse, error)
+	// GetCancellationQuote previews penalty and refund of cancelling the booking now
+	GetCancellationQuote(ctx context.Context, in *GetCancellationQuoteRequest, opts ...grpc.CallOption) (*GetCancellationQuoteResponse, error)
+	// CancelBooking cancels the booking applying the cancellation policy of the room type
+	CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...grpc.CallOption) (*CancelBookingResponse, error)
+	// CheckIn records guest arrival and the room the guest is placed in
+	CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*CheckInResponse, error)
+	// CheckOut records guest departure, completes the booking and sends the room to housekeeping
+	CheckOut(ctx context.Context, in *CheckOutRequest, opts ...grpc.CallOption) (*CheckOutResponse, error)
+	// AssignRoom assigns a concrete room to a booking before check-in, overriding the assignment job
+	AssignRoom(ctx context.Context, in *AssignRoomRequest, opts ...grpc.CallOption) (*AssignRoomResponse, error)
+	// RunNightlyTransitions marks past bookings as NO_SHOW / COMPLETED on demand.
+	// Operator-only: not exposed through the gateway, use dry_run to preview changes
+	RunNightlyTransitions(ctx context.Context, in *RunNightlyTransitionsRequest, opts ...grpc.CallOption) (*RunNightlyTransitionsResponse, error)
+	// Promo codes management (admin only)
+	CreatePromoCode(ctx context.Context, in *CreatePromoCodeRequest, opts ...grpc.CallOption) (*PromoCodeResponse, error)
+	GetPromoCode(ctx context.Context, in *GetPromoCodeRequest, opts ...grpc.CallOption) (*PromoCodeResponse, error)
+	ListPromoCodes(ctx context.Context, in *ListPromoCodesRequest, opts ...grpc.CallOption) (*ListPromoCodesResponse, error)
+	UpdatePromoCode(ctx context.Context, in *UpdatePromoCodeRequest, opts ...grpc.CallOption) (*PromoCodeResponse, error)
+	// DeletePromoCode removes a promo code that has never been redeemed; redeemed codes can only be deactivated
+	DeletePromoCode(ctx context.Context, in *DeletePromoCodeRequest, opts ...grpc.CallOption) (*DeletePromoCodeResponse, error)
+	// Stay restrictions management (admin only): minimum/maximum stay, closed to arrival/departure
+	// and advance booking window per date range and room type
+	CreateStayRestriction(ctx context.Context, in *CreateStayRestrictionRequest, opts ...grpc.CallOption) (*StayRestrictionResponse, error)
+	GetStayRestriction(ctx context.Context, in *GetStayRestrictionRequest, opts ...grpc.CallOption) (*StayRestrictionResponse, error)
+	// ListStayRestrictions returns restrictions overlapping the period, ordered by start date
+	ListStayRestrictions(ctx context.Context, in *ListStayRestrictionsRequest, opts ...grpc.CallOption) (*ListStayRestrictionsResponse, error)
+	UpdateStayRestriction(ctx context.Context, in *UpdateStayRestrictionRequest, opts ...grpc.CallOption) (*StayRestrictionResponse, error)
+	DeleteStayRestriction(ctx context.Context, in *DeleteStayRestrictionRequest, opts ...grpc.CallOption) (*DeleteStayRestrictionResponse, error)
+	// Guest profiles management (admin only): profiles group bookings by normalized email and phone
+	GetGuestProfile(ctx context.Context, in *GetGuestProfileRequest, opts ...grpc.CallOption) (*GuestProfileResponse, error)
+	// SearchGuestProfiles finds profiles by part of the guest name, email or phone
+	SearchGuestProfiles(ctx context.Context, in *SearchGuestProfilesRequest, opts ...grpc.CallOption) (*SearchGuestProfilesResponse, error)
+	// UpdateGuestProfile replaces the guest name and preferences
+	UpdateGuestProfile(ctx context.Context, in *UpdateGuestProfileRequest, opts ...grpc.CallOption) (*GuestProfileResponse, error)
+	// MergeGuestProfiles moves contacts and bookings of duplicate profiles into the target and deletes duplicates
+	MergeGuestProfiles(ctx context.Context, in *MergeGuestProfilesRequest, opts ...grpc.CallOption) (*GuestProfileResponse, error)
+	// ClaimGuestBookings attaches anonymous bookings with the email to the user so they show up in "my bookings".
+	// Internal: called by auth-service once the user has verified the email. Not exposed through the gateway,
+	// the server accepts it only with the shared service token in the x-service-token metadata
+	ClaimGuestBookings(ctx context.Context, in *ClaimGuestBookingsRequest, opts ...grpc.CallOption) (*ClaimGuestBookingsResponse, error)
+	// JoinWaitlist registers interest in sold-out dates; the guest gets a PENDING hold when a matching room frees up
+	JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest, opts ...grpc.CallOption) (*WaitlistEntryResponse, error)
+	GetWaitlistEntry(ctx context.Context, in *GetWaitlistEntryRequest, opts ...grpc.CallOption) (*WaitlistEntryResponse, error)
+	// ListWaitlistEntries returns waitlist entries in queue order
+	ListWaitlistEntries(ctx context.Context, in *ListWaitlistEntriesRequest, opts ...grpc.CallOption) (*ListWaitlistEntriesResponse, error)
+	// LeaveWaitlist removes a waiting entry from the queue
+	LeaveWaitlist(ctx context.Context, in *LeaveWaitlistRequest, opts ...grpc.CallOption) (*WaitlistEntryResponse, error)
+	// GetOversoldNights reports nights where a room type is sold beyond its room count (admin only)
+	GetOversoldNights(ctx context.Context, in *GetOversoldNightsRequest, opts ...grpc.CallOption) (*GetOversoldNightsResponse, error)
+	// GetAvailabilityCalendar returns per-night state of each room or room type in a window
+	GetAvailabilityCalendar(ctx context.Context, in *GetAvailabilityCalendarRequest, opts ...grpc.CallOption) (*GetAvailabilityCalendarResponse, error)
 }
 
 type bookingServiceClient struct {
@@ -63,6 +126,303 @@ func (c *bookingServiceClient) UpdateBookingStatus(ctx context.Context, in *Upda
 	return out, nil
 }
 
+func (c *bookingServiceClient) GetBooking(ctx context.Context, in *GetBookingRequest, opts ...grpc.CallOption) (*GetBookingResponse, error) {
+	out := new(GetBookingResponse)
+	err := c.cc.Invoke(ctx, "/hotel.booking.v1.BookingService/GetBooking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListBookings(ctx context.Context, in *ListBookingsRequest, opts ...grpc.CallOption) (*ListBookingsResponse, error) {
+	out := new(ListBookingsResponse)
+	err := c.cc.Invoke(ctx, "/hotel.booking.v1.BookingService/ListBookings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetBookingHistory(ctx context.Context, in *GetBookingHistoryRequest, opts ...grpc.CallOption) (*GetBookingHistoryResponse, error) {
+	out := new(GetBookingHistoryResponse)
+	err := c.cc.Invoke(ctx, "/hotel.booking.v1.BookingService/GetBookingHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CreateReservation(ctx context.Context, in *CreateReservationRequest, opts ...grpc.CallOption) (*CreateReservationResponse, error) {
+	out := new(CreateReservationResponse)
+	err := c.cc.Invoke(ctx, "/hotel.booking.v1.BookingService/CreateReservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetReservation(ctx context.Context, in *GetReservationRequest, opts ...grpc.CallOption) (*GetReservationResponse, error) {
+	out := new(GetReservationResponse)
+	err := c.cc.Invoke(ctx, "/hotel.booking.v1.BookingService/GetReservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ModifyBooking(ctx context.Context, in *ModifyBookingRequest, opts ...grpc.CallOption) (*ModifyBookingResponse, error) {
+	out := new(ModifyBookingResponse)
+	err := c.cc.Invoke(ctx, "/hotel.booking.v1.BookingService/ModifyBooking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetCancellationQuote(ctx context.Context, in *GetCancellationQuoteRequest, opts ...grpc.CallOption) (*GetCancellationQuoteResponse, error) {
+	out := new(GetCancellationQuoteResponse)
+	err := c.cc.Invoke(ctx, "/hotel.booking.v1.BookingService/GetCancellationQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...grpc.CallOption) (*CancelBookingResponse, error) {
+	out := new(CancelBookingResponse)
+	err := c.cc.Invoke(ctx, "/hotel.booking.v1.BookingService/CancelBooking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*CheckInResponse, error) {
+	out := new(CheckInResponse)
+	err := c.cc.Invoke(ctx, "/hotel.booking.v1.BookingService/CheckIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CheckOut(ctx context.Context, in *CheckOutRequest, opts ...grpc.CallOption) (*CheckOutResponse, error) {
+	out := new(CheckOutResponse)
+	err := c.cc.Invoke(ctx, "/hotel.booking.v1.BookingService/CheckOut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) AssignRoom(ctx context.Context, in *AssignRoomRequest, opts ...grpc.CallOption) (*AssignRoomResponse, error) {
+	out := new(AssignRoomResponse)
+	err := c.cc.Invoke(ctx, "/hotel.booking.v1.BookingService/AssignRoom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) RunNightlyTransitions(ctx context.Context, in *RunNightlyTransitionsRequest, opts ...grpc.CallOption) (*RunNightlyTransitionsResponse, error) {
+	out := new(RunNightlyTransitionsResponse)
+	err := c.cc.Invoke(ctx, "/hotel.booking.v1.BookingService/RunNightlyTransitions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CreatePromoCode(ctx context.Context, in *CreatePromoCodeRequest, opts ...grpc.CallOption) (*PromoCodeResponse, error) {
+	out := new(PromoCodeResponse)
+	err := c.cc.Invoke(ctx, "/hotel.booking.v1.BookingService/CreatePromoCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetPromoCode(ctx context.Context, in *GetPromoCodeRequest, opts ...grpc.CallOption) (*PromoCodeResponse, error) {
+	out := new(PromoCodeResponse)
+	err := c.cc.Invoke(ctx, "/hotel.booking.v1.BookingService/GetPromoCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListPromoCodes(ctx context.Context, in *ListPromoCodesRequest, opts ...grpc.CallOption) (*ListPromoCodesResponse, error) {
+	out := new(ListPromoCodesResponse)
+	err := c.cc.Invoke(ctx, "/hotel.booking.v1.BookingService/ListPromoCodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) UpdatePromoCode(ctx context.Context, in *UpdatePromoCodeRequest, opts ...grpc.CallOption) (*PromoCodeResponse, error) {
+	out := new(PromoCodeResponse)
+	err := c.cc.Invoke(ctx, "/hotel.booking.v1.BookingService/UpdatePromoCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) DeletePromoCode(ctx context.Context, in *DeletePromoCodeRequest, opts ...grpc.CallOption) (*DeletePromoCodeResponse, error) {
+	out := new(DeletePromoCodeResponse)
+	err := c.cc.Invoke(ctx, "/hotel.booking.v1.BookingService/DeletePromoCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CreateStayRestriction(ctx context.Context, in *CreateStayRestrictionRequest, opts ...grpc.CallOption) (*StayRestrictionResponse, error) {
+	out := new(StayRestrictionResponse)
+	err := c.cc.Invoke(ctx, "/hotel.booking.v1.BookingService/CreateStayRestriction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetStayRestriction(ctx context.Context, in *GetStayRestrictionRequest, opts ...grpc.CallOption) (*StayRestrictionResponse, error) {
+	out := new(StayRestrictionResponse)
+	err := c.cc.Invoke(ctx, "/hotel.booking.v1.BookingService/GetStayRestriction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListStayRestrictions(ctx context.Context, in *ListStayRestrictionsRequest, opts ...grpc.CallOption) (*ListStayRestrictionsResponse, error) {
+	out := new(ListStayRestrictionsResponse)
+	err := c.cc.Invoke(ctx, "/hotel.booking.v1.BookingService/ListStayRestrictions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) UpdateStayRestriction(ctx context.Context, in *UpdateStayRestrictionRequest, opts ...grpc.CallOption) (*StayRestrictionResponse, error) {
+	out := new(StayRestrictionResponse)
+	err := c.cc.Invoke(ctx, "/hotel.booking.v1.BookingService/UpdateStayRestriction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) DeleteStayRestriction(ctx context.Context, in *DeleteStayRestrictionRequest, opts ...grpc.CallOption) (*DeleteStayRestrictionResponse, error) {
+	out := new(DeleteStayRestrictionResponse)
+	err := c.cc.Invoke(ctx, "/hotel.booking.v1.BookingService/DeleteStayRestriction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetGuestProfile(ctx context.Context, in *GetGuestProfileRequest, opts ...grpc.CallOption) (*GuestProfileResponse, error) {
+	out := new(GuestProfileResponse)
+	err := c.cc.Invoke(ctx, "/hotel.booking.v1.BookingService/GetGuestProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) SearchGuestProfiles(ctx context.Context, in *SearchGuestProfilesRequest, opts ...grpc.CallOption) (*SearchGuestProfilesResponse, error) {
+	out := new(SearchGuestProfilesResponse)
+	err := c.cc.Invoke(ctx, "/hotel.booking.v1.BookingService/SearchGuestProfiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) UpdateGuestProfile(ctx context.Context, in *UpdateGuestProfileRequest, opts ...grpc.CallOption) (*GuestProfileResponse, error) {
+	out := new(GuestProfileResponse)
+	err := c.cc.Invoke(ctx, "/hotel.booking.v1.BookingService/UpdateGuestProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) MergeGuestProfiles(ctx context.Context, in *MergeGuestProfilesRequest, opts ...grpc.CallOption) (*GuestProfileResponse, error) {
+	out := new(GuestProfileResponse)
+	err := c.cc.Invoke(ctx, "/hotel.booking.v1.BookingService/MergeGuestProfiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ClaimGuestBookings(ctx context.Context, in *ClaimGuestBookingsRequest, opts ...grpc.CallOption) (*ClaimGuestBookingsResponse, error) {
+	out := new(ClaimGuestBookingsResponse)
+	err := c.cc.Invoke(ctx, "/hotel.booking.v1.BookingService/ClaimGuestBookings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest, opts ...grpc.CallOption) (*WaitlistEntryResponse, error) {
+	out := new(WaitlistEntryResponse)
+	err := c.cc.Invoke(ctx, "/hotel.booking.v1.BookingService/JoinWaitlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetWaitlistEntry(ctx context.Context, in *GetWaitlistEntryRequest, opts ...grpc.CallOption) (*WaitlistEntryResponse, error) {
+	out := new(WaitlistEntryResponse)
+	err := c.cc.Invoke(ctx, "/hotel.booking.v1.BookingService/GetWaitlistEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListWaitlistEntries(ctx context.Context, in *ListWaitlistEntriesRequest, opts ...grpc.CallOption) (*ListWaitlistEntriesResponse, error) {
+	out := new(ListWaitlistEntriesResponse)
+	err := c.cc.Invoke(ctx, "/hotel.booking.v1.BookingService/ListWaitlistEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) LeaveWaitlist(ctx context.Context, in *LeaveWaitlistRequest, opts ...grpc.CallOption) (*WaitlistEntryResponse, error) {
+	out := new(WaitlistEntryResponse)
+	err := c.cc.Invoke(ctx, "/hotel.booking.v1.BookingService/LeaveWaitlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetOversoldNights(ctx context.Context, in *GetOversoldNightsRequest, opts ...grpc.CallOption) (*GetOversoldNightsResponse, error) {
+	out := new(GetOversoldNightsResponse)
+	err := c.cc.Invoke(ctx, "/hotel.booking.v1.BookingService/GetOversoldNights", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetAvailabilityCalendar(ctx context.Context, in *GetAvailabilityCalendarRequest, opts ...grpc.CallOption) (*GetAvailabilityCalendarResponse, error) {
+	out := new(GetAvailabilityCalendarResponse)
+	err := c.cc.Invoke(ctx, "/hotel.booking.v1.BookingService/GetAvailabilityCalendar", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BookingServiceServer is the server API for BookingService service.
 // All implementations must embed UnimplementedBookingServiceServer
 // for forward compatibility
@@ -71,6 +431,69 @@ type BookingServiceServer interface {
 	CreateBooking(context.Context, *CreateBookingRequest) (*CreateBookingResponse, error)
 	// UpdateBookingStatus updates booking status
 	UpdateBookingStatus(context.Context, *UpdateBookingStatusRequest) (*UpdateBookingStatusResponse, error)
+	// GetBooking returns booking with its current status
+	GetBooking(context.Context, *GetBookingRequest) (*GetBookingResponse, error)
+	// ListBookings returns bookings page by filter, newest first
+	ListBookings(context.Context, *ListBookingsRequest) (*ListBookingsResponse, error)
+	// GetBookingHistory returns booking status changes, newest first
+	GetBookingHistory(context.Context, *GetBookingHistoryRequest) (*GetBookingHistoryResponse, error)
+	// CreateReservation books several rooms atomically under one confirmation code
+	CreateReservation(context.Context, *CreateReservationRequest) (*CreateReservationResponse, error)
+	// GetReservation returns group reservation with bookings of all its rooms
+	GetReservation(context.Context, *GetReservationRequest) (*GetReservationResponse, error)
+	// ModifyBooking changes dates, room type or guest details of an active booking
+	ModifyBooking(context.Context, *ModifyBookingRequest) (*ModifyBookingResponse, error)
+	// GetCancellationQuote previews penalty and refund of cancelling the booking now
+	GetCancellationQuote(context.Context, *GetCancellationQuoteRequest) (*GetCancellationQuoteResponse, error)
+	// CancelBooking cancels the booking applying the cancellation policy of the room type
+	CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error)
+	// CheckIn records guest arrival and the room the guest is placed in
+	CheckIn(context.Context, *CheckInRequest) (*CheckInResponse, error)
+	// CheckOut records guest departure, completes the booking and sends the room to housekeeping
+	CheckOut(context.Context, *CheckOutRequest) (*CheckOutResponse, error)
+	// AssignRoom assigns a concrete room to a booking before check-in, overriding the assignment job
+	AssignRoom(context.Context, *AssignRoomRequest) (*AssignRoomResponse, error)
+	// RunNightlyTransitions marks past bookings as NO_SHOW / COMPLETED on demand.
+	// Operator-only: not exposed through the gateway, use dry_run to preview changes
+	RunNightlyTransitions(context.Context, *RunNightlyTransitionsRequest) (*RunNightlyTransitionsResponse, error)
+	// Promo codes management (admin only)
+	CreatePromoCode(context.Context, *CreatePromoCodeRequest) (*PromoCodeResponse, error)
+	GetPromoCode(context.Context, *GetPromoCodeRequest) (*PromoCodeResponse, error)
+	ListPromoCodes(context.Context, *ListPromoCodesRequest) (*ListPromoCodesResponse, error)
+	UpdatePromoCode(context.Context, *UpdatePromoCodeRequest) (*PromoCodeResponse, error)
+	// DeletePromoCode removes a promo code that has never been redeemed; redeemed codes can only be deactivated
+	DeletePromoCode(context.Context, *DeletePromoCodeRequest) (*DeletePromoCodeResponse, error)
+	// Stay restrictions management (admin only): minimum/maximum stay, closed to arrival/departure
+	// and advance booking window per date range and room type
+	CreateStayRestriction(context.Context, *CreateStayRestrictionRequest) (*StayRestrictionResponse, error)
+	GetStayRestriction(context.Context, *GetStayRestrictionRequest) (*StayRestrictionResponse, error)
+	// ListStayRestrictions returns restrictions overlapping the period, ordered by start date
+	ListStayRestrictions(context.Context, *ListStayRestrictionsRequest) (*ListStayRestrictionsResponse, error)
+	UpdateStayRestriction(context.Context, *UpdateStayRestrictionRequest) (*StayRestrictionResponse, error)
+	DeleteStayRestriction(context.Context, *DeleteStayRestrictionRequest) (*DeleteStayRestrictionResponse, error)
+	// Guest profiles management (admin only): profiles group bookings by normalized email and phone
+	GetGuestProfile(context.Context, *GetGuestProfileRequest) (*GuestProfileResponse, error)
+	// SearchGuestProfiles finds profiles by part of the guest name, email or phone
+	SearchGuestProfiles(context.Context, *SearchGuestProfilesRequest) (*SearchGuestProfilesResponse, error)
+	// UpdateGuestProfile replaces the guest name and preferences
+	UpdateGuestProfile(context.Context, *UpdateGuestProfileRequest) (*GuestProfileResponse, error)
+	// MergeGuestProfiles moves contacts and bookings of duplicate profiles into the target and deletes duplicates
+	MergeGuestProfiles(context.Context, *MergeGuestProfilesRequest) (*GuestProfileResponse, error)
+	// ClaimGuestBookings attaches anonymous bookings with the email to the user so they show up in "my bookings".
+	// Internal: called by auth-service once the user has verified the email. Not exposed through the gateway,
+	// the server accepts it only with the shared service token in the x-service-token metadata
+	ClaimGuestBookings(context.Context, *ClaimGuestBookingsRequest) (*ClaimGuestBookingsResponse, error)
+	// JoinWaitlist registers interest in sold-out dates; the guest gets a PENDING hold when a matching room frees up
+	JoinWaitlist(context.Context, *JoinWaitlistRequest) (*WaitlistEntryResponse, error)
+	GetWaitlistEntry(context.Context, *GetWaitlistEntryRequest) (*WaitlistEntryResponse, error)
+	// ListWaitlistEntries returns waitlist entries in queue order
+	ListWaitlistEntries(context.Context, *ListWaitlistEntriesRequest) (*ListWaitlistEntriesResponse, error)
+	// LeaveWaitlist removes a waiting entry from the queue
+	LeaveWaitlist(context.Context, *LeaveWaitlistRequest) (*WaitlistEntryResponse, error)
+	// GetOversoldNights reports nights where a room type is sold beyond its room count (admin only)
+	GetOversoldNights(context.Context, *GetOversoldNightsRequest) (*GetOversoldNightsResponse, error)
+	// GetAvailabilityCalendar returns per-night state of each room or room type in a window
+	GetAvailabilityCalendar(context.Context, *GetAvailabilityCalendarRequest) (*GetAvailabilityCalendarResponse, error)
 	mustEmbedUnimplementedBookingServiceServer()
 }
 
@@ -87,6 +510,105 @@ func (UnimplementedBookingServiceServer) CreateBooking(context.Context, *CreateB
 func (UnimplementedBookingServiceServer) UpdateBookingStatus(context.Context, *UpdateBookingStatusRequest) (*UpdateBookingStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateBookingStatus not implemented")
 }
+func (UnimplementedBookingServiceServer) GetBooking(context.Context, *GetBookingRequest) (*GetBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) ListBookings(context.Context, *ListBookingsRequest) (*ListBookingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBookings not implemented")
+}
+func (UnimplementedBookingServiceServer) GetBookingHistory(context.Context, *GetBookingHistoryRequest) (*GetBookingHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBookingHistory not implemented")
+}
+func (UnimplementedBookingServiceServer) CreateReservation(context.Context, *CreateReservationRequest) (*CreateReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReservation not implemented")
+}
+func (UnimplementedBookingServiceServer) GetReservation(context.Context, *GetReservationRequest) (*GetReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReservation not implemented")
+}
+func (UnimplementedBookingServiceServer) ModifyBooking(context.Context, *ModifyBookingRequest) (*ModifyBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) GetCancellationQuote(context.Context, *GetCancellationQuoteRequest) (*GetCancellationQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCancellationQuote not implemented")
+}
+func (UnimplementedBookingServiceServer) CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) CheckIn(context.Context, *CheckInRequest) (*CheckInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckIn not implemented")
+}
+func (UnimplementedBookingServiceServer) CheckOut(context.Context, *CheckOutRequest) (*CheckOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckOut not implemented")
+}
+func (UnimplementedBookingServiceServer) AssignRoom(context.Context, *AssignRoomRequest) (*AssignRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignRoom not implemented")
+}
+func (UnimplementedBookingServiceServer) RunNightlyTransitions(context.Context, *RunNightlyTransitionsRequest) (*RunNightlyTransitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunNightlyTransitions not implemented")
+}
+func (UnimplementedBookingServiceServer) CreatePromoCode(context.Context, *CreatePromoCodeRequest) (*PromoCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePromoCode not implemented")
+}
+func (UnimplementedBookingServiceServer) GetPromoCode(context.Context, *GetPromoCodeRequest) (*PromoCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPromoCode not implemented")
+}
+func (UnimplementedBookingServiceServer) ListPromoCodes(context.Context, *ListPromoCodesRequest) (*ListPromoCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPromoCodes not implemented")
+}
+func (UnimplementedBookingServiceServer) UpdatePromoCode(context.Context, *UpdatePromoCodeRequest) (*PromoCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePromoCode not implemented")
+}
+func (UnimplementedBookingServiceServer) DeletePromoCode(context.Context, *DeletePromoCodeRequest) (*DeletePromoCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePromoCode not implemented")
+}
+func (UnimplementedBookingServiceServer) CreateStayRestriction(context.Context, *CreateStayRestrictionRequest) (*StayRestrictionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStayRestriction not implemented")
+}
+func (UnimplementedBookingServiceServer) GetStayRestriction(context.Context, *GetStayRestrictionRequest) (*StayRestrictionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStayRestriction not implemented")
+}
+func (UnimplementedBookingServiceServer) ListStayRestrictions(context.Context, *ListStayRestrictionsRequest) (*ListStayRestrictionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStayRestrictions not implemented")
+}
+func (UnimplementedBookingServiceServer) UpdateStayRestriction(context.Context, *UpdateStayRestrictionRequest) (*StayRestrictionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStayRestriction not implemented")
+}
+func (UnimplementedBookingServiceServer) DeleteStayRestriction(context.Context, *DeleteStayRestrictionRequest) (*DeleteStayRestrictionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStayRestriction not implemented")
+}
+func (UnimplementedBookingServiceServer) GetGuestProfile(context.Context, *GetGuestProfileRequest) (*GuestProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuestProfile not implemented")
+}
+func (UnimplementedBookingServiceServer) SearchGuestProfiles(context.Context, *SearchGuestProfilesRequest) (*SearchGuestProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchGuestProfiles not implemented")
+}
+func (UnimplementedBookingServiceServer) UpdateGuestProfile(context.Context, *UpdateGuestProfileRequest) (*GuestProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGuestProfile not implemented")
+}
+func (UnimplementedBookingServiceServer) MergeGuestProfiles(context.Context, *MergeGuestProfilesRequest) (*GuestProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeGuestProfiles not implemented")
+}
+func (UnimplementedBookingServiceServer) ClaimGuestBookings(context.Context, *ClaimGuestBookingsRequest) (*ClaimGuestBookingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimGuestBookings not implemented")
+}
+func (UnimplementedBookingServiceServer) JoinWaitlist(context.Context, *JoinWaitlistRequest) (*WaitlistEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinWaitlist not implemented")
+}
+func (UnimplementedBookingServiceServer) GetWaitlistEntry(context.Context, *GetWaitlistEntryRequest) (*WaitlistEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWaitlistEntry not implemented")
+}
+func (UnimplementedBookingServiceServer) ListWaitlistEntries(context.Context, *ListWaitlistEntriesRequest) (*ListWaitlistEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWaitlistEntries not implemented")
+}
+func (UnimplementedBookingServiceServer) LeaveWaitlist(context.Context, *LeaveWaitlistRequest) (*WaitlistEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveWaitlist not implemented")
+}
+func (UnimplementedBookingServiceServer) GetOversoldNights(context.Context, *GetOversoldNightsRequest) (*GetOversoldNightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOversoldNights not implemented")
+}
+func (UnimplementedBookingServiceServer) GetAvailabilityCalendar(context.Context, *GetAvailabilityCalendarRequest) (*GetAvailabilityCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailabilityCalendar not implemented")
+}
 func (UnimplementedBookingServiceServer) mustEmbedUnimplementedBookingServiceServer() {}
 
 // UnsafeBookingServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -154,24 +676,750 @@ func _BookingService_UpdateBookingStatus_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
-// BookingService_ServiceDesc is the grpc.ServiceDesc for BookingService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var BookingService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "hotel.booking.v1.BookingService",
-	HandlerType: (*BookingServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetAvailableRooms",
-			Handler:    _BookingService_GetAvailableRooms_Handler,
-		},
-		{
-			MethodName: "CreateBooking",
-			Handler:    _BookingService_CreateBooking_Handler,
-		},
-		{
-			MethodName: "UpdateBookingStatus",
-			Handler:    _BookingService_UpdateBookingStatus_Handler,
+func _BookingService_GetBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hotel.booking.v1.BookingService/GetBooking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetBooking(ctx, req.(*GetBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBookingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListBookings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hotel.booking.v1.BookingService/ListBookings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListBookings(ctx, req.(*ListBookingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetBookingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookingHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetBookingHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hotel.booking.v1.BookingService/GetBookingHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetBookingHistory(ctx, req.(*GetBookingHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CreateReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CreateReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hotel.booking.v1.BookingService/CreateReservation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CreateReservation(ctx, req.(*CreateReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hotel.booking.v1.BookingService/GetReservation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetReservation(ctx, req.(*GetReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ModifyBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ModifyBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hotel.booking.v1.BookingService/ModifyBooking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ModifyBooking(ctx, req.(*ModifyBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetCancellationQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCancellationQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetCancellationQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hotel.booking.v1.BookingService/GetCancellationQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetCancellationQuote(ctx, req.(*GetCancellationQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CancelBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CancelBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hotel.booking.v1.BookingService/CancelBooking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CancelBooking(ctx, req.(*CancelBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CheckIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CheckIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hotel.booking.v1.BookingService/CheckIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CheckIn(ctx, req.(*CheckInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CheckOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CheckOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hotel.booking.v1.BookingService/CheckOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CheckOut(ctx, req.(*CheckOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_AssignRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).AssignRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hotel.booking.v1.BookingService/AssignRoom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).AssignRoom(ctx, req.(*AssignRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_RunNightlyTransitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunNightlyTransitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).RunNightlyTransitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hotel.booking.v1.BookingService/RunNightlyTransitions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).RunNightlyTransitions(ctx, req.(*RunNightlyTransitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CreatePromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CreatePromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hotel.booking.v1.BookingService/CreatePromoCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CreatePromoCode(ctx, req.(*CreatePromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetPromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetPromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hotel.booking.v1.BookingService/GetPromoCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetPromoCode(ctx, req.(*GetPromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListPromoCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPromoCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListPromoCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hotel.booking.v1.BookingService/ListPromoCodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListPromoCodes(ctx, req.(*ListPromoCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_UpdatePromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).UpdatePromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hotel.booking.v1.BookingService/UpdatePromoCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).UpdatePromoCode(ctx, req.(*UpdatePromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_DeletePromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).DeletePromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hotel.booking.v1.BookingService/DeletePromoCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).DeletePromoCode(ctx, req.(*DeletePromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CreateStayRestriction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStayRestrictionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CreateStayRestriction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hotel.booking.v1.BookingService/CreateStayRestriction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CreateStayRestriction(ctx, req.(*CreateStayRestrictionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetStayRestriction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStayRestrictionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetStayRestriction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hotel.booking.v1.BookingService/GetStayRestriction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetStayRestriction(ctx, req.(*GetStayRestrictionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListStayRestrictions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStayRestrictionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListStayRestrictions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hotel.booking.v1.BookingService/ListStayRestrictions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListStayRestrictions(ctx, req.(*ListStayRestrictionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_UpdateStayRestriction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStayRestrictionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).UpdateStayRestriction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hotel.booking.v1.BookingService/UpdateStayRestriction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).UpdateStayRestriction(ctx, req.(*UpdateStayRestrictionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_DeleteStayRestriction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStayRestrictionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).DeleteStayRestriction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hotel.booking.v1.BookingService/DeleteStayRestriction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).DeleteStayRestriction(ctx, req.(*DeleteStayRestrictionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetGuestProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuestProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetGuestProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hotel.booking.v1.BookingService/GetGuestProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetGuestProfile(ctx, req.(*GetGuestProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_SearchGuestProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchGuestProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).SearchGuestProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hotel.booking.v1.BookingService/SearchGuestProfiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).SearchGuestProfiles(ctx, req.(*SearchGuestProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_UpdateGuestProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGuestProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).UpdateGuestProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hotel.booking.v1.BookingService/UpdateGuestProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).UpdateGuestProfile(ctx, req.(*UpdateGuestProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_MergeGuestProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeGuestProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).MergeGuestProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hotel.booking.v1.BookingService/MergeGuestProfiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).MergeGuestProfiles(ctx, req.(*MergeGuestProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ClaimGuestBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimGuestBookingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ClaimGuestBookings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hotel.booking.v1.BookingService/ClaimGuestBookings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ClaimGuestBookings(ctx, req.(*ClaimGuestBookingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_JoinWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).JoinWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hotel.booking.v1.BookingService/JoinWaitlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).JoinWaitlist(ctx, req.(*JoinWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetWaitlistEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWaitlistEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetWaitlistEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hotel.booking.v1.BookingService/GetWaitlistEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetWaitlistEntry(ctx, req.(*GetWaitlistEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListWaitlistEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWaitlistEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListWaitlistEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hotel.booking.v1.BookingService/ListWaitlistEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListWaitlistEntries(ctx, req.(*ListWaitlistEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_LeaveWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).LeaveWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hotel.booking.v1.BookingService/LeaveWaitlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).LeaveWaitlist(ctx, req.(*LeaveWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetOversoldNights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOversoldNightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetOversoldNights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hotel.booking.v1.BookingService/GetOversoldNights",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetOversoldNights(ctx, req.(*GetOversoldNightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetAvailabilityCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailabilityCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetAvailabilityCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hotel.booking.v1.BookingService/GetAvailabilityCalendar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetAvailabilityCalendar(ctx, req.(*GetAvailabilityCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BookingService_ServiceDesc is the grpc.ServiceDesc for BookingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BookingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hotel.booking.v1.BookingService",
+	HandlerType: (*BookingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAvailableRooms",
+			Handler:    _BookingService_GetAvailableRooms_Handler,
+		},
+		{
+			MethodName: "CreateBooking",
+			Handler:    _BookingService_CreateBooking_Handler,
+		},
+		{
+			MethodName: "UpdateBookingStatus",
+			Handler:    _BookingService_UpdateBookingStatus_Handler,
+		},
+		{
+			MethodName: "GetBooking",
+			Handler:    _BookingService_GetBooking_Handler,
+		},
+		{
+			MethodName: "ListBookings",
+			Handler:    _BookingService_ListBookings_Handler,
+		},
+		{
+			MethodName: "GetBookingHistory",
+			Handler:    _BookingService_GetBookingHistory_Handler,
+		},
+		{
+			MethodName: "CreateReservation",
+			Handler:    _BookingService_CreateReservation_Handler,
+		},
+		{
+			MethodName: "GetReservation",
+			Handler:    _BookingService_GetReservation_Handler,
+		},
+		{
+			MethodName: "ModifyBooking",
+			Handler:    _BookingService_ModifyBooking_Handler,
+		},
+		{
+			MethodName: "GetCancellationQuote",
+			Handler:    _BookingService_GetCancellationQuote_Handler,
+		},
+		{
+			MethodName: "CancelBooking",
+			Handler:    _BookingService_CancelBooking_Handler,
+		},
+		{
+			MethodName: "CheckIn",
+			Handler:    _BookingService_CheckIn_Handler,
+		},
+		{
+			MethodName: "CheckOut",
+			Handler:    _BookingService_CheckOut_Handler,
+		},
+		{
+			MethodName: "AssignRoom",
+			Handler:    _BookingService_AssignRoom_Handler,
+		},
+		{
+			MethodName: "RunNightlyTransitions",
+			Handler:    _BookingService_RunNightlyTransitions_Handler,
+		},
+		{
+			MethodName: "CreatePromoCode",
+			Handler:    _BookingService_CreatePromoCode_Handler,
+		},
+		{
+			MethodName: "GetPromoCode",
+			Handler:    _BookingService_GetPromoCode_Handler,
+		},
+		{
+			MethodName: "ListPromoCodes",
+			Handler:    _BookingService_ListPromoCodes_Handler,
+		},
+		{
+			MethodName: "UpdatePromoCode",
+			Handler:    _BookingService_UpdatePromoCode_Handler,
+		},
+		{
+			MethodName: "DeletePromoCode",
+			Handler:    _BookingService_DeletePromoCode_Handler,
+		},
+		{
+			MethodName: "CreateStayRestriction",
+			Handler:    _BookingService_CreateStayRestriction_Handler,
+		},
+		{
+			MethodName: "GetStayRestriction",
+			Handler:    _BookingService_GetStayRestriction_Handler,
+		},
+		{
+			MethodName: "ListStayRestrictions",
+			Handler:    _BookingService_ListStayRestrictions_Handler,
+		},
+		{
+			MethodName: "UpdateStayRestriction",
+			Handler:    _BookingService_UpdateStayRestriction_Handler,
+		},
+		{
+			MethodName: "DeleteStayRestriction",
+			Handler:    _BookingService_DeleteStayRestriction_Handler,
+		},
+		{
+			MethodName: "GetGuestProfile",
+			Handler:    _BookingService_GetGuestProfile_Handler,
+		},
+		{
+			MethodName: "SearchGuestProfiles",
+			Handler:    _BookingService_SearchGuestProfiles_Handler,
+		},
+		{
+			MethodName: "UpdateGuestProfile",
+			Handler:    _BookingService_UpdateGuestProfile_Handler,
+		},
+		{
+			MethodName: "MergeGuestProfiles",
+			Handler:    _BookingService_MergeGuestProfiles_Handler,
+		},
+		{
+			MethodName: "ClaimGuestBookings",
+			Handler:    _BookingService_ClaimGuestBookings_Handler,
+		},
+		{
+			MethodName: "JoinWaitlist",
+			Handler:    _BookingService_JoinWaitlist_Handler,
+		},
+		{
+			MethodName: "GetWaitlistEntry",
+			Handler:    _BookingService_GetWaitlistEntry_Handler,
+		},
+		{
+			MethodName: "ListWaitlistEntries",
+			Handler:    _BookingService_ListWaitlistEntries_Handler,
+		},
+		{
+			MethodName: "LeaveWaitlist",
+			Handler:    _BookingService_LeaveWaitlist_Handler,
+		},
+		{
+			MethodName: "GetOversoldNights",
+			Handler:    _BookingService_GetOversoldNights_Handler,
+		},
+		{
+			MethodName: "GetAvailabilityCalendar",
+			Handler:    _BookingService_GetAvailabilityCalendar_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
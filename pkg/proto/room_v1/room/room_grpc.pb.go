@@ -28,6 +28,8 @@ type RoomServiceClient interface {
 	GetRoomsCount(ctx context.Context, in *GetAvailableRoomsRequest, opts ...grpc.CallOption) (*GetRoomsCountResponse, error)
 	GetRoom(ctx context.Context, in *GetRoomRequest, opts ...grpc.CallOption) (*GetRoomResponse, error)
 	GetFirstAvailableRoom(ctx context.Context, in *GetAvailableRoomsRequest, opts ...grpc.CallOption) (*GetRoomResponse, error)
+	// UpdateRoomStatus changes housekeeping/technical status of the room
+	UpdateRoomStatus(ctx context.Context, in *UpdateRoomStatusRequest, opts ...grpc.CallOption) (*GetRoomResponse, error)
 }
 
 type roomServiceClient struct {
@@ -83,6 +85,15 @@ func (c *roomServiceClient) GetFirstAvailableRoom(ctx context.Context, in *GetAv
 	return out, nil
 }
 
+func (c *roomServiceClient) UpdateRoomStatus(ctx context.Context, in *UpdateRoomStatusRequest, opts ...grpc.CallOption) (*GetRoomResponse, error) {
+	out := new(GetRoomResponse)
+	err := c.cc.Invoke(ctx, "/hotel.room.v1.RoomService/UpdateRoomStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RoomServiceServer is the server API for RoomService service.
 // All implementations must embed UnimplementedRoomServiceServer
 // for forward compatibility
@@ -93,6 +104,8 @@ type RoomServiceServer interface {
 	GetRoomsCount(context.Context, *GetAvailableRoomsRequest) (*GetRoomsCountResponse, error)
 	GetRoom(context.Context, *GetRoomRequest) (*GetRoomResponse, error)
 	GetFirstAvailableRoom(context.Context, *GetAvailableRoomsRequest) (*GetRoomResponse, error)
+	// UpdateRoomStatus changes housekeeping/technical status of the room
+	UpdateRoomStatus(context.Context, *UpdateRoomStatusRequest) (*GetRoomResponse, error)
 	mustEmbedUnimplementedRoomServiceServer()
 }
 
@@ -115,6 +128,9 @@ func (UnimplementedRoomServiceServer) GetRoom(context.Context, *GetRoomRequest)
 func (UnimplementedRoomServiceServer) GetFirstAvailableRoom(context.Context, *GetAvailableRoomsRequest) (*GetRoomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFirstAvailableRoom not implemented")
 }
+func (UnimplementedRoomServiceServer) UpdateRoomStatus(context.Context, *UpdateRoomStatusRequest) (*GetRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoomStatus not implemented")
+}
 func (UnimplementedRoomServiceServer) mustEmbedUnimplementedRoomServiceServer() {}
 
 // UnsafeRoomServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -218,6 +234,24 @@ func _RoomService_GetFirstAvailableRoom_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RoomService_UpdateRoomStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoomStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).UpdateRoomStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hotel.room.v1.RoomService/UpdateRoomStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).UpdateRoomStatus(ctx, req.(*UpdateRoomStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RoomService_ServiceDesc is the grpc.ServiceDesc for RoomService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -245,6 +279,10 @@ var RoomService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFirstAvailableRoom",
 			Handler:    _RoomService_GetFirstAvailableRoom_Handler,
 		},
+		{
+			MethodName: "UpdateRoomStatus",
+			Handler:    _RoomService_UpdateRoomStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "room/room.proto",
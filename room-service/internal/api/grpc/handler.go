@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/google/uuid"
+	"github.com/semho/hotel-booking/pkg/errors"
 	"github.com/semho/hotel-booking/pkg/logger"
 	pb "github.com/semho/hotel-booking/pkg/proto/room_v1/room"
 	"github.com/semho/hotel-booking/room-service/internal/api/grpc/mapper"
@@ -125,3 +126,28 @@ func (h *RoomHandler) GetFirstAvailableRoom(ctx context.Context, req *pb.GetAvai
 		Room: mapper.ToProtoRoom(*room),
 	}, nil
 }
+
+func (h *RoomHandler) UpdateRoomStatus(ctx context.Context, req *pb.UpdateRoomStatusRequest) (
+	*pb.GetRoomResponse,
+	error,
+) {
+	roomID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, mapper.ToDomainError(errors.WithMessage(errors.ErrInvalidInput, "invalid room_id"))
+	}
+
+	room, err := h.roomService.UpdateStatus(ctx, roomID, req.GetStatus())
+	if err != nil {
+		logger.Log.Error(
+			"failed to update room status",
+			"room id", roomID,
+			"status", req.GetStatus(),
+			"error", err,
+		)
+		return nil, mapper.ToDomainError(err)
+	}
+
+	return &pb.GetRoomResponse{
+		Room: mapper.ToProtoRoom(*room),
+	}, nil
+}
@@ -38,6 +38,7 @@ func ToProtoRoom(r model.Room) *pb.Room {
 		RoomNumber: r.RoomNumber,
 		Type:       r.Type,
 		Price:      r.Price.String(),
+		Currency:   r.Currency,
 		Capacity:   int32(r.Capacity),
 		Status:     r.Status,
 		Amenities:  r.Amenities,
@@ -66,6 +67,7 @@ func ProtoToRoom(protoRoom *pb.CreateRoomRequest, price decimal.Decimal) *model.
 		RoomNumber: protoRoom.RoomNumber,
 		Type:       protoRoom.Type,
 		Price:      price,
+		Currency:   protoRoom.Currency,
 		Capacity:   int(protoRoom.Capacity),
 		Status:     protoRoom.Status,
 		Amenities:  protoRoom.Amenities,
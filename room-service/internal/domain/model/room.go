@@ -18,6 +18,7 @@ type Room struct {
 	RoomNumber string          `db:"room_number" json:"room_number"`
 	Type       RoomType        `db:"type" json:"type"`
 	Price      decimal.Decimal `db:"price" json:"price"`
+	Currency   string          `db:"currency" json:"currency"`
 	Capacity   int             `db:"capacity" json:"capacity"`
 	Status     RoomStatus      `db:"status" json:"status"`
 	Amenities  pq.StringArray  `db:"amenities" json:"amenities"`
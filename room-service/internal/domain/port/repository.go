@@ -11,6 +11,7 @@ type RoomRepository interface {
 	GetByID(ctx context.Context, id uuid.UUID) (*model.Room, error)
 	Create(ctx context.Context, room *model.Room) error
 	Update(ctx context.Context, room *model.Room) error
+	UpdateStatus(ctx context.Context, id uuid.UUID, status model.RoomStatus) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	GetRoomsCount(ctx context.Context, params model.SearchParams) (int32, error)
 	GetFirstAvailableRoom(ctx context.Context, params model.SearchParams) (*model.Room, error)
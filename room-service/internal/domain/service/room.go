@@ -2,16 +2,21 @@ package service
 
 import (
 	"context"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/semho/hotel-booking/pkg/errors"
 	"github.com/semho/hotel-booking/pkg/logger"
+	"github.com/semho/hotel-booking/pkg/money"
 	pb "github.com/semho/hotel-booking/pkg/proto/room_v1/room"
 	"github.com/semho/hotel-booking/room-service/internal/domain/model"
 	"github.com/semho/hotel-booking/room-service/internal/domain/port"
 	"github.com/shopspring/decimal"
 )
 
+// Валюта цены комнаты, если она не указана при создании
+const defaultCurrency = "RUB"
+
 type RoomService struct {
 	repo port.RoomRepository
 }
@@ -78,6 +83,13 @@ func (s *RoomService) Create(ctx context.Context, room *model.Room) error {
 		return errors.WithMessage(errors.ErrInvalidInput, "capacity must be greater than 0")
 	}
 
+	if room.Currency == "" {
+		room.Currency = defaultCurrency
+	}
+	if err := normalizeCurrency(room); err != nil {
+		return err
+	}
+
 	if room.Type == 0 {
 		room.Type = pb.RoomType_ROOM_TYPE_STANDARD
 	}
@@ -106,9 +118,38 @@ func (s *RoomService) Update(ctx context.Context, room *model.Room) error {
 		return errors.WithMessage(errors.ErrInvalidInput, "capacity must be greater than 0")
 	}
 
+	if err := normalizeCurrency(room); err != nil {
+		return err
+	}
+
 	return s.repo.Update(ctx, room)
 }
 
+func normalizeCurrency(room *model.Room) error {
+	if err := money.ValidateCurrency(room.Currency); err != nil {
+		return err
+	}
+	room.Currency = strings.ToUpper(room.Currency)
+	return nil
+}
+
+func (s *RoomService) UpdateStatus(ctx context.Context, id uuid.UUID, status model.RoomStatus) (*model.Room, error) {
+	if id == uuid.Nil {
+		return nil, errors.WithMessage(errors.ErrInvalidInput, "invalid room id")
+	}
+
+	if _, ok := pb.RoomStatus_name[int32(status)]; !ok || status == pb.RoomStatus_ROOM_STATUS_UNSPECIFIED {
+		return nil, errors.WithMessage(errors.ErrInvalidInput, "invalid room status")
+	}
+
+	if err := s.repo.UpdateStatus(ctx, id, status); err != nil {
+		return nil, err
+	}
+
+	logger.Log.Info("room status updated", "room id", id, "status", status)
+	return s.repo.GetByID(ctx, id)
+}
+
 func (s *RoomService) Delete(ctx context.Context, id uuid.UUID) error {
 	if id == uuid.Nil {
 		return errors.WithMessage(errors.ErrInvalidInput, "invalid room id")
@@ -21,6 +21,7 @@ const (
 	roomNumberColumn = "room_number"
 	typeColumn       = "type"
 	priceColumn      = "price"
+	currencyColumn   = "currency"
 	capacityColumn   = "capacity"
 	statusColumn     = "status"
 	amenitiesColumn  = "amenities"
@@ -46,6 +47,7 @@ func (r *roomRepository) GetAvailableRooms(ctx context.Context, params model.Sea
 		roomNumberColumn,
 		typeColumn,
 		priceColumn,
+		currencyColumn,
 		capacityColumn,
 		statusColumn,
 		amenitiesColumn,
@@ -115,6 +117,7 @@ func (r *roomRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.Room
 			roomNumberColumn,
 			typeColumn,
 			priceColumn,
+			currencyColumn,
 			capacityColumn,
 			statusColumn,
 			amenitiesColumn,
@@ -143,6 +146,7 @@ func (r *roomRepository) Create(ctx context.Context, room *model.Room) error {
 			roomNumberColumn,
 			typeColumn,
 			priceColumn,
+			currencyColumn,
 			capacityColumn,
 			statusColumn,
 			amenitiesColumn,
@@ -151,6 +155,7 @@ func (r *roomRepository) Create(ctx context.Context, room *model.Room) error {
 			room.RoomNumber,
 			room.Type,
 			room.Price,
+			room.Currency,
 			room.Capacity,
 			room.Status,
 			pq.Array(room.Amenities),
@@ -174,6 +179,7 @@ func (r *roomRepository) Update(ctx context.Context, room *model.Room) error {
 		Set(roomNumberColumn, room.RoomNumber).
 		Set(typeColumn, room.Type).
 		Set(priceColumn, room.Price).
+		Set(currencyColumn, room.Currency).
 		Set(capacityColumn, room.Capacity).
 		Set(statusColumn, room.Status).
 		Set(amenitiesColumn, pq.Array(room.Amenities)).
@@ -199,6 +205,32 @@ func (r *roomRepository) Update(ctx context.Context, room *model.Room) error {
 	return nil
 }
 
+func (r *roomRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status model.RoomStatus) error {
+	sql, args, err := r.builder.
+		Update(tableRooms).
+		Set(statusColumn, status).
+		Set(updatedAtColumn, squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{idColumn: id}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	result, err := r.db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return errors.ErrNotFound
+	}
+	return nil
+}
+
 func (r *roomRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	sql, args, err := r.builder.
 		Delete(tableRooms).